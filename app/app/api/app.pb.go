@@ -22,16 +22,16 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type EthAuthorizeRequest struct {
+type GetAuthNonceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *EthAuthorizeRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *EthAuthorizeRequest) Reset() {
-	*x = EthAuthorizeRequest{}
+func (x *GetAuthNonceRequest) Reset() {
+	*x = GetAuthNonceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -39,13 +39,13 @@ func (x *EthAuthorizeRequest) Reset() {
 	}
 }
 
-func (x *EthAuthorizeRequest) String() string {
+func (x *GetAuthNonceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EthAuthorizeRequest) ProtoMessage() {}
+func (*GetAuthNonceRequest) ProtoMessage() {}
 
-func (x *EthAuthorizeRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAuthNonceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -57,28 +57,30 @@ func (x *EthAuthorizeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EthAuthorizeRequest.ProtoReflect.Descriptor instead.
-func (*EthAuthorizeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAuthNonceRequest.ProtoReflect.Descriptor instead.
+func (*GetAuthNonceRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *EthAuthorizeRequest) GetSendBody() *EthAuthorizeRequest_SendBody {
+func (x *GetAuthNonceRequest) GetAddress() string {
 	if x != nil {
-		return x.SendBody
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-type EthAuthorizeReply struct {
+type GetAuthNonceReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Nonce     string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
 }
 
-func (x *EthAuthorizeReply) Reset() {
-	*x = EthAuthorizeReply{}
+func (x *GetAuthNonceReply) Reset() {
+	*x = GetAuthNonceReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -86,13 +88,13 @@ func (x *EthAuthorizeReply) Reset() {
 	}
 }
 
-func (x *EthAuthorizeReply) String() string {
+func (x *GetAuthNonceReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EthAuthorizeReply) ProtoMessage() {}
+func (*GetAuthNonceReply) ProtoMessage() {}
 
-func (x *EthAuthorizeReply) ProtoReflect() protoreflect.Message {
+func (x *GetAuthNonceReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -104,26 +106,42 @@ func (x *EthAuthorizeReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EthAuthorizeReply.ProtoReflect.Descriptor instead.
-func (*EthAuthorizeReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAuthNonceReply.ProtoReflect.Descriptor instead.
+func (*GetAuthNonceReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *EthAuthorizeReply) GetToken() string {
+func (x *GetAuthNonceReply) GetNonce() string {
 	if x != nil {
-		return x.Token
+		return x.Nonce
 	}
 	return ""
 }
 
-type DepositRequest struct {
+func (x *GetAuthNonceReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetAuthNonceReply) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type EthAuthorizeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SendBody *EthAuthorizeRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *DepositRequest) Reset() {
-	*x = DepositRequest{}
+func (x *EthAuthorizeRequest) Reset() {
+	*x = EthAuthorizeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -131,13 +149,13 @@ func (x *DepositRequest) Reset() {
 	}
 }
 
-func (x *DepositRequest) String() string {
+func (x *EthAuthorizeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositRequest) ProtoMessage() {}
+func (*EthAuthorizeRequest) ProtoMessage() {}
 
-func (x *DepositRequest) ProtoReflect() protoreflect.Message {
+func (x *EthAuthorizeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -149,19 +167,29 @@ func (x *DepositRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositRequest.ProtoReflect.Descriptor instead.
-func (*DepositRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EthAuthorizeRequest.ProtoReflect.Descriptor instead.
+func (*EthAuthorizeRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{2}
 }
 
-type DepositReply struct {
+func (x *EthAuthorizeRequest) GetSendBody() *EthAuthorizeRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type EthAuthorizeReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Token        string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken string `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
 }
 
-func (x *DepositReply) Reset() {
-	*x = DepositReply{}
+func (x *EthAuthorizeReply) Reset() {
+	*x = EthAuthorizeReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -169,13 +197,13 @@ func (x *DepositReply) Reset() {
 	}
 }
 
-func (x *DepositReply) String() string {
+func (x *EthAuthorizeReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositReply) ProtoMessage() {}
+func (*EthAuthorizeReply) ProtoMessage() {}
 
-func (x *DepositReply) ProtoReflect() protoreflect.Message {
+func (x *EthAuthorizeReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -187,19 +215,35 @@ func (x *DepositReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositReply.ProtoReflect.Descriptor instead.
-func (*DepositReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use EthAuthorizeReply.ProtoReflect.Descriptor instead.
+func (*EthAuthorizeReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{3}
 }
 
-type UserInfoRequest struct {
+func (x *EthAuthorizeReply) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *EthAuthorizeReply) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SendBody *RefreshTokenRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *UserInfoRequest) Reset() {
-	*x = UserInfoRequest{}
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -207,13 +251,13 @@ func (x *UserInfoRequest) Reset() {
 	}
 }
 
-func (x *UserInfoRequest) String() string {
+func (x *RefreshTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoRequest) ProtoMessage() {}
+func (*RefreshTokenRequest) ProtoMessage() {}
 
-func (x *UserInfoRequest) ProtoReflect() protoreflect.Message {
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -225,53 +269,29 @@ func (x *UserInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoRequest.ProtoReflect.Descriptor instead.
-func (*UserInfoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{4}
 }
 
-type UserInfoReply struct {
+func (x *RefreshTokenRequest) GetSendBody() *RefreshTokenRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type RefreshTokenReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address           string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Level             int64                 `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
-	Status            string                `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Amount            string                `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	BalanceUsdt       string                `protobuf:"bytes,5,opt,name=balanceUsdt,proto3" json:"balanceUsdt,omitempty"`
-	BalanceDhb        string                `protobuf:"bytes,6,opt,name=balanceDhb,proto3" json:"balanceDhb,omitempty"`
-	InviteUrl         string                `protobuf:"bytes,7,opt,name=inviteUrl,proto3" json:"inviteUrl,omitempty"`
-	InviteUserAddress string                `protobuf:"bytes,8,opt,name=inviteUserAddress,proto3" json:"inviteUserAddress,omitempty"`
-	RecommendNum      int64                 `protobuf:"varint,9,opt,name=recommendNum,proto3" json:"recommendNum,omitempty"`
-	RecommendTeamNum  int64                 `protobuf:"varint,10,opt,name=recommendTeamNum,proto3" json:"recommendTeamNum,omitempty"`
-	Total             string                `protobuf:"bytes,11,opt,name=total,proto3" json:"total,omitempty"`
-	Row               int64                 `protobuf:"varint,12,opt,name=row,proto3" json:"row,omitempty"`
-	Col               int64                 `protobuf:"varint,13,opt,name=col,proto3" json:"col,omitempty"`
-	RecommendTotal    string                `protobuf:"bytes,15,opt,name=recommendTotal,proto3" json:"recommendTotal,omitempty"`
-	LocationTotal     string                `protobuf:"bytes,16,opt,name=locationTotal,proto3" json:"locationTotal,omitempty"`
-	Usdt              string                `protobuf:"bytes,20,opt,name=usdt,proto3" json:"usdt,omitempty"`
-	Account           string                `protobuf:"bytes,22,opt,name=account,proto3" json:"account,omitempty"`
-	RecommendNumAll   int64                 `protobuf:"varint,24,opt,name=recommendNumAll,proto3" json:"recommendNumAll,omitempty"`
-	RecommendTeamAll  int64                 `protobuf:"varint,25,opt,name=recommendTeamAll,proto3" json:"recommendTeamAll,omitempty"`
-	AmountB           string                `protobuf:"bytes,27,opt,name=amountB,proto3" json:"amountB,omitempty"`
-	RecommendVipTotal string                `protobuf:"bytes,28,opt,name=recommendVipTotal,proto3" json:"recommendVipTotal,omitempty"`
-	FeeDaily          string                `protobuf:"bytes,29,opt,name=feeDaily,proto3" json:"feeDaily,omitempty"`
-	WithdrawAmount    string                `protobuf:"bytes,30,opt,name=withdrawAmount,proto3" json:"withdrawAmount,omitempty"`
-	UserCount         string                `protobuf:"bytes,31,opt,name=userCount,proto3" json:"userCount,omitempty"`
-	TotalDeposit      string                `protobuf:"bytes,32,opt,name=totalDeposit,proto3" json:"totalDeposit,omitempty"`
-	PoolAmount        string                `protobuf:"bytes,34,opt,name=poolAmount,proto3" json:"poolAmount,omitempty"`
-	TopUser           []*UserInfoReply_List `protobuf:"bytes,33,rep,name=topUser,proto3" json:"topUser,omitempty"`
-	LocationCount     int64                 `protobuf:"varint,35,opt,name=locationCount,proto3" json:"locationCount,omitempty"`
-	TodayReward       string                `protobuf:"bytes,36,opt,name=todayReward,proto3" json:"todayReward,omitempty"`
-	RecommendTop      string                `protobuf:"bytes,37,opt,name=recommendTop,proto3" json:"recommendTop,omitempty"`
-	LocationTotalCol  string                `protobuf:"bytes,38,opt,name=locationTotalCol,proto3" json:"locationTotalCol,omitempty"`
-	LocationTotalRow  string                `protobuf:"bytes,39,opt,name=locationTotalRow,proto3" json:"locationTotalRow,omitempty"`
-	BnbAmount         string                `protobuf:"bytes,40,opt,name=bnbAmount,proto3" json:"bnbAmount,omitempty"`
+	Token        string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken string `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
 }
 
-func (x *UserInfoReply) Reset() {
-	*x = UserInfoReply{}
+func (x *RefreshTokenReply) Reset() {
+	*x = RefreshTokenReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -279,13 +299,13 @@ func (x *UserInfoReply) Reset() {
 	}
 }
 
-func (x *UserInfoReply) String() string {
+func (x *RefreshTokenReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoReply) ProtoMessage() {}
+func (*RefreshTokenReply) ProtoMessage() {}
 
-func (x *UserInfoReply) ProtoReflect() protoreflect.Message {
+func (x *RefreshTokenReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -297,267 +317,126 @@ func (x *UserInfoReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoReply.ProtoReflect.Descriptor instead.
-func (*UserInfoReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshTokenReply.ProtoReflect.Descriptor instead.
+func (*RefreshTokenReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *UserInfoReply) GetAddress() string {
+func (x *RefreshTokenReply) GetToken() string {
 	if x != nil {
-		return x.Address
+		return x.Token
 	}
 	return ""
 }
 
-func (x *UserInfoReply) GetLevel() int64 {
+func (x *RefreshTokenReply) GetRefreshToken() string {
 	if x != nil {
-		return x.Level
+		return x.RefreshToken
 	}
-	return 0
+	return ""
 }
 
-func (x *UserInfoReply) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+type LogoutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UserInfoReply) GetAmount() string {
-	if x != nil {
-		return x.Amount
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *UserInfoReply) GetBalanceUsdt() string {
-	if x != nil {
-		return x.BalanceUsdt
-	}
-	return ""
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserInfoReply) GetBalanceDhb() string {
-	if x != nil {
-		return x.BalanceDhb
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserInfoReply) GetInviteUrl() string {
-	if x != nil {
-		return x.InviteUrl
-	}
-	return ""
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *UserInfoReply) GetInviteUserAddress() string {
-	if x != nil {
-		return x.InviteUserAddress
-	}
-	return ""
+type LogoutReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UserInfoReply) GetRecommendNum() int64 {
-	if x != nil {
-		return x.RecommendNum
+func (x *LogoutReply) Reset() {
+	*x = LogoutReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *UserInfoReply) GetRecommendTeamNum() int64 {
-	if x != nil {
-		return x.RecommendTeamNum
-	}
-	return 0
+func (x *LogoutReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserInfoReply) GetTotal() string {
-	if x != nil {
-		return x.Total
+func (*LogoutReply) ProtoMessage() {}
+
+func (x *LogoutReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserInfoReply) GetRow() int64 {
-	if x != nil {
-		return x.Row
-	}
-	return 0
+// Deprecated: Use LogoutReply.ProtoReflect.Descriptor instead.
+func (*LogoutReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *UserInfoReply) GetCol() int64 {
-	if x != nil {
-		return x.Col
-	}
-	return 0
+type AdminLoginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminLoginRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *UserInfoReply) GetRecommendTotal() string {
-	if x != nil {
-		return x.RecommendTotal
+func (x *AdminLoginRequest) Reset() {
+	*x = AdminLoginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *UserInfoReply) GetLocationTotal() string {
-	if x != nil {
-		return x.LocationTotal
-	}
-	return ""
-}
-
-func (x *UserInfoReply) GetUsdt() string {
-	if x != nil {
-		return x.Usdt
-	}
-	return ""
-}
-
-func (x *UserInfoReply) GetAccount() string {
-	if x != nil {
-		return x.Account
-	}
-	return ""
-}
-
-func (x *UserInfoReply) GetRecommendNumAll() int64 {
-	if x != nil {
-		return x.RecommendNumAll
-	}
-	return 0
-}
-
-func (x *UserInfoReply) GetRecommendTeamAll() int64 {
-	if x != nil {
-		return x.RecommendTeamAll
-	}
-	return 0
-}
-
-func (x *UserInfoReply) GetAmountB() string {
-	if x != nil {
-		return x.AmountB
-	}
-	return ""
-}
-
-func (x *UserInfoReply) GetRecommendVipTotal() string {
-	if x != nil {
-		return x.RecommendVipTotal
-	}
-	return ""
-}
-
-func (x *UserInfoReply) GetFeeDaily() string {
-	if x != nil {
-		return x.FeeDaily
-	}
-	return ""
-}
-
-func (x *UserInfoReply) GetWithdrawAmount() string {
-	if x != nil {
-		return x.WithdrawAmount
-	}
-	return ""
-}
-
-func (x *UserInfoReply) GetUserCount() string {
-	if x != nil {
-		return x.UserCount
-	}
-	return ""
-}
-
-func (x *UserInfoReply) GetTotalDeposit() string {
-	if x != nil {
-		return x.TotalDeposit
-	}
-	return ""
-}
-
-func (x *UserInfoReply) GetPoolAmount() string {
-	if x != nil {
-		return x.PoolAmount
-	}
-	return ""
-}
-
-func (x *UserInfoReply) GetTopUser() []*UserInfoReply_List {
-	if x != nil {
-		return x.TopUser
-	}
-	return nil
-}
-
-func (x *UserInfoReply) GetLocationCount() int64 {
-	if x != nil {
-		return x.LocationCount
-	}
-	return 0
-}
-
-func (x *UserInfoReply) GetTodayReward() string {
-	if x != nil {
-		return x.TodayReward
-	}
-	return ""
-}
-
-func (x *UserInfoReply) GetRecommendTop() string {
-	if x != nil {
-		return x.RecommendTop
-	}
-	return ""
-}
-
-func (x *UserInfoReply) GetLocationTotalCol() string {
-	if x != nil {
-		return x.LocationTotalCol
-	}
-	return ""
-}
-
-func (x *UserInfoReply) GetLocationTotalRow() string {
-	if x != nil {
-		return x.LocationTotalRow
-	}
-	return ""
-}
-
-func (x *UserInfoReply) GetBnbAmount() string {
-	if x != nil {
-		return x.BnbAmount
-	}
-	return ""
-}
-
-type RewardListRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-}
-
-func (x *RewardListRequest) Reset() {
-	*x = RewardListRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RewardListRequest) String() string {
+func (x *AdminLoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RewardListRequest) ProtoMessage() {}
+func (*AdminLoginRequest) ProtoMessage() {}
 
-func (x *RewardListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[6]
+func (x *AdminLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -568,43 +447,43 @@ func (x *RewardListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RewardListRequest.ProtoReflect.Descriptor instead.
-func (*RewardListRequest) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use AdminLoginRequest.ProtoReflect.Descriptor instead.
+func (*AdminLoginRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *RewardListRequest) GetType() string {
+func (x *AdminLoginRequest) GetSendBody() *AdminLoginRequest_SendBody {
 	if x != nil {
-		return x.Type
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type RewardListReply struct {
+type AdminLoginReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rewards []*RewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *RewardListReply) Reset() {
-	*x = RewardListReply{}
+func (x *AdminLoginReply) Reset() {
+	*x = AdminLoginReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[7]
+		mi := &file_api_app_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RewardListReply) String() string {
+func (x *AdminLoginReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RewardListReply) ProtoMessage() {}
+func (*AdminLoginReply) ProtoMessage() {}
 
-func (x *RewardListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[7]
+func (x *AdminLoginReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -615,41 +494,41 @@ func (x *RewardListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RewardListReply.ProtoReflect.Descriptor instead.
-func (*RewardListReply) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use AdminLoginReply.ProtoReflect.Descriptor instead.
+func (*AdminLoginReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *RewardListReply) GetRewards() []*RewardListReply_List {
+func (x *AdminLoginReply) GetToken() string {
 	if x != nil {
-		return x.Rewards
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-type RecommendRewardListRequest struct {
+type DepositRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *RecommendRewardListRequest) Reset() {
-	*x = RecommendRewardListRequest{}
+func (x *DepositRequest) Reset() {
+	*x = DepositRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[8]
+		mi := &file_api_app_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RecommendRewardListRequest) String() string {
+func (x *DepositRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendRewardListRequest) ProtoMessage() {}
+func (*DepositRequest) ProtoMessage() {}
 
-func (x *RecommendRewardListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[8]
+func (x *DepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -660,36 +539,36 @@ func (x *RecommendRewardListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendRewardListRequest.ProtoReflect.Descriptor instead.
-func (*RecommendRewardListRequest) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use DepositRequest.ProtoReflect.Descriptor instead.
+func (*DepositRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{10}
 }
 
-type RecommendRewardListReply struct {
+type DepositReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rewards []*RecommendRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *RecommendRewardListReply) Reset() {
-	*x = RecommendRewardListReply{}
+func (x *DepositReply) Reset() {
+	*x = DepositReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[9]
+		mi := &file_api_app_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RecommendRewardListReply) String() string {
+func (x *DepositReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendRewardListReply) ProtoMessage() {}
+func (*DepositReply) ProtoMessage() {}
 
-func (x *RecommendRewardListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[9]
+func (x *DepositReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -700,41 +579,41 @@ func (x *RecommendRewardListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendRewardListReply.ProtoReflect.Descriptor instead.
-func (*RecommendRewardListReply) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use DepositReply.ProtoReflect.Descriptor instead.
+func (*DepositReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *RecommendRewardListReply) GetRewards() []*RecommendRewardListReply_List {
+func (x *DepositReply) GetCount() int64 {
 	if x != nil {
-		return x.Rewards
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-type FeeRewardListRequest struct {
+type UserInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *FeeRewardListRequest) Reset() {
-	*x = FeeRewardListRequest{}
+func (x *UserInfoRequest) Reset() {
+	*x = UserInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[10]
+		mi := &file_api_app_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FeeRewardListRequest) String() string {
+func (x *UserInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeeRewardListRequest) ProtoMessage() {}
+func (*UserInfoRequest) ProtoMessage() {}
 
-func (x *FeeRewardListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[10]
+func (x *UserInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -745,36 +624,68 @@ func (x *FeeRewardListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FeeRewardListRequest.ProtoReflect.Descriptor instead.
-func (*FeeRewardListRequest) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use UserInfoRequest.ProtoReflect.Descriptor instead.
+func (*UserInfoRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{12}
 }
 
-type FeeRewardListReply struct {
+type UserInfoReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rewards []*FeeRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Address           string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Level             int64                 `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	Status            string                `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Amount            string                `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	BalanceUsdt       string                `protobuf:"bytes,5,opt,name=balanceUsdt,proto3" json:"balanceUsdt,omitempty"`
+	BalanceDhb        string                `protobuf:"bytes,6,opt,name=balanceDhb,proto3" json:"balanceDhb,omitempty"`
+	InviteUrl         string                `protobuf:"bytes,7,opt,name=inviteUrl,proto3" json:"inviteUrl,omitempty"`
+	InviteUserAddress string                `protobuf:"bytes,8,opt,name=inviteUserAddress,proto3" json:"inviteUserAddress,omitempty"`
+	RecommendNum      int64                 `protobuf:"varint,9,opt,name=recommendNum,proto3" json:"recommendNum,omitempty"`
+	RecommendTeamNum  int64                 `protobuf:"varint,10,opt,name=recommendTeamNum,proto3" json:"recommendTeamNum,omitempty"`
+	Total             string                `protobuf:"bytes,11,opt,name=total,proto3" json:"total,omitempty"`
+	Row               int64                 `protobuf:"varint,12,opt,name=row,proto3" json:"row,omitempty"`
+	Col               int64                 `protobuf:"varint,13,opt,name=col,proto3" json:"col,omitempty"`
+	RecommendTotal    string                `protobuf:"bytes,15,opt,name=recommendTotal,proto3" json:"recommendTotal,omitempty"`
+	LocationTotal     string                `protobuf:"bytes,16,opt,name=locationTotal,proto3" json:"locationTotal,omitempty"`
+	Usdt              string                `protobuf:"bytes,20,opt,name=usdt,proto3" json:"usdt,omitempty"`
+	Account           string                `protobuf:"bytes,22,opt,name=account,proto3" json:"account,omitempty"`
+	RecommendNumAll   int64                 `protobuf:"varint,24,opt,name=recommendNumAll,proto3" json:"recommendNumAll,omitempty"`
+	RecommendTeamAll  int64                 `protobuf:"varint,25,opt,name=recommendTeamAll,proto3" json:"recommendTeamAll,omitempty"`
+	AmountB           string                `protobuf:"bytes,27,opt,name=amountB,proto3" json:"amountB,omitempty"`
+	RecommendVipTotal string                `protobuf:"bytes,28,opt,name=recommendVipTotal,proto3" json:"recommendVipTotal,omitempty"`
+	FeeDaily          string                `protobuf:"bytes,29,opt,name=feeDaily,proto3" json:"feeDaily,omitempty"`
+	WithdrawAmount    string                `protobuf:"bytes,30,opt,name=withdrawAmount,proto3" json:"withdrawAmount,omitempty"`
+	UserCount         string                `protobuf:"bytes,31,opt,name=userCount,proto3" json:"userCount,omitempty"`
+	TotalDeposit      string                `protobuf:"bytes,32,opt,name=totalDeposit,proto3" json:"totalDeposit,omitempty"`
+	PoolAmount        string                `protobuf:"bytes,34,opt,name=poolAmount,proto3" json:"poolAmount,omitempty"`
+	TopUser           []*UserInfoReply_List `protobuf:"bytes,33,rep,name=topUser,proto3" json:"topUser,omitempty"`
+	LocationCount     int64                 `protobuf:"varint,35,opt,name=locationCount,proto3" json:"locationCount,omitempty"`
+	TodayReward       string                `protobuf:"bytes,36,opt,name=todayReward,proto3" json:"todayReward,omitempty"`
+	RecommendTop      string                `protobuf:"bytes,37,opt,name=recommendTop,proto3" json:"recommendTop,omitempty"`
+	LocationTotalCol  string                `protobuf:"bytes,38,opt,name=locationTotalCol,proto3" json:"locationTotalCol,omitempty"`
+	LocationTotalRow  string                `protobuf:"bytes,39,opt,name=locationTotalRow,proto3" json:"locationTotalRow,omitempty"`
+	BnbAmount         string                `protobuf:"bytes,40,opt,name=bnbAmount,proto3" json:"bnbAmount,omitempty"`
 }
 
-func (x *FeeRewardListReply) Reset() {
-	*x = FeeRewardListReply{}
+func (x *UserInfoReply) Reset() {
+	*x = UserInfoReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[11]
+		mi := &file_api_app_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FeeRewardListReply) String() string {
+func (x *UserInfoReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeeRewardListReply) ProtoMessage() {}
+func (*UserInfoReply) ProtoMessage() {}
 
-func (x *FeeRewardListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[11]
+func (x *UserInfoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -785,231 +696,267 @@ func (x *FeeRewardListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FeeRewardListReply.ProtoReflect.Descriptor instead.
-func (*FeeRewardListReply) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use UserInfoReply.ProtoReflect.Descriptor instead.
+func (*UserInfoReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *FeeRewardListReply) GetRewards() []*FeeRewardListReply_List {
+func (x *UserInfoReply) GetAddress() string {
 	if x != nil {
-		return x.Rewards
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-type WithdrawListRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+func (x *UserInfoReply) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
 }
 
-func (x *WithdrawListRequest) Reset() {
-	*x = WithdrawListRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UserInfoReply) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
+	return ""
 }
 
-func (x *WithdrawListRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserInfoReply) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
 }
 
-func (*WithdrawListRequest) ProtoMessage() {}
-
-func (x *WithdrawListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UserInfoReply) GetBalanceUsdt() string {
+	if x != nil {
+		return x.BalanceUsdt
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use WithdrawListRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawListRequest) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{12}
+func (x *UserInfoReply) GetBalanceDhb() string {
+	if x != nil {
+		return x.BalanceDhb
+	}
+	return ""
 }
 
-func (x *WithdrawListRequest) GetType() string {
+func (x *UserInfoReply) GetInviteUrl() string {
 	if x != nil {
-		return x.Type
+		return x.InviteUrl
 	}
 	return ""
 }
 
-type WithdrawListReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Withdraw []*WithdrawListReply_List `protobuf:"bytes,1,rep,name=withdraw,proto3" json:"withdraw,omitempty"`
+func (x *UserInfoReply) GetInviteUserAddress() string {
+	if x != nil {
+		return x.InviteUserAddress
+	}
+	return ""
 }
 
-func (x *WithdrawListReply) Reset() {
-	*x = WithdrawListReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UserInfoReply) GetRecommendNum() int64 {
+	if x != nil {
+		return x.RecommendNum
 	}
+	return 0
 }
 
-func (x *WithdrawListReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserInfoReply) GetRecommendTeamNum() int64 {
+	if x != nil {
+		return x.RecommendTeamNum
+	}
+	return 0
 }
 
-func (*WithdrawListReply) ProtoMessage() {}
-
-func (x *WithdrawListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UserInfoReply) GetTotal() string {
+	if x != nil {
+		return x.Total
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use WithdrawListReply.ProtoReflect.Descriptor instead.
-func (*WithdrawListReply) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{13}
+func (x *UserInfoReply) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
 }
 
-func (x *WithdrawListReply) GetWithdraw() []*WithdrawListReply_List {
+func (x *UserInfoReply) GetCol() int64 {
 	if x != nil {
-		return x.Withdraw
+		return x.Col
 	}
-	return nil
+	return 0
 }
 
-type RecommendListRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+func (x *UserInfoReply) GetRecommendTotal() string {
+	if x != nil {
+		return x.RecommendTotal
+	}
+	return ""
 }
 
-func (x *RecommendListRequest) Reset() {
-	*x = RecommendListRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UserInfoReply) GetLocationTotal() string {
+	if x != nil {
+		return x.LocationTotal
 	}
+	return ""
 }
 
-func (x *RecommendListRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserInfoReply) GetUsdt() string {
+	if x != nil {
+		return x.Usdt
+	}
+	return ""
 }
 
-func (*RecommendListRequest) ProtoMessage() {}
-
-func (x *RecommendListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UserInfoReply) GetAccount() string {
+	if x != nil {
+		return x.Account
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use RecommendListRequest.ProtoReflect.Descriptor instead.
-func (*RecommendListRequest) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{14}
+func (x *UserInfoReply) GetRecommendNumAll() int64 {
+	if x != nil {
+		return x.RecommendNumAll
+	}
+	return 0
 }
 
-func (x *RecommendListRequest) GetType() string {
+func (x *UserInfoReply) GetRecommendTeamAll() int64 {
 	if x != nil {
-		return x.Type
+		return x.RecommendTeamAll
 	}
-	return ""
+	return 0
 }
 
-type RecommendListReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Recommends []*RecommendListReply_List `protobuf:"bytes,1,rep,name=recommends,proto3" json:"recommends,omitempty"`
+func (x *UserInfoReply) GetAmountB() string {
+	if x != nil {
+		return x.AmountB
+	}
+	return ""
 }
 
-func (x *RecommendListReply) Reset() {
-	*x = RecommendListReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UserInfoReply) GetRecommendVipTotal() string {
+	if x != nil {
+		return x.RecommendVipTotal
 	}
+	return ""
 }
 
-func (x *RecommendListReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserInfoReply) GetFeeDaily() string {
+	if x != nil {
+		return x.FeeDaily
+	}
+	return ""
 }
 
-func (*RecommendListReply) ProtoMessage() {}
+func (x *UserInfoReply) GetWithdrawAmount() string {
+	if x != nil {
+		return x.WithdrawAmount
+	}
+	return ""
+}
 
-func (x *RecommendListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UserInfoReply) GetUserCount() string {
+	if x != nil {
+		return x.UserCount
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use RecommendListReply.ProtoReflect.Descriptor instead.
-func (*RecommendListReply) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{15}
+func (x *UserInfoReply) GetTotalDeposit() string {
+	if x != nil {
+		return x.TotalDeposit
+	}
+	return ""
 }
 
-func (x *RecommendListReply) GetRecommends() []*RecommendListReply_List {
+func (x *UserInfoReply) GetPoolAmount() string {
 	if x != nil {
-		return x.Recommends
+		return x.PoolAmount
+	}
+	return ""
+}
+
+func (x *UserInfoReply) GetTopUser() []*UserInfoReply_List {
+	if x != nil {
+		return x.TopUser
 	}
 	return nil
 }
 
-type WithdrawRequest struct {
+func (x *UserInfoReply) GetLocationCount() int64 {
+	if x != nil {
+		return x.LocationCount
+	}
+	return 0
+}
+
+func (x *UserInfoReply) GetTodayReward() string {
+	if x != nil {
+		return x.TodayReward
+	}
+	return ""
+}
+
+func (x *UserInfoReply) GetRecommendTop() string {
+	if x != nil {
+		return x.RecommendTop
+	}
+	return ""
+}
+
+func (x *UserInfoReply) GetLocationTotalCol() string {
+	if x != nil {
+		return x.LocationTotalCol
+	}
+	return ""
+}
+
+func (x *UserInfoReply) GetLocationTotalRow() string {
+	if x != nil {
+		return x.LocationTotalRow
+	}
+	return ""
+}
+
+func (x *UserInfoReply) GetBnbAmount() string {
+	if x != nil {
+		return x.BnbAmount
+	}
+	return ""
+}
+
+type RewardListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *WithdrawRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 }
 
-func (x *WithdrawRequest) Reset() {
-	*x = WithdrawRequest{}
+func (x *RewardListRequest) Reset() {
+	*x = RewardListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[16]
+		mi := &file_api_app_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WithdrawRequest) String() string {
+func (x *RewardListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawRequest) ProtoMessage() {}
+func (*RewardListRequest) ProtoMessage() {}
 
-func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[16]
+func (x *RewardListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1020,43 +967,43 @@ func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawRequest) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use RewardListRequest.ProtoReflect.Descriptor instead.
+func (*RewardListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *WithdrawRequest) GetSendBody() *WithdrawRequest_SendBody {
+func (x *RewardListRequest) GetType() string {
 	if x != nil {
-		return x.SendBody
+		return x.Type
 	}
-	return nil
+	return ""
 }
 
-type WithdrawReply struct {
+type RewardListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Rewards []*RewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
 }
 
-func (x *WithdrawReply) Reset() {
-	*x = WithdrawReply{}
+func (x *RewardListReply) Reset() {
+	*x = RewardListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[17]
+		mi := &file_api_app_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WithdrawReply) String() string {
+func (x *RewardListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawReply) ProtoMessage() {}
+func (*RewardListReply) ProtoMessage() {}
 
-func (x *WithdrawReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[17]
+func (x *RewardListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1067,44 +1014,41 @@ func (x *WithdrawReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawReply.ProtoReflect.Descriptor instead.
-func (*WithdrawReply) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use RewardListReply.ProtoReflect.Descriptor instead.
+func (*RewardListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *WithdrawReply) GetStatus() string {
+func (x *RewardListReply) GetRewards() []*RewardListReply_List {
 	if x != nil {
-		return x.Status
+		return x.Rewards
 	}
-	return ""
+	return nil
 }
 
-type AdminRewardListRequest struct {
+type RecommendRewardListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *AdminRewardListRequest) Reset() {
-	*x = AdminRewardListRequest{}
+func (x *RecommendRewardListRequest) Reset() {
+	*x = RecommendRewardListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[18]
+		mi := &file_api_app_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminRewardListRequest) String() string {
+func (x *RecommendRewardListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminRewardListRequest) ProtoMessage() {}
+func (*RecommendRewardListRequest) ProtoMessage() {}
 
-func (x *AdminRewardListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[18]
+func (x *RecommendRewardListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1115,51 +1059,36 @@ func (x *AdminRewardListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminRewardListRequest.ProtoReflect.Descriptor instead.
-func (*AdminRewardListRequest) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *AdminRewardListRequest) GetPage() int64 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *AdminRewardListRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+// Deprecated: Use RecommendRewardListRequest.ProtoReflect.Descriptor instead.
+func (*RecommendRewardListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{16}
 }
 
-type AdminRewardListReply struct {
+type RecommendRewardListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rewards []*AdminRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
-	Count   int64                        `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Rewards []*RecommendRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
 }
 
-func (x *AdminRewardListReply) Reset() {
-	*x = AdminRewardListReply{}
+func (x *RecommendRewardListReply) Reset() {
+	*x = RecommendRewardListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[19]
+		mi := &file_api_app_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminRewardListReply) String() string {
+func (x *RecommendRewardListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminRewardListReply) ProtoMessage() {}
+func (*RecommendRewardListReply) ProtoMessage() {}
 
-func (x *AdminRewardListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[19]
+func (x *RecommendRewardListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1170,51 +1099,41 @@ func (x *AdminRewardListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminRewardListReply.ProtoReflect.Descriptor instead.
-func (*AdminRewardListReply) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use RecommendRewardListReply.ProtoReflect.Descriptor instead.
+func (*RecommendRewardListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *AdminRewardListReply) GetRewards() []*AdminRewardListReply_List {
+func (x *RecommendRewardListReply) GetRewards() []*RecommendRewardListReply_List {
 	if x != nil {
 		return x.Rewards
 	}
 	return nil
 }
 
-func (x *AdminRewardListReply) GetCount() int64 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-type AdminUserListRequest struct {
+type FeeRewardListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *AdminUserListRequest) Reset() {
-	*x = AdminUserListRequest{}
+func (x *FeeRewardListRequest) Reset() {
+	*x = FeeRewardListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[20]
+		mi := &file_api_app_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminUserListRequest) String() string {
+func (x *FeeRewardListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserListRequest) ProtoMessage() {}
+func (*FeeRewardListRequest) ProtoMessage() {}
 
-func (x *AdminUserListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[20]
+func (x *FeeRewardListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1225,51 +1144,36 @@ func (x *AdminUserListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserListRequest.ProtoReflect.Descriptor instead.
-func (*AdminUserListRequest) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *AdminUserListRequest) GetPage() int64 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *AdminUserListRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+// Deprecated: Use FeeRewardListRequest.ProtoReflect.Descriptor instead.
+func (*FeeRewardListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{18}
 }
 
-type AdminUserListReply struct {
+type FeeRewardListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*AdminUserListReply_UserList `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Count int64                          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Rewards []*FeeRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
 }
 
-func (x *AdminUserListReply) Reset() {
-	*x = AdminUserListReply{}
+func (x *FeeRewardListReply) Reset() {
+	*x = FeeRewardListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[21]
+		mi := &file_api_app_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminUserListReply) String() string {
+func (x *FeeRewardListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserListReply) ProtoMessage() {}
+func (*FeeRewardListReply) ProtoMessage() {}
 
-func (x *AdminUserListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[21]
+func (x *FeeRewardListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1280,51 +1184,43 @@ func (x *AdminUserListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserListReply.ProtoReflect.Descriptor instead.
-func (*AdminUserListReply) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use FeeRewardListReply.ProtoReflect.Descriptor instead.
+func (*FeeRewardListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *AdminUserListReply) GetUsers() []*AdminUserListReply_UserList {
+func (x *FeeRewardListReply) GetRewards() []*FeeRewardListReply_List {
 	if x != nil {
-		return x.Users
+		return x.Rewards
 	}
 	return nil
 }
 
-func (x *AdminUserListReply) GetCount() int64 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-type AdminLocationListRequest struct {
+type WithdrawListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 }
 
-func (x *AdminLocationListRequest) Reset() {
-	*x = AdminLocationListRequest{}
+func (x *WithdrawListRequest) Reset() {
+	*x = WithdrawListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[22]
+		mi := &file_api_app_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminLocationListRequest) String() string {
+func (x *WithdrawListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLocationListRequest) ProtoMessage() {}
+func (*WithdrawListRequest) ProtoMessage() {}
 
-func (x *AdminLocationListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[22]
+func (x *WithdrawListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1335,51 +1231,43 @@ func (x *AdminLocationListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLocationListRequest.ProtoReflect.Descriptor instead.
-func (*AdminLocationListRequest) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *AdminLocationListRequest) GetPage() int64 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+// Deprecated: Use WithdrawListRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *AdminLocationListRequest) GetAddress() string {
+func (x *WithdrawListRequest) GetType() string {
 	if x != nil {
-		return x.Address
+		return x.Type
 	}
 	return ""
 }
 
-type AdminLocationListReply struct {
+type WithdrawListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Locations []*AdminLocationListReply_LocationList `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
-	Count     int64                                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Withdraw []*WithdrawListReply_List `protobuf:"bytes,1,rep,name=withdraw,proto3" json:"withdraw,omitempty"`
 }
 
-func (x *AdminLocationListReply) Reset() {
-	*x = AdminLocationListReply{}
+func (x *WithdrawListReply) Reset() {
+	*x = WithdrawListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[23]
+		mi := &file_api_app_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminLocationListReply) String() string {
+func (x *WithdrawListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLocationListReply) ProtoMessage() {}
+func (*WithdrawListReply) ProtoMessage() {}
 
-func (x *AdminLocationListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[23]
+func (x *WithdrawListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1390,51 +1278,43 @@ func (x *AdminLocationListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLocationListReply.ProtoReflect.Descriptor instead.
-func (*AdminLocationListReply) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use WithdrawListReply.ProtoReflect.Descriptor instead.
+func (*WithdrawListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *AdminLocationListReply) GetLocations() []*AdminLocationListReply_LocationList {
+func (x *WithdrawListReply) GetWithdraw() []*WithdrawListReply_List {
 	if x != nil {
-		return x.Locations
+		return x.Withdraw
 	}
 	return nil
 }
 
-func (x *AdminLocationListReply) GetCount() int64 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-type AdminWithdrawListRequest struct {
+type RecommendListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 }
 
-func (x *AdminWithdrawListRequest) Reset() {
-	*x = AdminWithdrawListRequest{}
+func (x *RecommendListRequest) Reset() {
+	*x = RecommendListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[24]
+		mi := &file_api_app_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminWithdrawListRequest) String() string {
+func (x *RecommendListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawListRequest) ProtoMessage() {}
+func (*RecommendListRequest) ProtoMessage() {}
 
-func (x *AdminWithdrawListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[24]
+func (x *RecommendListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1445,51 +1325,43 @@ func (x *AdminWithdrawListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawListRequest.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawListRequest) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *AdminWithdrawListRequest) GetPage() int64 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+// Deprecated: Use RecommendListRequest.ProtoReflect.Descriptor instead.
+func (*RecommendListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *AdminWithdrawListRequest) GetAddress() string {
+func (x *RecommendListRequest) GetType() string {
 	if x != nil {
-		return x.Address
+		return x.Type
 	}
 	return ""
 }
 
-type AdminWithdrawListReply struct {
+type RecommendListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Withdraw []*AdminWithdrawListReply_List `protobuf:"bytes,1,rep,name=withdraw,proto3" json:"withdraw,omitempty"`
-	Count    int64                          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Recommends []*RecommendListReply_List `protobuf:"bytes,1,rep,name=recommends,proto3" json:"recommends,omitempty"`
 }
 
-func (x *AdminWithdrawListReply) Reset() {
-	*x = AdminWithdrawListReply{}
+func (x *RecommendListReply) Reset() {
+	*x = RecommendListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[25]
+		mi := &file_api_app_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminWithdrawListReply) String() string {
+func (x *RecommendListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawListReply) ProtoMessage() {}
+func (*RecommendListReply) ProtoMessage() {}
 
-func (x *AdminWithdrawListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[25]
+func (x *RecommendListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1500,48 +1372,41 @@ func (x *AdminWithdrawListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawListReply.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawListReply) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use RecommendListReply.ProtoReflect.Descriptor instead.
+func (*RecommendListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *AdminWithdrawListReply) GetWithdraw() []*AdminWithdrawListReply_List {
+func (x *RecommendListReply) GetRecommends() []*RecommendListReply_List {
 	if x != nil {
-		return x.Withdraw
+		return x.Recommends
 	}
 	return nil
 }
 
-func (x *AdminWithdrawListReply) GetCount() int64 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-type AdminWithdrawRequest struct {
+type DepositPendingListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminWithdrawRequest) Reset() {
-	*x = AdminWithdrawRequest{}
+func (x *DepositPendingListRequest) Reset() {
+	*x = DepositPendingListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[26]
+		mi := &file_api_app_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminWithdrawRequest) String() string {
+func (x *DepositPendingListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawRequest) ProtoMessage() {}
+func (*DepositPendingListRequest) ProtoMessage() {}
 
-func (x *AdminWithdrawRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[26]
+func (x *DepositPendingListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1552,34 +1417,36 @@ func (x *AdminWithdrawRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawRequest.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawRequest) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use DepositPendingListRequest.ProtoReflect.Descriptor instead.
+func (*DepositPendingListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{24}
 }
 
-type AdminWithdrawReply struct {
+type DepositPendingListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Deposits []*DepositPendingListReply_List `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
 }
 
-func (x *AdminWithdrawReply) Reset() {
-	*x = AdminWithdrawReply{}
+func (x *DepositPendingListReply) Reset() {
+	*x = DepositPendingListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[27]
+		mi := &file_api_app_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminWithdrawReply) String() string {
+func (x *DepositPendingListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawReply) ProtoMessage() {}
+func (*DepositPendingListReply) ProtoMessage() {}
 
-func (x *AdminWithdrawReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[27]
+func (x *DepositPendingListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1590,34 +1457,41 @@ func (x *AdminWithdrawReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawReply.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawReply) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use DepositPendingListReply.ProtoReflect.Descriptor instead.
+func (*DepositPendingListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{25}
 }
 
-type AdminWithdrawEthRequest struct {
+func (x *DepositPendingListReply) GetDeposits() []*DepositPendingListReply_List {
+	if x != nil {
+		return x.Deposits
+	}
+	return nil
+}
+
+type DepositUnmatchedListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminWithdrawEthRequest) Reset() {
-	*x = AdminWithdrawEthRequest{}
+func (x *DepositUnmatchedListRequest) Reset() {
+	*x = DepositUnmatchedListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[28]
+		mi := &file_api_app_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminWithdrawEthRequest) String() string {
+func (x *DepositUnmatchedListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawEthRequest) ProtoMessage() {}
+func (*DepositUnmatchedListRequest) ProtoMessage() {}
 
-func (x *AdminWithdrawEthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[28]
+func (x *DepositUnmatchedListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1628,34 +1502,36 @@ func (x *AdminWithdrawEthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawEthRequest.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawEthRequest) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use DepositUnmatchedListRequest.ProtoReflect.Descriptor instead.
+func (*DepositUnmatchedListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{26}
 }
 
-type AdminWithdrawEthReply struct {
+type DepositUnmatchedListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Deposits []*DepositUnmatchedListReply_List `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
 }
 
-func (x *AdminWithdrawEthReply) Reset() {
-	*x = AdminWithdrawEthReply{}
+func (x *DepositUnmatchedListReply) Reset() {
+	*x = DepositUnmatchedListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[29]
+		mi := &file_api_app_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminWithdrawEthReply) String() string {
+func (x *DepositUnmatchedListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawEthReply) ProtoMessage() {}
+func (*DepositUnmatchedListReply) ProtoMessage() {}
 
-func (x *AdminWithdrawEthReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[29]
+func (x *DepositUnmatchedListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1666,34 +1542,41 @@ func (x *AdminWithdrawEthReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawEthReply.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawEthReply) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use DepositUnmatchedListReply.ProtoReflect.Descriptor instead.
+func (*DepositUnmatchedListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{27}
 }
 
-type AdminFeeRequest struct {
+func (x *DepositUnmatchedListReply) GetDeposits() []*DepositUnmatchedListReply_List {
+	if x != nil {
+		return x.Deposits
+	}
+	return nil
+}
+
+type ChainListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminFeeRequest) Reset() {
-	*x = AdminFeeRequest{}
+func (x *ChainListRequest) Reset() {
+	*x = ChainListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[30]
+		mi := &file_api_app_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminFeeRequest) String() string {
+func (x *ChainListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminFeeRequest) ProtoMessage() {}
+func (*ChainListRequest) ProtoMessage() {}
 
-func (x *AdminFeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[30]
+func (x *ChainListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1704,34 +1587,36 @@ func (x *AdminFeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminFeeRequest.ProtoReflect.Descriptor instead.
-func (*AdminFeeRequest) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ChainListRequest.ProtoReflect.Descriptor instead.
+func (*ChainListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{28}
 }
 
-type AdminFeeReply struct {
+type ChainListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Chains []*ChainListReply_List `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains,omitempty"` // 第一条为默认链
 }
 
-func (x *AdminFeeReply) Reset() {
-	*x = AdminFeeReply{}
+func (x *ChainListReply) Reset() {
+	*x = ChainListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[31]
+		mi := &file_api_app_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminFeeReply) String() string {
+func (x *ChainListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminFeeReply) ProtoMessage() {}
+func (*ChainListReply) ProtoMessage() {}
 
-func (x *AdminFeeReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[31]
+func (x *ChainListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1742,34 +1627,43 @@ func (x *AdminFeeReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminFeeReply.ProtoReflect.Descriptor instead.
-func (*AdminFeeReply) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ChainListReply.ProtoReflect.Descriptor instead.
+func (*ChainListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{29}
 }
 
-type AdminAllRequest struct {
+func (x *ChainListReply) GetChains() []*ChainListReply_List {
+	if x != nil {
+		return x.Chains
+	}
+	return nil
+}
+
+type DepositAddressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ChainId int64 `protobuf:"varint,1,opt,name=chainId,proto3" json:"chainId,omitempty"` // 为 0 时为默认链
 }
 
-func (x *AdminAllRequest) Reset() {
-	*x = AdminAllRequest{}
+func (x *DepositAddressRequest) Reset() {
+	*x = DepositAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[32]
+		mi := &file_api_app_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminAllRequest) String() string {
+func (x *DepositAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminAllRequest) ProtoMessage() {}
+func (*DepositAddressRequest) ProtoMessage() {}
 
-func (x *AdminAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[32]
+func (x *DepositAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1780,44 +1674,45 @@ func (x *AdminAllRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminAllRequest.ProtoReflect.Descriptor instead.
-func (*AdminAllRequest) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use DepositAddressRequest.ProtoReflect.Descriptor instead.
+func (*DepositAddressRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{30}
 }
 
-type AdminAllReply struct {
+func (x *DepositAddressRequest) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+type DepositAddressReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TodayTotalUser        int64  `protobuf:"varint,1,opt,name=todayTotalUser,proto3" json:"todayTotalUser,omitempty"`
-	TotalUser             int64  `protobuf:"varint,2,opt,name=totalUser,proto3" json:"totalUser,omitempty"`
-	AllBalance            string `protobuf:"bytes,3,opt,name=allBalance,proto3" json:"allBalance,omitempty"`
-	TodayLocation         string `protobuf:"bytes,4,opt,name=todayLocation,proto3" json:"todayLocation,omitempty"`
-	AllLocation           string `protobuf:"bytes,5,opt,name=allLocation,proto3" json:"allLocation,omitempty"`
-	TodayWithdraw         string `protobuf:"bytes,6,opt,name=todayWithdraw,proto3" json:"todayWithdraw,omitempty"`
-	AllWithdraw           string `protobuf:"bytes,7,opt,name=allWithdraw,proto3" json:"allWithdraw,omitempty"`
-	AllReward             string `protobuf:"bytes,8,opt,name=allReward,proto3" json:"allReward,omitempty"`
-	AllSystemRewardAndFee string `protobuf:"bytes,9,opt,name=allSystemRewardAndFee,proto3" json:"allSystemRewardAndFee,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Shared  bool   `protobuf:"varint,2,opt,name=shared,proto3" json:"shared,omitempty"` // true 时为公共收款地址，按发送地址识别用户
+	ChainId int64  `protobuf:"varint,3,opt,name=chainId,proto3" json:"chainId,omitempty"`
 }
 
-func (x *AdminAllReply) Reset() {
-	*x = AdminAllReply{}
+func (x *DepositAddressReply) Reset() {
+	*x = DepositAddressReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[33]
+		mi := &file_api_app_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminAllReply) String() string {
+func (x *DepositAddressReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminAllReply) ProtoMessage() {}
+func (*DepositAddressReply) ProtoMessage() {}
 
-func (x *AdminAllReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[33]
+func (x *DepositAddressReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1828,84 +1723,168 @@ func (x *AdminAllReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminAllReply.ProtoReflect.Descriptor instead.
-func (*AdminAllReply) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use DepositAddressReply.ProtoReflect.Descriptor instead.
+func (*DepositAddressReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *AdminAllReply) GetTodayTotalUser() int64 {
+func (x *DepositAddressReply) GetAddress() string {
 	if x != nil {
-		return x.TodayTotalUser
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-func (x *AdminAllReply) GetTotalUser() int64 {
+func (x *DepositAddressReply) GetShared() bool {
 	if x != nil {
-		return x.TotalUser
+		return x.Shared
 	}
-	return 0
+	return false
 }
 
-func (x *AdminAllReply) GetAllBalance() string {
+func (x *DepositAddressReply) GetChainId() int64 {
 	if x != nil {
-		return x.AllBalance
+		return x.ChainId
 	}
-	return ""
+	return 0
 }
 
-func (x *AdminAllReply) GetTodayLocation() string {
+type SubmitDepositTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *SubmitDepositTxRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+}
+
+func (x *SubmitDepositTxRequest) Reset() {
+	*x = SubmitDepositTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitDepositTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitDepositTxRequest) ProtoMessage() {}
+
+func (x *SubmitDepositTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitDepositTxRequest.ProtoReflect.Descriptor instead.
+func (*SubmitDepositTxRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SubmitDepositTxRequest) GetSendBody() *SubmitDepositTxRequest_SendBody {
 	if x != nil {
-		return x.TodayLocation
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-func (x *AdminAllReply) GetAllLocation() string {
+type SubmitDepositTxReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status        string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // pending 未达到确认数 | success 已入账 | unmatched 未匹配档位 | unpaired 等待组合档位的另一笔
+	CoinType      string `protobuf:"bytes,2,opt,name=coinType,proto3" json:"coinType,omitempty"`
+	Amount        string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Confirmations int64  `protobuf:"varint,4,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Required      int64  `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+}
+
+func (x *SubmitDepositTxReply) Reset() {
+	*x = SubmitDepositTxReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitDepositTxReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitDepositTxReply) ProtoMessage() {}
+
+func (x *SubmitDepositTxReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitDepositTxReply.ProtoReflect.Descriptor instead.
+func (*SubmitDepositTxReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SubmitDepositTxReply) GetStatus() string {
 	if x != nil {
-		return x.AllLocation
+		return x.Status
 	}
 	return ""
 }
 
-func (x *AdminAllReply) GetTodayWithdraw() string {
+func (x *SubmitDepositTxReply) GetCoinType() string {
 	if x != nil {
-		return x.TodayWithdraw
+		return x.CoinType
 	}
 	return ""
 }
 
-func (x *AdminAllReply) GetAllWithdraw() string {
+func (x *SubmitDepositTxReply) GetAmount() string {
 	if x != nil {
-		return x.AllWithdraw
+		return x.Amount
 	}
 	return ""
 }
 
-func (x *AdminAllReply) GetAllReward() string {
+func (x *SubmitDepositTxReply) GetConfirmations() int64 {
 	if x != nil {
-		return x.AllReward
+		return x.Confirmations
 	}
-	return ""
+	return 0
 }
 
-func (x *AdminAllReply) GetAllSystemRewardAndFee() string {
+func (x *SubmitDepositTxReply) GetRequired() int64 {
 	if x != nil {
-		return x.AllSystemRewardAndFee
+		return x.Required
 	}
-	return ""
+	return 0
 }
 
-type AdminUserRecommendRequest struct {
+type DepositWebhookRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SendBody *DepositWebhookRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminUserRecommendRequest) Reset() {
-	*x = AdminUserRecommendRequest{}
+func (x *DepositWebhookRequest) Reset() {
+	*x = DepositWebhookRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1913,13 +1892,13 @@ func (x *AdminUserRecommendRequest) Reset() {
 	}
 }
 
-func (x *AdminUserRecommendRequest) String() string {
+func (x *DepositWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserRecommendRequest) ProtoMessage() {}
+func (*DepositWebhookRequest) ProtoMessage() {}
 
-func (x *AdminUserRecommendRequest) ProtoReflect() protoreflect.Message {
+func (x *DepositWebhookRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1931,28 +1910,30 @@ func (x *AdminUserRecommendRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserRecommendRequest.ProtoReflect.Descriptor instead.
-func (*AdminUserRecommendRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DepositWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DepositWebhookRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *AdminUserRecommendRequest) GetUserId() int64 {
+func (x *DepositWebhookRequest) GetSendBody() *DepositWebhookRequest_SendBody {
 	if x != nil {
-		return x.UserId
+		return x.SendBody
 	}
-	return 0
+	return nil
 }
 
-type AdminUserRecommendReply struct {
+type DepositWebhookReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*AdminUserRecommendReply_List `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Count   int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`     // 入账条数
+	Pending int64 `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"` // 未达到确认数，记为 pending 的条数
+	Ignored int64 `protobuf:"varint,3,opt,name=ignored,proto3" json:"ignored,omitempty"` // 非充值代币、非收款地址或批次内重复的条数
 }
 
-func (x *AdminUserRecommendReply) Reset() {
-	*x = AdminUserRecommendReply{}
+func (x *DepositWebhookReply) Reset() {
+	*x = DepositWebhookReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1960,13 +1941,13 @@ func (x *AdminUserRecommendReply) Reset() {
 	}
 }
 
-func (x *AdminUserRecommendReply) String() string {
+func (x *DepositWebhookReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserRecommendReply) ProtoMessage() {}
+func (*DepositWebhookReply) ProtoMessage() {}
 
-func (x *AdminUserRecommendReply) ProtoReflect() protoreflect.Message {
+func (x *DepositWebhookReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1978,29 +1959,42 @@ func (x *AdminUserRecommendReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserRecommendReply.ProtoReflect.Descriptor instead.
-func (*AdminUserRecommendReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use DepositWebhookReply.ProtoReflect.Descriptor instead.
+func (*DepositWebhookReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *AdminUserRecommendReply) GetUsers() []*AdminUserRecommendReply_List {
+func (x *DepositWebhookReply) GetCount() int64 {
 	if x != nil {
-		return x.Users
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-type AdminMonthRecommendRequest struct {
+func (x *DepositWebhookReply) GetPending() int64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *DepositWebhookReply) GetIgnored() int64 {
+	if x != nil {
+		return x.Ignored
+	}
+	return 0
+}
+
+type WithdrawRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Page    int64  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	SendBody *WithdrawRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminMonthRecommendRequest) Reset() {
-	*x = AdminMonthRecommendRequest{}
+func (x *WithdrawRequest) Reset() {
+	*x = WithdrawRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2008,13 +2002,13 @@ func (x *AdminMonthRecommendRequest) Reset() {
 	}
 }
 
-func (x *AdminMonthRecommendRequest) String() string {
+func (x *WithdrawRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminMonthRecommendRequest) ProtoMessage() {}
+func (*WithdrawRequest) ProtoMessage() {}
 
-func (x *AdminMonthRecommendRequest) ProtoReflect() protoreflect.Message {
+func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2026,36 +2020,28 @@ func (x *AdminMonthRecommendRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminMonthRecommendRequest.ProtoReflect.Descriptor instead.
-func (*AdminMonthRecommendRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *AdminMonthRecommendRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *AdminMonthRecommendRequest) GetPage() int64 {
+func (x *WithdrawRequest) GetSendBody() *WithdrawRequest_SendBody {
 	if x != nil {
-		return x.Page
+		return x.SendBody
 	}
-	return 0
+	return nil
 }
 
-type AdminMonthRecommendReply struct {
+type WithdrawReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*AdminMonthRecommendReply_List `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Count int64                            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *AdminMonthRecommendReply) Reset() {
-	*x = AdminMonthRecommendReply{}
+func (x *WithdrawReply) Reset() {
+	*x = WithdrawReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2063,13 +2049,13 @@ func (x *AdminMonthRecommendReply) Reset() {
 	}
 }
 
-func (x *AdminMonthRecommendReply) String() string {
+func (x *WithdrawReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminMonthRecommendReply) ProtoMessage() {}
+func (*WithdrawReply) ProtoMessage() {}
 
-func (x *AdminMonthRecommendReply) ProtoReflect() protoreflect.Message {
+func (x *WithdrawReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2081,36 +2067,28 @@ func (x *AdminMonthRecommendReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminMonthRecommendReply.ProtoReflect.Descriptor instead.
-func (*AdminMonthRecommendReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawReply.ProtoReflect.Descriptor instead.
+func (*WithdrawReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *AdminMonthRecommendReply) GetUsers() []*AdminMonthRecommendReply_List {
+func (x *WithdrawReply) GetStatus() string {
 	if x != nil {
-		return x.Users
+		return x.Status
 	}
-	return nil
-}
-
-func (x *AdminMonthRecommendReply) GetCount() int64 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
+	return ""
 }
 
-type AdminConfigRequest struct {
+type WithdrawCancelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Page   int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	SendBody *WithdrawCancelRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminConfigRequest) Reset() {
-	*x = AdminConfigRequest{}
+func (x *WithdrawCancelRequest) Reset() {
+	*x = WithdrawCancelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2118,13 +2096,13 @@ func (x *AdminConfigRequest) Reset() {
 	}
 }
 
-func (x *AdminConfigRequest) String() string {
+func (x *WithdrawCancelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigRequest) ProtoMessage() {}
+func (*WithdrawCancelRequest) ProtoMessage() {}
 
-func (x *AdminConfigRequest) ProtoReflect() protoreflect.Message {
+func (x *WithdrawCancelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2136,36 +2114,26 @@ func (x *AdminConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigRequest.ProtoReflect.Descriptor instead.
-func (*AdminConfigRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawCancelRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawCancelRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *AdminConfigRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *AdminConfigRequest) GetPage() int64 {
+func (x *WithdrawCancelRequest) GetSendBody() *WithdrawCancelRequest_SendBody {
 	if x != nil {
-		return x.Page
+		return x.SendBody
 	}
-	return 0
+	return nil
 }
 
-type AdminConfigReply struct {
+type WithdrawCancelReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Config []*AdminConfigReply_List `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty"`
-	Count  int64                    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AdminConfigReply) Reset() {
-	*x = AdminConfigReply{}
+func (x *WithdrawCancelReply) Reset() {
+	*x = WithdrawCancelReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2173,13 +2141,13 @@ func (x *AdminConfigReply) Reset() {
 	}
 }
 
-func (x *AdminConfigReply) String() string {
+func (x *WithdrawCancelReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigReply) ProtoMessage() {}
+func (*WithdrawCancelReply) ProtoMessage() {}
 
-func (x *AdminConfigReply) ProtoReflect() protoreflect.Message {
+func (x *WithdrawCancelReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2191,35 +2159,22 @@ func (x *AdminConfigReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigReply.ProtoReflect.Descriptor instead.
-func (*AdminConfigReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawCancelReply.ProtoReflect.Descriptor instead.
+func (*WithdrawCancelReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *AdminConfigReply) GetConfig() []*AdminConfigReply_List {
-	if x != nil {
-		return x.Config
-	}
-	return nil
-}
-
-func (x *AdminConfigReply) GetCount() int64 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-type AdminConfigUpdateRequest struct {
+type AdminRewardListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *AdminConfigUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *AdminConfigUpdateRequest) Reset() {
-	*x = AdminConfigUpdateRequest{}
+func (x *AdminRewardListRequest) Reset() {
+	*x = AdminRewardListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2227,13 +2182,13 @@ func (x *AdminConfigUpdateRequest) Reset() {
 	}
 }
 
-func (x *AdminConfigUpdateRequest) String() string {
+func (x *AdminRewardListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigUpdateRequest) ProtoMessage() {}
+func (*AdminRewardListRequest) ProtoMessage() {}
 
-func (x *AdminConfigUpdateRequest) ProtoReflect() protoreflect.Message {
+func (x *AdminRewardListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2245,26 +2200,36 @@ func (x *AdminConfigUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigUpdateRequest.ProtoReflect.Descriptor instead.
-func (*AdminConfigUpdateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminRewardListRequest.ProtoReflect.Descriptor instead.
+func (*AdminRewardListRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *AdminConfigUpdateRequest) GetSendBody() *AdminConfigUpdateRequest_SendBody {
+func (x *AdminRewardListRequest) GetPage() int64 {
 	if x != nil {
-		return x.SendBody
+		return x.Page
 	}
-	return nil
+	return 0
 }
 
-type AdminConfigUpdateReply struct {
+func (x *AdminRewardListRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type AdminRewardListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Rewards []*AdminRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Count   int64                        `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AdminConfigUpdateReply) Reset() {
-	*x = AdminConfigUpdateReply{}
+func (x *AdminRewardListReply) Reset() {
+	*x = AdminRewardListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2272,13 +2237,13 @@ func (x *AdminConfigUpdateReply) Reset() {
 	}
 }
 
-func (x *AdminConfigUpdateReply) String() string {
+func (x *AdminRewardListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigUpdateReply) ProtoMessage() {}
+func (*AdminRewardListReply) ProtoMessage() {}
 
-func (x *AdminConfigUpdateReply) ProtoReflect() protoreflect.Message {
+func (x *AdminRewardListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2290,22 +2255,36 @@ func (x *AdminConfigUpdateReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigUpdateReply.ProtoReflect.Descriptor instead.
-func (*AdminConfigUpdateReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminRewardListReply.ProtoReflect.Descriptor instead.
+func (*AdminRewardListReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{41}
 }
 
-type EthAuthorizeRequest_SendBody struct {
+func (x *AdminRewardListReply) GetRewards() []*AdminRewardListReply_List {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *AdminRewardListReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminUserListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Code    string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *EthAuthorizeRequest_SendBody) Reset() {
-	*x = EthAuthorizeRequest_SendBody{}
+func (x *AdminUserListRequest) Reset() {
+	*x = AdminUserListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2313,13 +2292,13 @@ func (x *EthAuthorizeRequest_SendBody) Reset() {
 	}
 }
 
-func (x *EthAuthorizeRequest_SendBody) String() string {
+func (x *AdminUserListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EthAuthorizeRequest_SendBody) ProtoMessage() {}
+func (*AdminUserListRequest) ProtoMessage() {}
 
-func (x *EthAuthorizeRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminUserListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2331,37 +2310,36 @@ func (x *EthAuthorizeRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EthAuthorizeRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*EthAuthorizeRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use AdminUserListRequest.ProtoReflect.Descriptor instead.
+func (*AdminUserListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *EthAuthorizeRequest_SendBody) GetAddress() string {
+func (x *AdminUserListRequest) GetPage() int64 {
 	if x != nil {
-		return x.Address
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *EthAuthorizeRequest_SendBody) GetCode() string {
+func (x *AdminUserListRequest) GetAddress() string {
 	if x != nil {
-		return x.Code
+		return x.Address
 	}
 	return ""
 }
 
-type UserInfoReply_List struct {
+type AdminUserListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account         string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	RecommendReward string `protobuf:"bytes,2,opt,name=recommendReward,proto3" json:"recommendReward,omitempty"`
-	Reward          string `protobuf:"bytes,3,opt,name=reward,proto3" json:"reward,omitempty"`
+	Users []*AdminUserListReply_UserList `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Count int64                          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *UserInfoReply_List) Reset() {
-	*x = UserInfoReply_List{}
+func (x *AdminUserListReply) Reset() {
+	*x = AdminUserListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2369,13 +2347,13 @@ func (x *UserInfoReply_List) Reset() {
 	}
 }
 
-func (x *UserInfoReply_List) String() string {
+func (x *AdminUserListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoReply_List) ProtoMessage() {}
+func (*AdminUserListReply) ProtoMessage() {}
 
-func (x *UserInfoReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminUserListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2387,45 +2365,36 @@ func (x *UserInfoReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoReply_List.ProtoReflect.Descriptor instead.
-func (*UserInfoReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{5, 0}
-}
-
-func (x *UserInfoReply_List) GetAccount() string {
-	if x != nil {
-		return x.Account
-	}
-	return ""
+// Deprecated: Use AdminUserListReply.ProtoReflect.Descriptor instead.
+func (*AdminUserListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *UserInfoReply_List) GetRecommendReward() string {
+func (x *AdminUserListReply) GetUsers() []*AdminUserListReply_UserList {
 	if x != nil {
-		return x.RecommendReward
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-func (x *UserInfoReply_List) GetReward() string {
+func (x *AdminUserListReply) GetCount() int64 {
 	if x != nil {
-		return x.Reward
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type RewardListReply_List struct {
+type AdminLocationListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt      string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount         string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	LocationStatus string `protobuf:"bytes,3,opt,name=locationStatus,proto3" json:"locationStatus,omitempty"`
-	Type           string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *RewardListReply_List) Reset() {
-	*x = RewardListReply_List{}
+func (x *AdminLocationListRequest) Reset() {
+	*x = AdminLocationListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2433,13 +2402,13 @@ func (x *RewardListReply_List) Reset() {
 	}
 }
 
-func (x *RewardListReply_List) String() string {
+func (x *AdminLocationListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RewardListReply_List) ProtoMessage() {}
+func (*AdminLocationListRequest) ProtoMessage() {}
 
-func (x *RewardListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminLocationListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2451,52 +2420,36 @@ func (x *RewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RewardListReply_List.ProtoReflect.Descriptor instead.
-func (*RewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{7, 0}
-}
-
-func (x *RewardListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *RewardListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+// Deprecated: Use AdminLocationListRequest.ProtoReflect.Descriptor instead.
+func (*AdminLocationListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *RewardListReply_List) GetLocationStatus() string {
+func (x *AdminLocationListRequest) GetPage() int64 {
 	if x != nil {
-		return x.LocationStatus
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *RewardListReply_List) GetType() string {
+func (x *AdminLocationListRequest) GetAddress() string {
 	if x != nil {
-		return x.Type
+		return x.Address
 	}
 	return ""
 }
 
-type RecommendRewardListReply_List struct {
+type AdminLocationListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Type      string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Locations []*AdminLocationListReply_LocationList `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
+	Count     int64                                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *RecommendRewardListReply_List) Reset() {
-	*x = RecommendRewardListReply_List{}
+func (x *AdminLocationListReply) Reset() {
+	*x = AdminLocationListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2504,13 +2457,13 @@ func (x *RecommendRewardListReply_List) Reset() {
 	}
 }
 
-func (x *RecommendRewardListReply_List) String() string {
+func (x *AdminLocationListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendRewardListReply_List) ProtoMessage() {}
+func (*AdminLocationListReply) ProtoMessage() {}
 
-func (x *RecommendRewardListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminLocationListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2522,50 +2475,36 @@ func (x *RecommendRewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendRewardListReply_List.ProtoReflect.Descriptor instead.
-func (*RecommendRewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{9, 0}
-}
-
-func (x *RecommendRewardListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *RecommendRewardListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+// Deprecated: Use AdminLocationListReply.ProtoReflect.Descriptor instead.
+func (*AdminLocationListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *RecommendRewardListReply_List) GetType() string {
+func (x *AdminLocationListReply) GetLocations() []*AdminLocationListReply_LocationList {
 	if x != nil {
-		return x.Type
+		return x.Locations
 	}
-	return ""
+	return nil
 }
 
-func (x *RecommendRewardListReply_List) GetReason() string {
+func (x *AdminLocationListReply) GetCount() int64 {
 	if x != nil {
-		return x.Reason
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type FeeRewardListReply_List struct {
+type AdminWithdrawListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *FeeRewardListReply_List) Reset() {
-	*x = FeeRewardListReply_List{}
+func (x *AdminWithdrawListRequest) Reset() {
+	*x = AdminWithdrawListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2573,13 +2512,13 @@ func (x *FeeRewardListReply_List) Reset() {
 	}
 }
 
-func (x *FeeRewardListReply_List) String() string {
+func (x *AdminWithdrawListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeeRewardListReply_List) ProtoMessage() {}
+func (*AdminWithdrawListRequest) ProtoMessage() {}
 
-func (x *FeeRewardListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2591,38 +2530,36 @@ func (x *FeeRewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FeeRewardListReply_List.ProtoReflect.Descriptor instead.
-func (*FeeRewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{11, 0}
+// Deprecated: Use AdminWithdrawListRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *FeeRewardListReply_List) GetCreatedAt() string {
+func (x *AdminWithdrawListRequest) GetPage() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *FeeRewardListReply_List) GetAmount() string {
+func (x *AdminWithdrawListRequest) GetAddress() string {
 	if x != nil {
-		return x.Amount
+		return x.Address
 	}
 	return ""
 }
 
-type WithdrawListReply_List struct {
+type AdminWithdrawListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Withdraw []*AdminWithdrawListReply_List `protobuf:"bytes,1,rep,name=withdraw,proto3" json:"withdraw,omitempty"`
+	Count    int64                          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *WithdrawListReply_List) Reset() {
-	*x = WithdrawListReply_List{}
+func (x *AdminWithdrawListReply) Reset() {
+	*x = AdminWithdrawListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2630,13 +2567,13 @@ func (x *WithdrawListReply_List) Reset() {
 	}
 }
 
-func (x *WithdrawListReply_List) String() string {
+func (x *AdminWithdrawListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawListReply_List) ProtoMessage() {}
+func (*AdminWithdrawListReply) ProtoMessage() {}
 
-func (x *WithdrawListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2648,50 +2585,33 @@ func (x *WithdrawListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawListReply_List.ProtoReflect.Descriptor instead.
-func (*WithdrawListReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{13, 0}
-}
-
-func (x *WithdrawListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *WithdrawListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+// Deprecated: Use AdminWithdrawListReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *WithdrawListReply_List) GetType() string {
+func (x *AdminWithdrawListReply) GetWithdraw() []*AdminWithdrawListReply_List {
 	if x != nil {
-		return x.Type
+		return x.Withdraw
 	}
-	return ""
+	return nil
 }
 
-func (x *WithdrawListReply_List) GetStatus() string {
+func (x *AdminWithdrawListReply) GetCount() int64 {
 	if x != nil {
-		return x.Status
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type RecommendListReply_List struct {
+type AdminWithdrawRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *RecommendListReply_List) Reset() {
-	*x = RecommendListReply_List{}
+func (x *AdminWithdrawRequest) Reset() {
+	*x = AdminWithdrawRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2699,13 +2619,13 @@ func (x *RecommendListReply_List) Reset() {
 	}
 }
 
-func (x *RecommendListReply_List) String() string {
+func (x *AdminWithdrawRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendListReply_List) ProtoMessage() {}
+func (*AdminWithdrawRequest) ProtoMessage() {}
 
-func (x *RecommendListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2717,36 +2637,21 @@ func (x *RecommendListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendListReply_List.ProtoReflect.Descriptor instead.
-func (*RecommendListReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{15, 0}
-}
-
-func (x *RecommendListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
-}
-
-func (x *RecommendListReply_List) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+// Deprecated: Use AdminWithdrawRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{48}
 }
 
-type WithdrawRequest_SendBody struct {
+type AdminWithdrawReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *WithdrawRequest_SendBody) Reset() {
-	*x = WithdrawRequest_SendBody{}
+func (x *AdminWithdrawReply) Reset() {
+	*x = AdminWithdrawReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2754,13 +2659,13 @@ func (x *WithdrawRequest_SendBody) Reset() {
 	}
 }
 
-func (x *WithdrawRequest_SendBody) String() string {
+func (x *AdminWithdrawReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawRequest_SendBody) ProtoMessage() {}
+func (*AdminWithdrawReply) ProtoMessage() {}
 
-func (x *WithdrawRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2772,39 +2677,26 @@ func (x *WithdrawRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*WithdrawRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{16, 0}
-}
-
-func (x *WithdrawRequest_SendBody) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// Deprecated: Use AdminWithdrawReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *WithdrawRequest_SendBody) GetAmount() string {
+func (x *AdminWithdrawReply) GetCount() int64 {
 	if x != nil {
-		return x.Amount
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type AdminRewardListReply_List struct {
+type AdminWithdrawReceiptRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Type      string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	Reason    string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *AdminRewardListReply_List) Reset() {
-	*x = AdminRewardListReply_List{}
+func (x *AdminWithdrawReceiptRequest) Reset() {
+	*x = AdminWithdrawReceiptRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2812,13 +2704,13 @@ func (x *AdminRewardListReply_List) Reset() {
 	}
 }
 
-func (x *AdminRewardListReply_List) String() string {
+func (x *AdminWithdrawReceiptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminRewardListReply_List) ProtoMessage() {}
+func (*AdminWithdrawReceiptRequest) ProtoMessage() {}
 
-func (x *AdminRewardListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawReceiptRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2830,78 +2722,83 @@ func (x *AdminRewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminRewardListReply_List.ProtoReflect.Descriptor instead.
-func (*AdminRewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{19, 0}
+// Deprecated: Use AdminWithdrawReceiptRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *AdminRewardListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+type AdminWithdrawReceiptReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AdminRewardListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
+func (x *AdminWithdrawReceiptReply) Reset() {
+	*x = AdminWithdrawReceiptReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AdminRewardListReply_List) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+func (x *AdminWithdrawReceiptReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AdminRewardListReply_List) GetAddress() string {
-	if x != nil {
-		return x.Address
+func (*AdminWithdrawReceiptReply) ProtoMessage() {}
+
+func (x *AdminWithdrawReceiptReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AdminRewardListReply_List) GetReason() string {
+// Deprecated: Use AdminWithdrawReceiptReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawReceiptReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AdminWithdrawReceiptReply) GetCount() int64 {
 	if x != nil {
-		return x.Reason
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type AdminUserListReply_UserList struct {
+type AdminWithdrawUpdateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId           int64  `protobuf:"varint,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CreatedAt        string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Address          string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	BalanceUsdt      string `protobuf:"bytes,3,opt,name=balanceUsdt,proto3" json:"balanceUsdt,omitempty"`
-	BalanceDhb       string `protobuf:"bytes,4,opt,name=balanceDhb,proto3" json:"balanceDhb,omitempty"`
-	Vip              int64  `protobuf:"varint,5,opt,name=vip,proto3" json:"vip,omitempty"`
-	MonthRecommend   int64  `protobuf:"varint,7,opt,name=monthRecommend,proto3" json:"monthRecommend,omitempty"`
-	HistoryRecommend int64  `protobuf:"varint,6,opt,name=historyRecommend,proto3" json:"historyRecommend,omitempty"`
+	SendBody *AdminWithdrawUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminUserListReply_UserList) Reset() {
-	*x = AdminUserListReply_UserList{}
+func (x *AdminWithdrawUpdateRequest) Reset() {
+	*x = AdminWithdrawUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[51]
+		mi := &file_api_app_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminUserListReply_UserList) String() string {
+func (x *AdminWithdrawUpdateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserListReply_UserList) ProtoMessage() {}
+func (*AdminWithdrawUpdateRequest) ProtoMessage() {}
 
-func (x *AdminUserListReply_UserList) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[51]
+func (x *AdminWithdrawUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2912,99 +2809,90 @@ func (x *AdminUserListReply_UserList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserListReply_UserList.ProtoReflect.Descriptor instead.
-func (*AdminUserListReply_UserList) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{21, 0}
+// Deprecated: Use AdminWithdrawUpdateRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *AdminUserListReply_UserList) GetUserId() int64 {
+func (x *AdminWithdrawUpdateRequest) GetSendBody() *AdminWithdrawUpdateRequest_SendBody {
 	if x != nil {
-		return x.UserId
+		return x.SendBody
 	}
-	return 0
+	return nil
 }
 
-func (x *AdminUserListReply_UserList) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
+type AdminWithdrawUpdateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *AdminUserListReply_UserList) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *AdminUserListReply_UserList) GetBalanceUsdt() string {
-	if x != nil {
-		return x.BalanceUsdt
+func (x *AdminWithdrawUpdateReply) Reset() {
+	*x = AdminWithdrawUpdateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AdminUserListReply_UserList) GetBalanceDhb() string {
-	if x != nil {
-		return x.BalanceDhb
-	}
-	return ""
+func (x *AdminWithdrawUpdateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AdminUserListReply_UserList) GetVip() int64 {
-	if x != nil {
-		return x.Vip
+func (*AdminWithdrawUpdateReply) ProtoMessage() {}
+
+func (x *AdminWithdrawUpdateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AdminUserListReply_UserList) GetMonthRecommend() int64 {
-	if x != nil {
-		return x.MonthRecommend
-	}
-	return 0
+// Deprecated: Use AdminWithdrawUpdateReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawUpdateReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *AdminUserListReply_UserList) GetHistoryRecommend() int64 {
+func (x *AdminWithdrawUpdateReply) GetStatus() string {
 	if x != nil {
-		return x.HistoryRecommend
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-type AdminLocationListReply_LocationList struct {
+type AdminWithdrawEventListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt    string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Address      string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Row          int64  `protobuf:"varint,3,opt,name=row,proto3" json:"row,omitempty"`
-	Col          int64  `protobuf:"varint,4,opt,name=col,proto3" json:"col,omitempty"`
-	Status       string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	CurrentLevel int64  `protobuf:"varint,6,opt,name=currentLevel,proto3" json:"currentLevel,omitempty"`
-	Current      string `protobuf:"bytes,7,opt,name=current,proto3" json:"current,omitempty"`
-	CurrentMax   string `protobuf:"bytes,8,opt,name=currentMax,proto3" json:"currentMax,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *AdminLocationListReply_LocationList) Reset() {
-	*x = AdminLocationListReply_LocationList{}
+func (x *AdminWithdrawEventListRequest) Reset() {
+	*x = AdminWithdrawEventListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[52]
+		mi := &file_api_app_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminLocationListReply_LocationList) String() string {
+func (x *AdminWithdrawEventListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLocationListReply_LocationList) ProtoMessage() {}
+func (*AdminWithdrawEventListRequest) ProtoMessage() {}
 
-func (x *AdminLocationListReply_LocationList) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[52]
+func (x *AdminWithdrawEventListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3015,98 +2903,88 @@ func (x *AdminLocationListReply_LocationList) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLocationListReply_LocationList.ProtoReflect.Descriptor instead.
-func (*AdminLocationListReply_LocationList) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{23, 0}
+// Deprecated: Use AdminWithdrawEventListRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawEventListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *AdminLocationListReply_LocationList) GetCreatedAt() string {
+func (x *AdminWithdrawEventListRequest) GetId() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *AdminLocationListReply_LocationList) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
+type AdminWithdrawEventListReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *AdminLocationListReply_LocationList) GetRow() int64 {
-	if x != nil {
-		return x.Row
-	}
-	return 0
+	Events []*AdminWithdrawEventListReply_List `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 }
 
-func (x *AdminLocationListReply_LocationList) GetCol() int64 {
-	if x != nil {
-		return x.Col
+func (x *AdminWithdrawEventListReply) Reset() {
+	*x = AdminWithdrawEventListReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *AdminLocationListReply_LocationList) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+func (x *AdminWithdrawEventListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AdminLocationListReply_LocationList) GetCurrentLevel() int64 {
-	if x != nil {
-		return x.CurrentLevel
+func (*AdminWithdrawEventListReply) ProtoMessage() {}
+
+func (x *AdminWithdrawEventListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AdminLocationListReply_LocationList) GetCurrent() string {
-	if x != nil {
-		return x.Current
-	}
-	return ""
+// Deprecated: Use AdminWithdrawEventListReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawEventListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *AdminLocationListReply_LocationList) GetCurrentMax() string {
+func (x *AdminWithdrawEventListReply) GetEvents() []*AdminWithdrawEventListReply_List {
 	if x != nil {
-		return x.CurrentMax
+		return x.Events
 	}
-	return ""
+	return nil
 }
 
-type AdminWithdrawListReply_List struct {
+type AdminWithdrawEthRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	Id        int64  `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	RelAmount string `protobuf:"bytes,6,opt,name=relAmount,proto3" json:"relAmount,omitempty"`
-	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *AdminWithdrawListReply_List) Reset() {
-	*x = AdminWithdrawListReply_List{}
+func (x *AdminWithdrawEthRequest) Reset() {
+	*x = AdminWithdrawEthRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[53]
+		mi := &file_api_app_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminWithdrawListReply_List) String() string {
+func (x *AdminWithdrawEthRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawListReply_List) ProtoMessage() {}
+func (*AdminWithdrawEthRequest) ProtoMessage() {}
 
-func (x *AdminWithdrawListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[53]
+func (x *AdminWithdrawEthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3117,88 +2995,81 @@ func (x *AdminWithdrawListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawListReply_List.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawListReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{25, 0}
+// Deprecated: Use AdminWithdrawEthRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawEthRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *AdminWithdrawListReply_List) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
+type AdminWithdrawEthReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *AdminWithdrawListReply_List) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AdminWithdrawListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
+func (x *AdminWithdrawEthReply) Reset() {
+	*x = AdminWithdrawEthReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AdminWithdrawListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+func (x *AdminWithdrawEthReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AdminWithdrawListReply_List) GetRelAmount() string {
-	if x != nil {
-		return x.RelAmount
+func (*AdminWithdrawEthReply) ProtoMessage() {}
+
+func (x *AdminWithdrawEthReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AdminWithdrawListReply_List) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// Deprecated: Use AdminWithdrawEthReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawEthReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *AdminWithdrawListReply_List) GetStatus() string {
+func (x *AdminWithdrawEthReply) GetCount() int64 {
 	if x != nil {
-		return x.Status
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type AdminUserRecommendReply_List struct {
+type AdminFeeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserId    int64  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Address   string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Id        int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *AdminUserRecommendReply_List) Reset() {
-	*x = AdminUserRecommendReply_List{}
+func (x *AdminFeeRequest) Reset() {
+	*x = AdminFeeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[54]
+		mi := &file_api_app_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminUserRecommendReply_List) String() string {
+func (x *AdminFeeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserRecommendReply_List) ProtoMessage() {}
+func (*AdminFeeRequest) ProtoMessage() {}
 
-func (x *AdminUserRecommendReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[54]
+func (x *AdminFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3209,67 +3080,84 @@ func (x *AdminUserRecommendReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserRecommendReply_List.ProtoReflect.Descriptor instead.
-func (*AdminUserRecommendReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{35, 0}
+// Deprecated: Use AdminFeeRequest.ProtoReflect.Descriptor instead.
+func (*AdminFeeRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *AdminUserRecommendReply_List) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+type AdminFeeReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AdminUserRecommendReply_List) GetAddress() string {
-	if x != nil {
-		return x.Address
+func (x *AdminFeeReply) Reset() {
+	*x = AdminFeeReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AdminUserRecommendReply_List) GetId() int64 {
-	if x != nil {
-		return x.Id
+func (x *AdminFeeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminFeeReply) ProtoMessage() {}
+
+func (x *AdminFeeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AdminUserRecommendReply_List) GetCreatedAt() string {
+// Deprecated: Use AdminFeeReply.ProtoReflect.Descriptor instead.
+func (*AdminFeeReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AdminFeeReply) GetCount() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type AdminMonthRecommendReply_List struct {
+type AdminJobRunListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address          string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	RecommendAddress string `protobuf:"bytes,4,opt,name=recommendAddress,proto3" json:"recommendAddress,omitempty"`
-	Id               int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt        string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Page int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *AdminMonthRecommendReply_List) Reset() {
-	*x = AdminMonthRecommendReply_List{}
+func (x *AdminJobRunListRequest) Reset() {
+	*x = AdminJobRunListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[55]
+		mi := &file_api_app_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminMonthRecommendReply_List) String() string {
+func (x *AdminJobRunListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminMonthRecommendReply_List) ProtoMessage() {}
+func (*AdminJobRunListRequest) ProtoMessage() {}
 
-func (x *AdminMonthRecommendReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[55]
+func (x *AdminJobRunListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3280,66 +3168,105 @@ func (x *AdminMonthRecommendReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminMonthRecommendReply_List.ProtoReflect.Descriptor instead.
-func (*AdminMonthRecommendReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{37, 0}
+// Deprecated: Use AdminJobRunListRequest.ProtoReflect.Descriptor instead.
+func (*AdminJobRunListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *AdminMonthRecommendReply_List) GetAddress() string {
+func (x *AdminJobRunListRequest) GetPage() int64 {
 	if x != nil {
-		return x.Address
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *AdminMonthRecommendReply_List) GetRecommendAddress() string {
+func (x *AdminJobRunListRequest) GetName() string {
 	if x != nil {
-		return x.RecommendAddress
+		return x.Name
 	}
 	return ""
 }
 
-func (x *AdminMonthRecommendReply_List) GetId() int64 {
+type AdminJobRunListReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobRun []*AdminJobRunListReply_List `protobuf:"bytes,1,rep,name=jobRun,proto3" json:"jobRun,omitempty"`
+	Count  int64                        `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AdminJobRunListReply) Reset() {
+	*x = AdminJobRunListReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminJobRunListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminJobRunListReply) ProtoMessage() {}
+
+func (x *AdminJobRunListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminJobRunListReply.ProtoReflect.Descriptor instead.
+func (*AdminJobRunListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *AdminJobRunListReply) GetJobRun() []*AdminJobRunListReply_List {
 	if x != nil {
-		return x.Id
+		return x.JobRun
 	}
-	return 0
+	return nil
 }
 
-func (x *AdminMonthRecommendReply_List) GetCreatedAt() string {
+func (x *AdminJobRunListReply) GetCount() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type AdminConfigReply_List struct {
+type AdminDepositReorgListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Page int64 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 }
 
-func (x *AdminConfigReply_List) Reset() {
-	*x = AdminConfigReply_List{}
+func (x *AdminDepositReorgListRequest) Reset() {
+	*x = AdminDepositReorgListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[56]
+		mi := &file_api_app_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminConfigReply_List) String() string {
+func (x *AdminDepositReorgListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigReply_List) ProtoMessage() {}
+func (*AdminDepositReorgListRequest) ProtoMessage() {}
 
-func (x *AdminConfigReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[56]
+func (x *AdminDepositReorgListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3350,58 +3277,99 @@ func (x *AdminConfigReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigReply_List.ProtoReflect.Descriptor instead.
-func (*AdminConfigReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{39, 0}
+// Deprecated: Use AdminDepositReorgListRequest.ProtoReflect.Descriptor instead.
+func (*AdminDepositReorgListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *AdminConfigReply_List) GetId() int64 {
+func (x *AdminDepositReorgListRequest) GetPage() int64 {
 	if x != nil {
-		return x.Id
+		return x.Page
 	}
 	return 0
 }
 
-func (x *AdminConfigReply_List) GetName() string {
+type AdminDepositReorgListReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*AdminDepositReorgListReply_List `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	Count   int64                              `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AdminDepositReorgListReply) Reset() {
+	*x = AdminDepositReorgListReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDepositReorgListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositReorgListReply) ProtoMessage() {}
+
+func (x *AdminDepositReorgListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDepositReorgListReply.ProtoReflect.Descriptor instead.
+func (*AdminDepositReorgListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AdminDepositReorgListReply) GetRecords() []*AdminDepositReorgListReply_List {
 	if x != nil {
-		return x.Name
+		return x.Records
 	}
-	return ""
+	return nil
 }
 
-func (x *AdminConfigReply_List) GetValue() string {
+func (x *AdminDepositReorgListReply) GetCount() int64 {
 	if x != nil {
-		return x.Value
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type AdminConfigUpdateRequest_SendBody struct {
+type AdminDepositUnmatchedListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Page   int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *AdminConfigUpdateRequest_SendBody) Reset() {
-	*x = AdminConfigUpdateRequest_SendBody{}
+func (x *AdminDepositUnmatchedListRequest) Reset() {
+	*x = AdminDepositUnmatchedListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[57]
+		mi := &file_api_app_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminConfigUpdateRequest_SendBody) String() string {
+func (x *AdminDepositUnmatchedListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigUpdateRequest_SendBody) ProtoMessage() {}
+func (*AdminDepositUnmatchedListRequest) ProtoMessage() {}
 
-func (x *AdminConfigUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[57]
+func (x *AdminDepositUnmatchedListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3412,599 +3380,5404 @@ func (x *AdminConfigUpdateRequest_SendBody) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminConfigUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{40, 0}
+// Deprecated: Use AdminDepositUnmatchedListRequest.ProtoReflect.Descriptor instead.
+func (*AdminDepositUnmatchedListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *AdminConfigUpdateRequest_SendBody) GetId() int64 {
+func (x *AdminDepositUnmatchedListRequest) GetPage() int64 {
 	if x != nil {
-		return x.Id
+		return x.Page
 	}
 	return 0
 }
 
-func (x *AdminConfigUpdateRequest_SendBody) GetValue() string {
+func (x *AdminDepositUnmatchedListRequest) GetStatus() string {
 	if x != nil {
-		return x.Value
+		return x.Status
 	}
 	return ""
 }
 
-var File_api_app_proto protoreflect.FileDescriptor
+type AdminDepositUnmatchedListReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_api_app_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x03, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8f, 0x01, 0x0a, 0x13,
-	0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68,
-	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42,
-	0x6f, 0x64, 0x79, 0x1a, 0x38, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x29, 0x0a,
-	0x11, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc0, 0x09,
-	0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76,
-	0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x20, 0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64,
-	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68,
-	0x62, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x12,
-	0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a,
-	0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75,
-	0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65,
-	0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12,
-	0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x64, 0x74, 0x18, 0x14, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x64, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x4e, 0x75, 0x6d, 0x41, 0x6c, 0x6c, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x41, 0x6c, 0x6c, 0x12, 0x2a, 0x0a,
-	0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x41, 0x6c,
-	0x6c, 0x18, 0x19, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x41, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x42, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x42, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x56, 0x69, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
-	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x56, 0x69, 0x70, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x18, 0x1d, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x12, 0x26, 0x0a,
-	0x0e, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6f, 0x6f, 0x6c, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x6f,
-	0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x74, 0x6f, 0x70, 0x55, 0x73,
-	0x65, 0x72, 0x18, 0x21, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x23, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18,
-	0x24, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54,
-	0x6f, 0x70, 0x18, 0x25, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x64, 0x54, 0x6f, 0x70, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6c, 0x18, 0x26, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43,
-	0x6f, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
-	0x74, 0x61, 0x6c, 0x52, 0x6f, 0x77, 0x18, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x6f, 0x77, 0x12, 0x1c,
-	0x0a, 0x09, 0x62, 0x6e, 0x62, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x28, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x62, 0x6e, 0x62, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x62, 0x0a, 0x04,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28,
-	0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x22, 0x27, 0x0a, 0x11, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xc1, 0x01, 0x0a, 0x0f, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a,
-	0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x73, 0x1a, 0x79, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x1c, 0x0a,
-	0x1a, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc3, 0x01, 0x0a, 0x18,
-	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x69, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x22, 0x16, 0x0a, 0x14, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x12, 0x46, 0x65,
-	0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x36, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x3d, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x29, 0x0a, 0x13, 0x57, 0x69, 0x74, 0x68, 0x64,
-	0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x11, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x1a, 0x69, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2a, 0x0a, 0x14,
-	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x73, 0x1a, 0x36, 0x0a,
-	0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0f, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x73, 0x65, 0x6e,
-	0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e,
-	0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x36, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x27, 0x0a,
-	0x0d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x46, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
-	0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xec,
-	0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x83, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x44, 0x0a,
-	0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0xe9, 0x02, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65,
-	0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x84, 0x02, 0x0a, 0x08, 0x55, 0x73, 0x65,
-	0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x70,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x76, 0x69, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x6d,
-	0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x68,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x22,
-	0x48, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xda, 0x02, 0x0a, 0x16, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x46, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x1a, 0xe1, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03,
-	0x72, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x10,
-	0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x4d, 0x61, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x22, 0x48, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x22, 0xa0, 0x02, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x08, 0x77,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a,
-	0xb1, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6c,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
-	0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x17, 0x0a, 0x15,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd9, 0x02, 0x0a,
-	0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x26,
-	0x0a, 0x0e, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55,
-	0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x64,
-	0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c,
-	0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d,
-	0x74, 0x6f, 0x64, 0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x57, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x61, 0x6c, 0x6c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x15, 0x61, 0x6c, 0x6c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x41, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x22, 0x34, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xbc,
-	0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x1a, 0x68, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4a, 0x0a,
-	0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0xe7, 0x01, 0x0a, 0x18, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x7b, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x22, 0x40, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14,
-	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x40, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08,
-	0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x30, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64,
-	0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x32, 0xdf, 0x09, 0x0a, 0x03, 0x41, 0x70, 0x70, 0x12, 0x72, 0x0a, 0x0c,
-	0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68,
-	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x30,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x65, 0x74, 0x68, 0x5f, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79,
-	0x12, 0x57, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x5f, 0x0a, 0x0a, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x13, 0x52,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73,
-	0x74, 0x12, 0x6c, 0x0a, 0x0d, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
-	0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12,
-	0x67, 0x0a, 0x0c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64,
-	0x72, 0x61, 0x77, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x6b, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x26, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x61, 0x0a, 0x08, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x25, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x3a, 0x09, 0x73,
-	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x51, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
-	0x64, 0x68, 0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x64, 0x0a, 0x0d, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x19, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x12, 0x71, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x45, 0x74, 0x68, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x5f, 0x65, 0x74, 0x68, 0x12, 0x50, 0x0a, 0x08, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65,
-	0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x14, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
-	0x68, 0x62, 0x2f, 0x66, 0x65, 0x65, 0x42, 0x11, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x50, 0x01, 0x5a,
-	0x08, 0x2f, 0x61, 0x70, 0x69, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	Records []*AdminDepositUnmatchedListReply_List `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	Count   int64                                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-var (
-	file_api_app_proto_rawDescOnce sync.Once
-	file_api_app_proto_rawDescData = file_api_app_proto_rawDesc
-)
+func (x *AdminDepositUnmatchedListReply) Reset() {
+	*x = AdminDepositUnmatchedListReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_api_app_proto_rawDescGZIP() []byte {
-	file_api_app_proto_rawDescOnce.Do(func() {
-		file_api_app_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_app_proto_rawDescData)
-	})
-	return file_api_app_proto_rawDescData
+func (x *AdminDepositUnmatchedListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_api_app_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
-var file_api_app_proto_goTypes = []interface{}{
-	(*EthAuthorizeRequest)(nil),                 // 0: api.EthAuthorizeRequest
-	(*EthAuthorizeReply)(nil),                   // 1: api.EthAuthorizeReply
-	(*DepositRequest)(nil),                      // 2: api.DepositRequest
-	(*DepositReply)(nil),                        // 3: api.DepositReply
-	(*UserInfoRequest)(nil),                     // 4: api.UserInfoRequest
-	(*UserInfoReply)(nil),                       // 5: api.UserInfoReply
-	(*RewardListRequest)(nil),                   // 6: api.RewardListRequest
-	(*RewardListReply)(nil),                     // 7: api.RewardListReply
-	(*RecommendRewardListRequest)(nil),          // 8: api.RecommendRewardListRequest
-	(*RecommendRewardListReply)(nil),            // 9: api.RecommendRewardListReply
-	(*FeeRewardListRequest)(nil),                // 10: api.FeeRewardListRequest
-	(*FeeRewardListReply)(nil),                  // 11: api.FeeRewardListReply
-	(*WithdrawListRequest)(nil),                 // 12: api.WithdrawListRequest
-	(*WithdrawListReply)(nil),                   // 13: api.WithdrawListReply
-	(*RecommendListRequest)(nil),                // 14: api.RecommendListRequest
-	(*RecommendListReply)(nil),                  // 15: api.RecommendListReply
-	(*WithdrawRequest)(nil),                     // 16: api.WithdrawRequest
-	(*WithdrawReply)(nil),                       // 17: api.WithdrawReply
-	(*AdminRewardListRequest)(nil),              // 18: api.AdminRewardListRequest
-	(*AdminRewardListReply)(nil),                // 19: api.AdminRewardListReply
-	(*AdminUserListRequest)(nil),                // 20: api.AdminUserListRequest
-	(*AdminUserListReply)(nil),                  // 21: api.AdminUserListReply
-	(*AdminLocationListRequest)(nil),            // 22: api.AdminLocationListRequest
-	(*AdminLocationListReply)(nil),              // 23: api.AdminLocationListReply
-	(*AdminWithdrawListRequest)(nil),            // 24: api.AdminWithdrawListRequest
-	(*AdminWithdrawListReply)(nil),              // 25: api.AdminWithdrawListReply
-	(*AdminWithdrawRequest)(nil),                // 26: api.AdminWithdrawRequest
-	(*AdminWithdrawReply)(nil),                  // 27: api.AdminWithdrawReply
-	(*AdminWithdrawEthRequest)(nil),             // 28: api.AdminWithdrawEthRequest
-	(*AdminWithdrawEthReply)(nil),               // 29: api.AdminWithdrawEthReply
-	(*AdminFeeRequest)(nil),                     // 30: api.AdminFeeRequest
-	(*AdminFeeReply)(nil),                       // 31: api.AdminFeeReply
-	(*AdminAllRequest)(nil),                     // 32: api.AdminAllRequest
-	(*AdminAllReply)(nil),                       // 33: api.AdminAllReply
-	(*AdminUserRecommendRequest)(nil),           // 34: api.AdminUserRecommendRequest
-	(*AdminUserRecommendReply)(nil),             // 35: api.AdminUserRecommendReply
-	(*AdminMonthRecommendRequest)(nil),          // 36: api.AdminMonthRecommendRequest
-	(*AdminMonthRecommendReply)(nil),            // 37: api.AdminMonthRecommendReply
-	(*AdminConfigRequest)(nil),                  // 38: api.AdminConfigRequest
-	(*AdminConfigReply)(nil),                    // 39: api.AdminConfigReply
-	(*AdminConfigUpdateRequest)(nil),            // 40: api.AdminConfigUpdateRequest
-	(*AdminConfigUpdateReply)(nil),              // 41: api.AdminConfigUpdateReply
-	(*EthAuthorizeRequest_SendBody)(nil),        // 42: api.EthAuthorizeRequest.SendBody
-	(*UserInfoReply_List)(nil),                  // 43: api.UserInfoReply.List
-	(*RewardListReply_List)(nil),                // 44: api.RewardListReply.List
-	(*RecommendRewardListReply_List)(nil),       // 45: api.RecommendRewardListReply.List
-	(*FeeRewardListReply_List)(nil),             // 46: api.FeeRewardListReply.List
-	(*WithdrawListReply_List)(nil),              // 47: api.WithdrawListReply.List
-	(*RecommendListReply_List)(nil),             // 48: api.RecommendListReply.List
-	(*WithdrawRequest_SendBody)(nil),            // 49: api.WithdrawRequest.SendBody
-	(*AdminRewardListReply_List)(nil),           // 50: api.AdminRewardListReply.List
-	(*AdminUserListReply_UserList)(nil),         // 51: api.AdminUserListReply.UserList
-	(*AdminLocationListReply_LocationList)(nil), // 52: api.AdminLocationListReply.LocationList
-	(*AdminWithdrawListReply_List)(nil),         // 53: api.AdminWithdrawListReply.List
-	(*AdminUserRecommendReply_List)(nil),        // 54: api.AdminUserRecommendReply.List
-	(*AdminMonthRecommendReply_List)(nil),       // 55: api.AdminMonthRecommendReply.List
-	(*AdminConfigReply_List)(nil),               // 56: api.AdminConfigReply.List
-	(*AdminConfigUpdateRequest_SendBody)(nil),   // 57: api.AdminConfigUpdateRequest.SendBody
+func (*AdminDepositUnmatchedListReply) ProtoMessage() {}
+
+func (x *AdminDepositUnmatchedListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_api_app_proto_depIdxs = []int32{
-	42, // 0: api.EthAuthorizeRequest.send_body:type_name -> api.EthAuthorizeRequest.SendBody
-	43, // 1: api.UserInfoReply.topUser:type_name -> api.UserInfoReply.List
-	44, // 2: api.RewardListReply.rewards:type_name -> api.RewardListReply.List
-	45, // 3: api.RecommendRewardListReply.rewards:type_name -> api.RecommendRewardListReply.List
-	46, // 4: api.FeeRewardListReply.rewards:type_name -> api.FeeRewardListReply.List
-	47, // 5: api.WithdrawListReply.withdraw:type_name -> api.WithdrawListReply.List
-	48, // 6: api.RecommendListReply.recommends:type_name -> api.RecommendListReply.List
-	49, // 7: api.WithdrawRequest.send_body:type_name -> api.WithdrawRequest.SendBody
-	50, // 8: api.AdminRewardListReply.rewards:type_name -> api.AdminRewardListReply.List
-	51, // 9: api.AdminUserListReply.users:type_name -> api.AdminUserListReply.UserList
-	52, // 10: api.AdminLocationListReply.locations:type_name -> api.AdminLocationListReply.LocationList
-	53, // 11: api.AdminWithdrawListReply.withdraw:type_name -> api.AdminWithdrawListReply.List
-	54, // 12: api.AdminUserRecommendReply.users:type_name -> api.AdminUserRecommendReply.List
-	55, // 13: api.AdminMonthRecommendReply.users:type_name -> api.AdminMonthRecommendReply.List
-	56, // 14: api.AdminConfigReply.config:type_name -> api.AdminConfigReply.List
-	57, // 15: api.AdminConfigUpdateRequest.send_body:type_name -> api.AdminConfigUpdateRequest.SendBody
-	0,  // 16: api.App.EthAuthorize:input_type -> api.EthAuthorizeRequest
-	4,  // 17: api.App.UserInfo:input_type -> api.UserInfoRequest
-	6,  // 18: api.App.RewardList:input_type -> api.RewardListRequest
-	8,  // 19: api.App.RecommendRewardList:input_type -> api.RecommendRewardListRequest
-	10, // 20: api.App.FeeRewardList:input_type -> api.FeeRewardListRequest
-	12, // 21: api.App.WithdrawList:input_type -> api.WithdrawListRequest
-	14, // 22: api.App.RecommendList:input_type -> api.RecommendListRequest
-	16, // 23: api.App.Withdraw:input_type -> api.WithdrawRequest
-	2,  // 24: api.App.Deposit:input_type -> api.DepositRequest
-	26, // 25: api.App.AdminWithdraw:input_type -> api.AdminWithdrawRequest
-	28, // 26: api.App.AdminWithdrawEth:input_type -> api.AdminWithdrawEthRequest
-	30, // 27: api.App.AdminFee:input_type -> api.AdminFeeRequest
-	1,  // 28: api.App.EthAuthorize:output_type -> api.EthAuthorizeReply
-	5,  // 29: api.App.UserInfo:output_type -> api.UserInfoReply
-	7,  // 30: api.App.RewardList:output_type -> api.RewardListReply
-	9,  // 31: api.App.RecommendRewardList:output_type -> api.RecommendRewardListReply
-	11, // 32: api.App.FeeRewardList:output_type -> api.FeeRewardListReply
-	13, // 33: api.App.WithdrawList:output_type -> api.WithdrawListReply
-	15, // 34: api.App.RecommendList:output_type -> api.RecommendListReply
-	17, // 35: api.App.Withdraw:output_type -> api.WithdrawReply
-	3,  // 36: api.App.Deposit:output_type -> api.DepositReply
-	27, // 37: api.App.AdminWithdraw:output_type -> api.AdminWithdrawReply
-	29, // 38: api.App.AdminWithdrawEth:output_type -> api.AdminWithdrawEthReply
-	31, // 39: api.App.AdminFee:output_type -> api.AdminFeeReply
-	28, // [28:40] is the sub-list for method output_type
-	16, // [16:28] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+
+// Deprecated: Use AdminDepositUnmatchedListReply.ProtoReflect.Descriptor instead.
+func (*AdminDepositUnmatchedListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{65}
 }
 
-func init() { file_api_app_proto_init() }
-func file_api_app_proto_init() {
-	if File_api_app_proto != nil {
-		return
+func (x *AdminDepositUnmatchedListReply) GetRecords() []*AdminDepositUnmatchedListReply_List {
+	if x != nil {
+		return x.Records
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_app_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthAuthorizeRequest); i {
+	return nil
+}
+
+func (x *AdminDepositUnmatchedListReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminDepositUnmatchedHandleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminDepositUnmatchedHandleRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+}
+
+func (x *AdminDepositUnmatchedHandleRequest) Reset() {
+	*x = AdminDepositUnmatchedHandleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDepositUnmatchedHandleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositUnmatchedHandleRequest) ProtoMessage() {}
+
+func (x *AdminDepositUnmatchedHandleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDepositUnmatchedHandleRequest.ProtoReflect.Descriptor instead.
+func (*AdminDepositUnmatchedHandleRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AdminDepositUnmatchedHandleRequest) GetSendBody() *AdminDepositUnmatchedHandleRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type AdminDepositUnmatchedHandleReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminDepositUnmatchedHandleReply) Reset() {
+	*x = AdminDepositUnmatchedHandleReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDepositUnmatchedHandleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositUnmatchedHandleReply) ProtoMessage() {}
+
+func (x *AdminDepositUnmatchedHandleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDepositUnmatchedHandleReply.ProtoReflect.Descriptor instead.
+func (*AdminDepositUnmatchedHandleReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{67}
+}
+
+type AdminDepositTierListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminDepositTierListRequest) Reset() {
+	*x = AdminDepositTierListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDepositTierListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositTierListRequest) ProtoMessage() {}
+
+func (x *AdminDepositTierListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDepositTierListRequest.ProtoReflect.Descriptor instead.
+func (*AdminDepositTierListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{68}
+}
+
+type AdminDepositTierListReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tiers []*AdminDepositTierListReply_List `protobuf:"bytes,1,rep,name=tiers,proto3" json:"tiers,omitempty"`
+}
+
+func (x *AdminDepositTierListReply) Reset() {
+	*x = AdminDepositTierListReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDepositTierListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositTierListReply) ProtoMessage() {}
+
+func (x *AdminDepositTierListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDepositTierListReply.ProtoReflect.Descriptor instead.
+func (*AdminDepositTierListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AdminDepositTierListReply) GetTiers() []*AdminDepositTierListReply_List {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+type AdminDepositTierSaveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminDepositTierSaveRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+}
+
+func (x *AdminDepositTierSaveRequest) Reset() {
+	*x = AdminDepositTierSaveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDepositTierSaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositTierSaveRequest) ProtoMessage() {}
+
+func (x *AdminDepositTierSaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDepositTierSaveRequest.ProtoReflect.Descriptor instead.
+func (*AdminDepositTierSaveRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *AdminDepositTierSaveRequest) GetSendBody() *AdminDepositTierSaveRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type AdminDepositTierSaveReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AdminDepositTierSaveReply) Reset() {
+	*x = AdminDepositTierSaveReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDepositTierSaveReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositTierSaveReply) ProtoMessage() {}
+
+func (x *AdminDepositTierSaveReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDepositTierSaveReply.ProtoReflect.Descriptor instead.
+func (*AdminDepositTierSaveReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *AdminDepositTierSaveReply) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AdminDepositSweepRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminDepositSweepRequest) Reset() {
+	*x = AdminDepositSweepRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDepositSweepRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositSweepRequest) ProtoMessage() {}
+
+func (x *AdminDepositSweepRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDepositSweepRequest.ProtoReflect.Descriptor instead.
+func (*AdminDepositSweepRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{72}
+}
+
+type AdminDepositSweepReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AdminDepositSweepReply) Reset() {
+	*x = AdminDepositSweepReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDepositSweepReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositSweepReply) ProtoMessage() {}
+
+func (x *AdminDepositSweepReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDepositSweepReply.ProtoReflect.Descriptor instead.
+func (*AdminDepositSweepReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AdminDepositSweepReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminAllRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminAllRequest) Reset() {
+	*x = AdminAllRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAllRequest) ProtoMessage() {}
+
+func (x *AdminAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAllRequest.ProtoReflect.Descriptor instead.
+func (*AdminAllRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{74}
+}
+
+type AdminAllReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TodayTotalUser        int64  `protobuf:"varint,1,opt,name=todayTotalUser,proto3" json:"todayTotalUser,omitempty"`
+	TotalUser             int64  `protobuf:"varint,2,opt,name=totalUser,proto3" json:"totalUser,omitempty"`
+	AllBalance            string `protobuf:"bytes,3,opt,name=allBalance,proto3" json:"allBalance,omitempty"`
+	TodayLocation         string `protobuf:"bytes,4,opt,name=todayLocation,proto3" json:"todayLocation,omitempty"`
+	AllLocation           string `protobuf:"bytes,5,opt,name=allLocation,proto3" json:"allLocation,omitempty"`
+	TodayWithdraw         string `protobuf:"bytes,6,opt,name=todayWithdraw,proto3" json:"todayWithdraw,omitempty"`
+	AllWithdraw           string `protobuf:"bytes,7,opt,name=allWithdraw,proto3" json:"allWithdraw,omitempty"`
+	AllReward             string `protobuf:"bytes,8,opt,name=allReward,proto3" json:"allReward,omitempty"`
+	AllSystemRewardAndFee string `protobuf:"bytes,9,opt,name=allSystemRewardAndFee,proto3" json:"allSystemRewardAndFee,omitempty"`
+}
+
+func (x *AdminAllReply) Reset() {
+	*x = AdminAllReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminAllReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAllReply) ProtoMessage() {}
+
+func (x *AdminAllReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAllReply.ProtoReflect.Descriptor instead.
+func (*AdminAllReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *AdminAllReply) GetTodayTotalUser() int64 {
+	if x != nil {
+		return x.TodayTotalUser
+	}
+	return 0
+}
+
+func (x *AdminAllReply) GetTotalUser() int64 {
+	if x != nil {
+		return x.TotalUser
+	}
+	return 0
+}
+
+func (x *AdminAllReply) GetAllBalance() string {
+	if x != nil {
+		return x.AllBalance
+	}
+	return ""
+}
+
+func (x *AdminAllReply) GetTodayLocation() string {
+	if x != nil {
+		return x.TodayLocation
+	}
+	return ""
+}
+
+func (x *AdminAllReply) GetAllLocation() string {
+	if x != nil {
+		return x.AllLocation
+	}
+	return ""
+}
+
+func (x *AdminAllReply) GetTodayWithdraw() string {
+	if x != nil {
+		return x.TodayWithdraw
+	}
+	return ""
+}
+
+func (x *AdminAllReply) GetAllWithdraw() string {
+	if x != nil {
+		return x.AllWithdraw
+	}
+	return ""
+}
+
+func (x *AdminAllReply) GetAllReward() string {
+	if x != nil {
+		return x.AllReward
+	}
+	return ""
+}
+
+func (x *AdminAllReply) GetAllSystemRewardAndFee() string {
+	if x != nil {
+		return x.AllSystemRewardAndFee
+	}
+	return ""
+}
+
+type AdminUserRecommendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *AdminUserRecommendRequest) Reset() {
+	*x = AdminUserRecommendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserRecommendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserRecommendRequest) ProtoMessage() {}
+
+func (x *AdminUserRecommendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserRecommendRequest.ProtoReflect.Descriptor instead.
+func (*AdminUserRecommendRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *AdminUserRecommendRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type AdminUserRecommendReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*AdminUserRecommendReply_List `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *AdminUserRecommendReply) Reset() {
+	*x = AdminUserRecommendReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserRecommendReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserRecommendReply) ProtoMessage() {}
+
+func (x *AdminUserRecommendReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserRecommendReply.ProtoReflect.Descriptor instead.
+func (*AdminUserRecommendReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *AdminUserRecommendReply) GetUsers() []*AdminUserRecommendReply_List {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type AdminMonthRecommendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Page    int64  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *AdminMonthRecommendRequest) Reset() {
+	*x = AdminMonthRecommendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminMonthRecommendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminMonthRecommendRequest) ProtoMessage() {}
+
+func (x *AdminMonthRecommendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminMonthRecommendRequest.ProtoReflect.Descriptor instead.
+func (*AdminMonthRecommendRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *AdminMonthRecommendRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminMonthRecommendRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type AdminMonthRecommendReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*AdminMonthRecommendReply_List `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Count int64                            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AdminMonthRecommendReply) Reset() {
+	*x = AdminMonthRecommendReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminMonthRecommendReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminMonthRecommendReply) ProtoMessage() {}
+
+func (x *AdminMonthRecommendReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminMonthRecommendReply.ProtoReflect.Descriptor instead.
+func (*AdminMonthRecommendReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *AdminMonthRecommendReply) GetUsers() []*AdminMonthRecommendReply_List {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *AdminMonthRecommendReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Page   int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *AdminConfigRequest) Reset() {
+	*x = AdminConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigRequest) ProtoMessage() {}
+
+func (x *AdminConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigRequest.ProtoReflect.Descriptor instead.
+func (*AdminConfigRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *AdminConfigRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminConfigRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type AdminConfigReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config []*AdminConfigReply_List `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty"`
+	Count  int64                    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AdminConfigReply) Reset() {
+	*x = AdminConfigReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigReply) ProtoMessage() {}
+
+func (x *AdminConfigReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigReply.ProtoReflect.Descriptor instead.
+func (*AdminConfigReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *AdminConfigReply) GetConfig() []*AdminConfigReply_List {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *AdminConfigReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminConfigUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminConfigUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+}
+
+func (x *AdminConfigUpdateRequest) Reset() {
+	*x = AdminConfigUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigUpdateRequest) ProtoMessage() {}
+
+func (x *AdminConfigUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigUpdateRequest.ProtoReflect.Descriptor instead.
+func (*AdminConfigUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *AdminConfigUpdateRequest) GetSendBody() *AdminConfigUpdateRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type AdminConfigUpdateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminConfigUpdateReply) Reset() {
+	*x = AdminConfigUpdateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigUpdateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigUpdateReply) ProtoMessage() {}
+
+func (x *AdminConfigUpdateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigUpdateReply.ProtoReflect.Descriptor instead.
+func (*AdminConfigUpdateReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{83}
+}
+
+type EthAuthorizeRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Code    string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Nonce   string `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Sign    string `protobuf:"bytes,4,opt,name=sign,proto3" json:"sign,omitempty"`
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *EthAuthorizeRequest_SendBody) Reset() {
+	*x = EthAuthorizeRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EthAuthorizeRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthAuthorizeRequest_SendBody) ProtoMessage() {}
+
+func (x *EthAuthorizeRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthAuthorizeRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*EthAuthorizeRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *EthAuthorizeRequest_SendBody) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EthAuthorizeRequest_SendBody) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *EthAuthorizeRequest_SendBody) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *EthAuthorizeRequest_SendBody) GetSign() string {
+	if x != nil {
+		return x.Sign
+	}
+	return ""
+}
+
+func (x *EthAuthorizeRequest_SendBody) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RefreshTokenRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefreshToken string `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+}
+
+func (x *RefreshTokenRequest_SendBody) Reset() {
+	*x = RefreshTokenRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshTokenRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest_SendBody) ProtoMessage() {}
+
+func (x *RefreshTokenRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *RefreshTokenRequest_SendBody) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type AdminLoginRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *AdminLoginRequest_SendBody) Reset() {
+	*x = AdminLoginRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLoginRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLoginRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminLoginRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLoginRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminLoginRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *AdminLoginRequest_SendBody) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *AdminLoginRequest_SendBody) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type UserInfoReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account         string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	RecommendReward string `protobuf:"bytes,2,opt,name=recommendReward,proto3" json:"recommendReward,omitempty"`
+	Reward          string `protobuf:"bytes,3,opt,name=reward,proto3" json:"reward,omitempty"`
+}
+
+func (x *UserInfoReply_List) Reset() {
+	*x = UserInfoReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfoReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfoReply_List) ProtoMessage() {}
+
+func (x *UserInfoReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfoReply_List.ProtoReflect.Descriptor instead.
+func (*UserInfoReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *UserInfoReply_List) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *UserInfoReply_List) GetRecommendReward() string {
+	if x != nil {
+		return x.RecommendReward
+	}
+	return ""
+}
+
+func (x *UserInfoReply_List) GetReward() string {
+	if x != nil {
+		return x.Reward
+	}
+	return ""
+}
+
+type RewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt      string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount         string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	LocationStatus string `protobuf:"bytes,3,opt,name=locationStatus,proto3" json:"locationStatus,omitempty"`
+	Type           string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *RewardListReply_List) Reset() {
+	*x = RewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardListReply_List) ProtoMessage() {}
+
+func (x *RewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardListReply_List.ProtoReflect.Descriptor instead.
+func (*RewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *RewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RewardListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RewardListReply_List) GetLocationStatus() string {
+	if x != nil {
+		return x.LocationStatus
+	}
+	return ""
+}
+
+func (x *RewardListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type RecommendRewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type      string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RecommendRewardListReply_List) Reset() {
+	*x = RecommendRewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendRewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendRewardListReply_List) ProtoMessage() {}
+
+func (x *RecommendRewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendRewardListReply_List.ProtoReflect.Descriptor instead.
+func (*RecommendRewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *RecommendRewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RecommendRewardListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RecommendRewardListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RecommendRewardListReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type FeeRewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *FeeRewardListReply_List) Reset() {
+	*x = FeeRewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeRewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeRewardListReply_List) ProtoMessage() {}
+
+func (x *FeeRewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeRewardListReply_List.ProtoReflect.Descriptor instead.
+func (*FeeRewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *FeeRewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *FeeRewardListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type WithdrawListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	ChainId   int64  `protobuf:"varint,5,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Id        int64  `protobuf:"varint,6,opt,name=id,proto3" json:"id,omitempty"`
+	TxHash    string `protobuf:"bytes,7,opt,name=txHash,proto3" json:"txHash,omitempty"`
+}
+
+func (x *WithdrawListReply_List) Reset() {
+	*x = WithdrawListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawListReply_List) ProtoMessage() {}
+
+func (x *WithdrawListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawListReply_List.ProtoReflect.Descriptor instead.
+func (*WithdrawListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *WithdrawListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *WithdrawListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WithdrawListReply_List) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+type RecommendListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *RecommendListReply_List) Reset() {
+	*x = RecommendListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendListReply_List) ProtoMessage() {}
+
+func (x *RecommendListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendListReply_List.ProtoReflect.Descriptor instead.
+func (*RecommendListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{23, 0}
+}
+
+func (x *RecommendListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RecommendListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type DepositPendingListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash          string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Amount        string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	BlockNumber   uint64 `protobuf:"varint,3,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	Confirmations int64  `protobuf:"varint,4,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Required      int64  `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	Status        string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     string `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	CoinType      string `protobuf:"bytes,8,opt,name=coinType,proto3" json:"coinType,omitempty"`
+	ChainId       int64  `protobuf:"varint,9,opt,name=chainId,proto3" json:"chainId,omitempty"`
+}
+
+func (x *DepositPendingListReply_List) Reset() {
+	*x = DepositPendingListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositPendingListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositPendingListReply_List) ProtoMessage() {}
+
+func (x *DepositPendingListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositPendingListReply_List.ProtoReflect.Descriptor instead.
+func (*DepositPendingListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{25, 0}
+}
+
+func (x *DepositPendingListReply_List) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *DepositPendingListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *DepositPendingListReply_List) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *DepositPendingListReply_List) GetConfirmations() int64 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *DepositPendingListReply_List) GetRequired() int64 {
+	if x != nil {
+		return x.Required
+	}
+	return 0
+}
+
+func (x *DepositPendingListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DepositPendingListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *DepositPendingListReply_List) GetCoinType() string {
+	if x != nil {
+		return x.CoinType
+	}
+	return ""
+}
+
+func (x *DepositPendingListReply_List) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+type DepositUnmatchedListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash      string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status    string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt string `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	CoinType  string `protobuf:"bytes,5,opt,name=coinType,proto3" json:"coinType,omitempty"`
+	ChainId   int64  `protobuf:"varint,6,opt,name=chainId,proto3" json:"chainId,omitempty"`
+}
+
+func (x *DepositUnmatchedListReply_List) Reset() {
+	*x = DepositUnmatchedListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositUnmatchedListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositUnmatchedListReply_List) ProtoMessage() {}
+
+func (x *DepositUnmatchedListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositUnmatchedListReply_List.ProtoReflect.Descriptor instead.
+func (*DepositUnmatchedListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *DepositUnmatchedListReply_List) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *DepositUnmatchedListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *DepositUnmatchedListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DepositUnmatchedListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *DepositUnmatchedListReply_List) GetCoinType() string {
+	if x != nil {
+		return x.CoinType
+	}
+	return ""
+}
+
+func (x *DepositUnmatchedListReply_List) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+type ChainListReply_Token struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol   string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Decimals int64  `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (x *ChainListReply_Token) Reset() {
+	*x = ChainListReply_Token{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainListReply_Token) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainListReply_Token) ProtoMessage() {}
+
+func (x *ChainListReply_Token) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainListReply_Token.ProtoReflect.Descriptor instead.
+func (*ChainListReply_Token) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *ChainListReply_Token) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ChainListReply_Token) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *ChainListReply_Token) GetDecimals() int64 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+type ChainListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId       int64                   `protobuf:"varint,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Name          string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Tokens        []*ChainListReply_Token `protobuf:"bytes,3,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	Confirmations int64                   `protobuf:"varint,4,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+}
+
+func (x *ChainListReply_List) Reset() {
+	*x = ChainListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainListReply_List) ProtoMessage() {}
+
+func (x *ChainListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainListReply_List.ProtoReflect.Descriptor instead.
+func (*ChainListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{29, 1}
+}
+
+func (x *ChainListReply_List) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *ChainListReply_List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChainListReply_List) GetTokens() []*ChainListReply_Token {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *ChainListReply_List) GetConfirmations() int64 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+type SubmitDepositTxRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash    string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	ChainId int64  `protobuf:"varint,2,opt,name=chainId,proto3" json:"chainId,omitempty"` // 为 0 时为默认链
+}
+
+func (x *SubmitDepositTxRequest_SendBody) Reset() {
+	*x = SubmitDepositTxRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitDepositTxRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitDepositTxRequest_SendBody) ProtoMessage() {}
+
+func (x *SubmitDepositTxRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitDepositTxRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*SubmitDepositTxRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{32, 0}
+}
+
+func (x *SubmitDepositTxRequest_SendBody) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *SubmitDepositTxRequest_SendBody) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+type DepositWebhookRequest_Transfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash        string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	From        string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To          string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Value       string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"` // 代币最小单位的十进制字符串
+	Contract    string `protobuf:"bytes,5,opt,name=contract,proto3" json:"contract,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,6,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	TxIndex     int64  `protobuf:"varint,7,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
+	LogIndex    int64  `protobuf:"varint,8,opt,name=logIndex,proto3" json:"logIndex,omitempty"`
+}
+
+func (x *DepositWebhookRequest_Transfer) Reset() {
+	*x = DepositWebhookRequest_Transfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositWebhookRequest_Transfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositWebhookRequest_Transfer) ProtoMessage() {}
+
+func (x *DepositWebhookRequest_Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositWebhookRequest_Transfer.ProtoReflect.Descriptor instead.
+func (*DepositWebhookRequest_Transfer) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{34, 0}
+}
+
+func (x *DepositWebhookRequest_Transfer) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *DepositWebhookRequest_Transfer) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *DepositWebhookRequest_Transfer) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *DepositWebhookRequest_Transfer) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *DepositWebhookRequest_Transfer) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *DepositWebhookRequest_Transfer) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *DepositWebhookRequest_Transfer) GetTxIndex() int64 {
+	if x != nil {
+		return x.TxIndex
+	}
+	return 0
+}
+
+func (x *DepositWebhookRequest_Transfer) GetLogIndex() int64 {
+	if x != nil {
+		return x.LogIndex
+	}
+	return 0
+}
+
+type DepositWebhookRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transfers []*DepositWebhookRequest_Transfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	ChainId   int64                             `protobuf:"varint,2,opt,name=chainId,proto3" json:"chainId,omitempty"` // 一次推送只包含一条链的转账，为 0 时为默认链
+}
+
+func (x *DepositWebhookRequest_SendBody) Reset() {
+	*x = DepositWebhookRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositWebhookRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositWebhookRequest_SendBody) ProtoMessage() {}
+
+func (x *DepositWebhookRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositWebhookRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*DepositWebhookRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{34, 1}
+}
+
+func (x *DepositWebhookRequest_SendBody) GetTransfers() []*DepositWebhookRequest_Transfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+func (x *DepositWebhookRequest_SendBody) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+type WithdrawRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Amount  string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	ChainId int64  `protobuf:"varint,3,opt,name=chainId,proto3" json:"chainId,omitempty"` // 打款链，为 0 时为默认链
+}
+
+func (x *WithdrawRequest_SendBody) Reset() {
+	*x = WithdrawRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawRequest_SendBody) ProtoMessage() {}
+
+func (x *WithdrawRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*WithdrawRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{36, 0}
+}
+
+func (x *WithdrawRequest_SendBody) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WithdrawRequest_SendBody) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *WithdrawRequest_SendBody) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+type WithdrawCancelRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *WithdrawCancelRequest_SendBody) Reset() {
+	*x = WithdrawCancelRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawCancelRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawCancelRequest_SendBody) ProtoMessage() {}
+
+func (x *WithdrawCancelRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawCancelRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*WithdrawCancelRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{38, 0}
+}
+
+func (x *WithdrawCancelRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AdminRewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type      string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Reason    string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AdminRewardListReply_List) Reset() {
+	*x = AdminRewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminRewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRewardListReply_List) ProtoMessage() {}
+
+func (x *AdminRewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRewardListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminRewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{41, 0}
+}
+
+func (x *AdminRewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminUserListReply_UserList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId           int64  `protobuf:"varint,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt        string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Address          string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	BalanceUsdt      string `protobuf:"bytes,3,opt,name=balanceUsdt,proto3" json:"balanceUsdt,omitempty"`
+	BalanceDhb       string `protobuf:"bytes,4,opt,name=balanceDhb,proto3" json:"balanceDhb,omitempty"`
+	Vip              int64  `protobuf:"varint,5,opt,name=vip,proto3" json:"vip,omitempty"`
+	MonthRecommend   int64  `protobuf:"varint,7,opt,name=monthRecommend,proto3" json:"monthRecommend,omitempty"`
+	HistoryRecommend int64  `protobuf:"varint,6,opt,name=historyRecommend,proto3" json:"historyRecommend,omitempty"`
+}
+
+func (x *AdminUserListReply_UserList) Reset() {
+	*x = AdminUserListReply_UserList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserListReply_UserList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserListReply_UserList) ProtoMessage() {}
+
+func (x *AdminUserListReply_UserList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserListReply_UserList.ProtoReflect.Descriptor instead.
+func (*AdminUserListReply_UserList) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{43, 0}
+}
+
+func (x *AdminUserListReply_UserList) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetBalanceUsdt() string {
+	if x != nil {
+		return x.BalanceUsdt
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetBalanceDhb() string {
+	if x != nil {
+		return x.BalanceDhb
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetVip() int64 {
+	if x != nil {
+		return x.Vip
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetMonthRecommend() int64 {
+	if x != nil {
+		return x.MonthRecommend
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetHistoryRecommend() int64 {
+	if x != nil {
+		return x.HistoryRecommend
+	}
+	return 0
+}
+
+type AdminLocationListReply_LocationList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt    string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Address      string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Row          int64  `protobuf:"varint,3,opt,name=row,proto3" json:"row,omitempty"`
+	Col          int64  `protobuf:"varint,4,opt,name=col,proto3" json:"col,omitempty"`
+	Status       string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CurrentLevel int64  `protobuf:"varint,6,opt,name=currentLevel,proto3" json:"currentLevel,omitempty"`
+	Current      string `protobuf:"bytes,7,opt,name=current,proto3" json:"current,omitempty"`
+	CurrentMax   string `protobuf:"bytes,8,opt,name=currentMax,proto3" json:"currentMax,omitempty"`
+}
+
+func (x *AdminLocationListReply_LocationList) Reset() {
+	*x = AdminLocationListReply_LocationList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLocationListReply_LocationList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLocationListReply_LocationList) ProtoMessage() {}
+
+func (x *AdminLocationListReply_LocationList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLocationListReply_LocationList.ProtoReflect.Descriptor instead.
+func (*AdminLocationListReply_LocationList) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{45, 0}
+}
+
+func (x *AdminLocationListReply_LocationList) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *AdminLocationListReply_LocationList) GetCol() int64 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+func (x *AdminLocationListReply_LocationList) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetCurrentLevel() int64 {
+	if x != nil {
+		return x.CurrentLevel
+	}
+	return 0
+}
+
+func (x *AdminLocationListReply_LocationList) GetCurrent() string {
+	if x != nil {
+		return x.Current
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetCurrentMax() string {
+	if x != nil {
+		return x.CurrentMax
+	}
+	return ""
+}
+
+type AdminWithdrawListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address     string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Id          int64  `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt   string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount      string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	RelAmount   string `protobuf:"bytes,6,opt,name=relAmount,proto3" json:"relAmount,omitempty"`
+	Type        string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Status      string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	ChainId     int64  `protobuf:"varint,8,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	TxHash      string `protobuf:"bytes,9,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	GasUsed     int64  `protobuf:"varint,10,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	BlockNumber int64  `protobuf:"varint,11,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+}
+
+func (x *AdminWithdrawListReply_List) Reset() {
+	*x = AdminWithdrawListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawListReply_List) ProtoMessage() {}
+
+func (x *AdminWithdrawListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{47, 0}
+}
+
+func (x *AdminWithdrawListReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWithdrawListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetRelAmount() string {
+	if x != nil {
+		return x.RelAmount
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *AdminWithdrawListReply_List) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetGasUsed() int64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *AdminWithdrawListReply_List) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+type AdminWithdrawUpdateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // reject 拒绝并退回余额 | retry 打款失败后重新打款 | fail 标记打款失败
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AdminWithdrawUpdateRequest_SendBody) Reset() {
+	*x = AdminWithdrawUpdateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawUpdateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawUpdateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminWithdrawUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{52, 0}
+}
+
+func (x *AdminWithdrawUpdateRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWithdrawUpdateRequest_SendBody) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AdminWithdrawUpdateRequest_SendBody) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminWithdrawEventListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromStatus string `protobuf:"bytes,2,opt,name=fromStatus,proto3" json:"fromStatus,omitempty"`
+	ToStatus   string `protobuf:"bytes,3,opt,name=toStatus,proto3" json:"toStatus,omitempty"`
+	Actor      string `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason     string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt  string `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *AdminWithdrawEventListReply_List) Reset() {
+	*x = AdminWithdrawEventListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawEventListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawEventListReply_List) ProtoMessage() {}
+
+func (x *AdminWithdrawEventListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawEventListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawEventListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{55, 0}
+}
+
+func (x *AdminWithdrawEventListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWithdrawEventListReply_List) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *AdminWithdrawEventListReply_List) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *AdminWithdrawEventListReply_List) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AdminWithdrawEventListReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdminWithdrawEventListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminJobRunListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status    string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	StartedAt string `protobuf:"bytes,4,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	EndedAt   string `protobuf:"bytes,5,opt,name=endedAt,proto3" json:"endedAt,omitempty"`
+	Items     int64  `protobuf:"varint,6,opt,name=items,proto3" json:"items,omitempty"`
+	Error     string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *AdminJobRunListReply_List) Reset() {
+	*x = AdminJobRunListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminJobRunListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminJobRunListReply_List) ProtoMessage() {}
+
+func (x *AdminJobRunListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminJobRunListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminJobRunListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{61, 0}
+}
+
+func (x *AdminJobRunListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminJobRunListReply_List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminJobRunListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminJobRunListReply_List) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *AdminJobRunListReply_List) GetEndedAt() string {
+	if x != nil {
+		return x.EndedAt
+	}
+	return ""
+}
+
+func (x *AdminJobRunListReply_List) GetItems() int64 {
+	if x != nil {
+		return x.Items
+	}
+	return 0
+}
+
+func (x *AdminJobRunListReply_List) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type AdminDepositReorgListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId      int64  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Hash        string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	Amount      string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,5,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	LocationId  int64  `protobuf:"varint,6,opt,name=locationId,proto3" json:"locationId,omitempty"`
+	CreatedAt   string `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *AdminDepositReorgListReply_List) Reset() {
+	*x = AdminDepositReorgListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDepositReorgListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositReorgListReply_List) ProtoMessage() {}
+
+func (x *AdminDepositReorgListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDepositReorgListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminDepositReorgListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{63, 0}
+}
+
+func (x *AdminDepositReorgListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminDepositReorgListReply_List) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminDepositReorgListReply_List) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *AdminDepositReorgListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminDepositReorgListReply_List) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *AdminDepositReorgListReply_List) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *AdminDepositReorgListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminDepositUnmatchedListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId      int64  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Hash        string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	Amount      string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,5,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	Status      string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt   string `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Address     string `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
+	CoinType    string `protobuf:"bytes,9,opt,name=coinType,proto3" json:"coinType,omitempty"`
+}
+
+func (x *AdminDepositUnmatchedListReply_List) Reset() {
+	*x = AdminDepositUnmatchedListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDepositUnmatchedListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositUnmatchedListReply_List) ProtoMessage() {}
+
+func (x *AdminDepositUnmatchedListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDepositUnmatchedListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminDepositUnmatchedListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{65, 0}
+}
+
+func (x *AdminDepositUnmatchedListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminDepositUnmatchedListReply_List) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminDepositUnmatchedListReply_List) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *AdminDepositUnmatchedListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminDepositUnmatchedListReply_List) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *AdminDepositUnmatchedListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminDepositUnmatchedListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminDepositUnmatchedListReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminDepositUnmatchedListReply_List) GetCoinType() string {
+	if x != nil {
+		return x.CoinType
+	}
+	return ""
+}
+
+type AdminDepositUnmatchedHandleRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // credit 入余额 | place 放入档位 | refund 退款
+}
+
+func (x *AdminDepositUnmatchedHandleRequest_SendBody) Reset() {
+	*x = AdminDepositUnmatchedHandleRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDepositUnmatchedHandleRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositUnmatchedHandleRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminDepositUnmatchedHandleRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDepositUnmatchedHandleRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminDepositUnmatchedHandleRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{66, 0}
+}
+
+func (x *AdminDepositUnmatchedHandleRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminDepositUnmatchedHandleRequest_SendBody) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type AdminDepositTierListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Token      string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Amount     string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Level      int64  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	Multiplier int64  `protobuf:"varint,5,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	Enabled    bool   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	PairToken  string `protobuf:"bytes,7,opt,name=pairToken,proto3" json:"pairToken,omitempty"`
+	PairAmount string `protobuf:"bytes,8,opt,name=pairAmount,proto3" json:"pairAmount,omitempty"`
+}
+
+func (x *AdminDepositTierListReply_List) Reset() {
+	*x = AdminDepositTierListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDepositTierListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositTierListReply_List) ProtoMessage() {}
+
+func (x *AdminDepositTierListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDepositTierListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminDepositTierListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{69, 0}
+}
+
+func (x *AdminDepositTierListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminDepositTierListReply_List) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AdminDepositTierListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminDepositTierListReply_List) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *AdminDepositTierListReply_List) GetMultiplier() int64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *AdminDepositTierListReply_List) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AdminDepositTierListReply_List) GetPairToken() string {
+	if x != nil {
+		return x.PairToken
+	}
+	return ""
+}
+
+func (x *AdminDepositTierListReply_List) GetPairAmount() string {
+	if x != nil {
+		return x.PairAmount
+	}
+	return ""
+}
+
+type AdminDepositTierSaveRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Token      string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Amount     string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Level      int64  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	Multiplier int64  `protobuf:"varint,5,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	Enabled    bool   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	PairToken  string `protobuf:"bytes,7,opt,name=pairToken,proto3" json:"pairToken,omitempty"` // 组合档位需要的第二种代币，为空表示单币档位
+	PairAmount string `protobuf:"bytes,8,opt,name=pairAmount,proto3" json:"pairAmount,omitempty"`
+}
+
+func (x *AdminDepositTierSaveRequest_SendBody) Reset() {
+	*x = AdminDepositTierSaveRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDepositTierSaveRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositTierSaveRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminDepositTierSaveRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDepositTierSaveRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminDepositTierSaveRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{70, 0}
+}
+
+func (x *AdminDepositTierSaveRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminDepositTierSaveRequest_SendBody) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AdminDepositTierSaveRequest_SendBody) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminDepositTierSaveRequest_SendBody) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *AdminDepositTierSaveRequest_SendBody) GetMultiplier() int64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *AdminDepositTierSaveRequest_SendBody) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AdminDepositTierSaveRequest_SendBody) GetPairToken() string {
+	if x != nil {
+		return x.PairToken
+	}
+	return ""
+}
+
+func (x *AdminDepositTierSaveRequest_SendBody) GetPairAmount() string {
+	if x != nil {
+		return x.PairAmount
+	}
+	return ""
+}
+
+type AdminUserRecommendReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int64  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Address   string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Id        int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminUserRecommendReply_List) Reset() {
+	*x = AdminUserRecommendReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserRecommendReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserRecommendReply_List) ProtoMessage() {}
+
+func (x *AdminUserRecommendReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserRecommendReply_List.ProtoReflect.Descriptor instead.
+func (*AdminUserRecommendReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{77, 0}
+}
+
+func (x *AdminUserRecommendReply_List) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminUserRecommendReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminUserRecommendReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminUserRecommendReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminMonthRecommendReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address          string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	RecommendAddress string `protobuf:"bytes,4,opt,name=recommendAddress,proto3" json:"recommendAddress,omitempty"`
+	Id               int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt        string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminMonthRecommendReply_List) Reset() {
+	*x = AdminMonthRecommendReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminMonthRecommendReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminMonthRecommendReply_List) ProtoMessage() {}
+
+func (x *AdminMonthRecommendReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminMonthRecommendReply_List.ProtoReflect.Descriptor instead.
+func (*AdminMonthRecommendReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{79, 0}
+}
+
+func (x *AdminMonthRecommendReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminMonthRecommendReply_List) GetRecommendAddress() string {
+	if x != nil {
+		return x.RecommendAddress
+	}
+	return ""
+}
+
+func (x *AdminMonthRecommendReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminMonthRecommendReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminConfigReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AdminConfigReply_List) Reset() {
+	*x = AdminConfigReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigReply_List) ProtoMessage() {}
+
+func (x *AdminConfigReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigReply_List.ProtoReflect.Descriptor instead.
+func (*AdminConfigReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{81, 0}
+}
+
+func (x *AdminConfigReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminConfigReply_List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminConfigReply_List) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type AdminConfigUpdateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) Reset() {
+	*x = AdminConfigUpdateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigUpdateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminConfigUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminConfigUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{82, 0}
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+var File_api_app_proto protoreflect.FileDescriptor
+
+var file_api_app_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x03, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2f, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x61, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22,
+	0xd3, 0x01, 0x0a, 0x13, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73,
+	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x7c, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4d, 0x0a, 0x11, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x85, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x2e, 0x0a, 0x08,
+	0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4d, 0x0a, 0x11,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x0f, 0x0a, 0x0d, 0x4c,
+	0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0d, 0x0a, 0x0b,
+	0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x93, 0x01, 0x0a, 0x11,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a,
+	0x40, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x22, 0x27, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x24, 0x0a, 0x0c,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc0, 0x09, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65,
+	0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x72,
+	0x6f, 0x77, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x10, 0x0a,
+	0x03, 0x63, 0x6f, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12,
+	0x26, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x73, 0x64, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x64,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x16, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x41, 0x6c, 0x6c, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e,
+	0x75, 0x6d, 0x41, 0x6c, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x41, 0x6c, 0x6c, 0x18, 0x19, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x41, 0x6c,
+	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x18, 0x1b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x12, 0x2c, 0x0a, 0x11, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x56, 0x69, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x56, 0x69, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65,
+	0x44, 0x61, 0x69, 0x6c, 0x79, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65,
+	0x44, 0x61, 0x69, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x6f, 0x6f, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x22, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x6f, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x31, 0x0a, 0x07, 0x74, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x18, 0x21, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x23, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x64, 0x61,
+	0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74,
+	0x6f, 0x64, 0x61, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x70, 0x18, 0x25, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x70, 0x12, 0x2a,
+	0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43,
+	0x6f, 0x6c, 0x18, 0x26, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x6f, 0x77, 0x18, 0x27,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x52, 0x6f, 0x77, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6e, 0x62, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6e, 0x62, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x62, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0x27, 0x0a, 0x11, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0xc1, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x79, 0x0a, 0x04, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0xc3, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x69,
+	0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x46, 0x65, 0x65,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x8b, 0x01, 0x0a, 0x12, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x1a, 0x3d, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x29, 0x0a, 0x13, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xfa, 0x01, 0x0a, 0x11, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x37, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x1a, 0xab, 0x01, 0x0a, 0x04, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x2a, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x73, 0x1a, 0x36, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x1b, 0x0a, 0x19, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xdd, 0x02,
+	0x0a, 0x17, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3d, 0x0a, 0x08, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x1a, 0x82, 0x02, 0x0a, 0x04, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x1d, 0x0a,
+	0x1b, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xfd, 0x01, 0x0a,
+	0x19, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3f, 0x0a, 0x08, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x1a, 0x9e, 0x01, 0x0a, 0x04,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x12, 0x0a, 0x10,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0xab, 0x02, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x06, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x73, 0x1a, 0x57, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x1a, 0x8d,
+	0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x31,
+	0x0a, 0x15, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x64, 0x22, 0x61, 0x0a, 0x13, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x64, 0x22, 0x95, 0x01, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x41, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x1a, 0x38, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0xa4, 0x01, 0x0a,
+	0x14, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x78,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x22, 0x91, 0x03, 0x0a, 0x15, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a,
+	0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a,
+	0xcc, 0x01, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x1a, 0x67,
+	0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x41, 0x0a, 0x09, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x13, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18,
+	0x0a, 0x07, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x22, 0x9f, 0x01, 0x0a, 0x0f, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08,
+	0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x50, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x27, 0x0a, 0x0d, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x75, 0x0a, 0x15, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x1a,
+	0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x46, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x14, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0x83, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xe9,
+	0x02, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x1a, 0x84, 0x02, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x55, 0x73, 0x64, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44,
+	0x68, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x44, 0x68, 0x62, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x76, 0x69, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x2a,
+	0x0a, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x22, 0x48, 0x0a, 0x18, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0xda, 0x02, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x46, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xe1, 0x01,
+	0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61,
+	0x78, 0x22, 0x48, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x8e, 0x03, 0x0a, 0x16,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x9f, 0x02, 0x0a, 0x04, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61,
+	0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x16, 0x0a, 0x14,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x2a, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x1d, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x31, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xaf, 0x01, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x45, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08,
+	0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x4a, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x32, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2f, 0x0a, 0x1d, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0xfd, 0x01, 0x0a, 0x1b, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3d, 0x0a, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x9e, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x2d, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x25, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46,
+	0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x40, 0x0a,
+	0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x75, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x8d, 0x02, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x75, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x52,
+	0x75, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x75, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x06, 0x6a, 0x6f, 0x62, 0x52, 0x75, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xa6, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x32, 0x0a, 0x1c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52,
+	0x65, 0x6f, 0x72, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x22, 0xaf, 0x02, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x3e, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xba, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4e, 0x0a, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xe5, 0x02, 0x0a, 0x1e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x42, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0xe8, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0xa7, 0x01,
+	0x0a, 0x22, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x1a, 0x32, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x22, 0x0a, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1d, 0x0a, 0x1b, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x69, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xab, 0x02, 0x0a, 0x19, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x69, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x39, 0x0a, 0x05, 0x74, 0x69, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x69, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x74, 0x69,
+	0x65, 0x72, 0x73, 0x1a, 0xd2, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61,
+	0x69, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x69, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x69, 0x72,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61,
+	0x69, 0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xbe, 0x02, 0x0a, 0x1b, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x69, 0x65, 0x72, 0x53, 0x61, 0x76,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x69,
+	0x65, 0x72, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
+	0x1a, 0xd6, 0x01, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x61, 0x69, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x69, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x69,
+	0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x61, 0x69, 0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2b, 0x0a, 0x19, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x69, 0x65, 0x72, 0x53, 0x61, 0x76,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x77, 0x65, 0x65, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x2e, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x53, 0x77, 0x65, 0x65, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd9, 0x02, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41,
+	0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x6f, 0x64, 0x61, 0x79,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x0a,
+	0x0a, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a,
+	0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f,
+	0x64, 0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x6c, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x61,
+	0x6c, 0x6c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x6e,
+	0x64, 0x46, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x6c, 0x6c, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x6e, 0x64, 0x46, 0x65,
+	0x65, 0x22, 0x34, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xbc, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x68, 0x0a, 0x04,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4a, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d,
+	0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x22, 0xe7, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74,
+	0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x38, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a,
+	0x7b, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x40, 0x0a, 0x12,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x9e,
+	0x01, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x40, 0x0a,
+	0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x91, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
+	0x79, 0x1a, 0x30, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x32, 0x9d, 0x1e,
+	0x0a, 0x03, 0x41, 0x70, 0x70, 0x12, 0x64, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68,
+	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x75, 0x74, 0x68, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x4e, 0x6f, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12,
+	0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x72, 0x0a, 0x0c, 0x45,
+	0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x30, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x65, 0x74, 0x68, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12,
+	0x72, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62,
+	0x6f, 0x64, 0x79, 0x12, 0x51, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x12, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x6c, 0x6f, 0x67,
+	0x6f, 0x75, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x57, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12,
+	0x5f, 0x0a, 0x0a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x84, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27,
+	0x12, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x6c, 0x0a, 0x0d, 0x46, 0x65, 0x65, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46,
+	0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x67, 0x0a, 0x0c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12,
+	0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x6b,
+	0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x80, 0x01, 0x0a, 0x12,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x88,
+	0x01, 0x0a, 0x14, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x28, 0x12, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x75, 0x6e, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x09, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x73, 0x12, 0x6f, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x7f, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x54, 0x78, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x34,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x22, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x74, 0x78, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x12, 0x6f, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x21, 0x22, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x5f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x61, 0x0a, 0x08, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x25, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x3a, 0x09, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x7a, 0x0a, 0x0e, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x12, 0x63, 0x0a, 0x0a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x3a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x51, 0x0a, 0x07, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x64, 0x0a, 0x0d,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x19, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x12, 0x71, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x5f, 0x65, 0x74, 0x68, 0x12, 0x81, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x20,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x88, 0x01, 0x0a, 0x13, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x12, 0x8a, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x50, 0x0a, 0x08, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x14, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46,
+	0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12,
+	0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f,
+	0x66, 0x65, 0x65, 0x12, 0x6e, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4a, 0x6f, 0x62, 0x52,
+	0x75, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x75, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4a,
+	0x6f, 0x62, 0x52, 0x75, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6a, 0x6f, 0x62, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x86, 0x01, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x52, 0x65, 0x6f, 0x72, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x5f, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x96, 0x01, 0x0a,
+	0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0xa9, 0x01, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x48,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x22, 0x27, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f,
+	0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64,
+	0x79, 0x12, 0x82, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x54, 0x69, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x69, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54,
+	0x69, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x28, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x65,
+	0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x8d, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x69, 0x65, 0x72, 0x53, 0x61, 0x76, 0x65, 0x12,
+	0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x54, 0x69, 0x65, 0x72, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x54, 0x69, 0x65, 0x72, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x5f, 0x73, 0x61, 0x76, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e,
+	0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x75, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x77, 0x65, 0x65, 0x70, 0x12, 0x1d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x77,
+	0x65, 0x65, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x77, 0x65,
+	0x65, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12,
+	0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x73, 0x77, 0x65, 0x65, 0x70, 0x42, 0x11, 0x0a,
+	0x03, 0x61, 0x70, 0x69, 0x50, 0x01, 0x5a, 0x08, 0x2f, 0x61, 0x70, 0x69, 0x3b, 0x61, 0x70, 0x69,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_app_proto_rawDescOnce sync.Once
+	file_api_app_proto_rawDescData = file_api_app_proto_rawDesc
+)
+
+func file_api_app_proto_rawDescGZIP() []byte {
+	file_api_app_proto_rawDescOnce.Do(func() {
+		file_api_app_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_app_proto_rawDescData)
+	})
+	return file_api_app_proto_rawDescData
+}
+
+var file_api_app_proto_msgTypes = make([]protoimpl.MessageInfo, 118)
+var file_api_app_proto_goTypes = []interface{}{
+	(*GetAuthNonceRequest)(nil),                         // 0: api.GetAuthNonceRequest
+	(*GetAuthNonceReply)(nil),                           // 1: api.GetAuthNonceReply
+	(*EthAuthorizeRequest)(nil),                         // 2: api.EthAuthorizeRequest
+	(*EthAuthorizeReply)(nil),                           // 3: api.EthAuthorizeReply
+	(*RefreshTokenRequest)(nil),                         // 4: api.RefreshTokenRequest
+	(*RefreshTokenReply)(nil),                           // 5: api.RefreshTokenReply
+	(*LogoutRequest)(nil),                               // 6: api.LogoutRequest
+	(*LogoutReply)(nil),                                 // 7: api.LogoutReply
+	(*AdminLoginRequest)(nil),                           // 8: api.AdminLoginRequest
+	(*AdminLoginReply)(nil),                             // 9: api.AdminLoginReply
+	(*DepositRequest)(nil),                              // 10: api.DepositRequest
+	(*DepositReply)(nil),                                // 11: api.DepositReply
+	(*UserInfoRequest)(nil),                             // 12: api.UserInfoRequest
+	(*UserInfoReply)(nil),                               // 13: api.UserInfoReply
+	(*RewardListRequest)(nil),                           // 14: api.RewardListRequest
+	(*RewardListReply)(nil),                             // 15: api.RewardListReply
+	(*RecommendRewardListRequest)(nil),                  // 16: api.RecommendRewardListRequest
+	(*RecommendRewardListReply)(nil),                    // 17: api.RecommendRewardListReply
+	(*FeeRewardListRequest)(nil),                        // 18: api.FeeRewardListRequest
+	(*FeeRewardListReply)(nil),                          // 19: api.FeeRewardListReply
+	(*WithdrawListRequest)(nil),                         // 20: api.WithdrawListRequest
+	(*WithdrawListReply)(nil),                           // 21: api.WithdrawListReply
+	(*RecommendListRequest)(nil),                        // 22: api.RecommendListRequest
+	(*RecommendListReply)(nil),                          // 23: api.RecommendListReply
+	(*DepositPendingListRequest)(nil),                   // 24: api.DepositPendingListRequest
+	(*DepositPendingListReply)(nil),                     // 25: api.DepositPendingListReply
+	(*DepositUnmatchedListRequest)(nil),                 // 26: api.DepositUnmatchedListRequest
+	(*DepositUnmatchedListReply)(nil),                   // 27: api.DepositUnmatchedListReply
+	(*ChainListRequest)(nil),                            // 28: api.ChainListRequest
+	(*ChainListReply)(nil),                              // 29: api.ChainListReply
+	(*DepositAddressRequest)(nil),                       // 30: api.DepositAddressRequest
+	(*DepositAddressReply)(nil),                         // 31: api.DepositAddressReply
+	(*SubmitDepositTxRequest)(nil),                      // 32: api.SubmitDepositTxRequest
+	(*SubmitDepositTxReply)(nil),                        // 33: api.SubmitDepositTxReply
+	(*DepositWebhookRequest)(nil),                       // 34: api.DepositWebhookRequest
+	(*DepositWebhookReply)(nil),                         // 35: api.DepositWebhookReply
+	(*WithdrawRequest)(nil),                             // 36: api.WithdrawRequest
+	(*WithdrawReply)(nil),                               // 37: api.WithdrawReply
+	(*WithdrawCancelRequest)(nil),                       // 38: api.WithdrawCancelRequest
+	(*WithdrawCancelReply)(nil),                         // 39: api.WithdrawCancelReply
+	(*AdminRewardListRequest)(nil),                      // 40: api.AdminRewardListRequest
+	(*AdminRewardListReply)(nil),                        // 41: api.AdminRewardListReply
+	(*AdminUserListRequest)(nil),                        // 42: api.AdminUserListRequest
+	(*AdminUserListReply)(nil),                          // 43: api.AdminUserListReply
+	(*AdminLocationListRequest)(nil),                    // 44: api.AdminLocationListRequest
+	(*AdminLocationListReply)(nil),                      // 45: api.AdminLocationListReply
+	(*AdminWithdrawListRequest)(nil),                    // 46: api.AdminWithdrawListRequest
+	(*AdminWithdrawListReply)(nil),                      // 47: api.AdminWithdrawListReply
+	(*AdminWithdrawRequest)(nil),                        // 48: api.AdminWithdrawRequest
+	(*AdminWithdrawReply)(nil),                          // 49: api.AdminWithdrawReply
+	(*AdminWithdrawReceiptRequest)(nil),                 // 50: api.AdminWithdrawReceiptRequest
+	(*AdminWithdrawReceiptReply)(nil),                   // 51: api.AdminWithdrawReceiptReply
+	(*AdminWithdrawUpdateRequest)(nil),                  // 52: api.AdminWithdrawUpdateRequest
+	(*AdminWithdrawUpdateReply)(nil),                    // 53: api.AdminWithdrawUpdateReply
+	(*AdminWithdrawEventListRequest)(nil),               // 54: api.AdminWithdrawEventListRequest
+	(*AdminWithdrawEventListReply)(nil),                 // 55: api.AdminWithdrawEventListReply
+	(*AdminWithdrawEthRequest)(nil),                     // 56: api.AdminWithdrawEthRequest
+	(*AdminWithdrawEthReply)(nil),                       // 57: api.AdminWithdrawEthReply
+	(*AdminFeeRequest)(nil),                             // 58: api.AdminFeeRequest
+	(*AdminFeeReply)(nil),                               // 59: api.AdminFeeReply
+	(*AdminJobRunListRequest)(nil),                      // 60: api.AdminJobRunListRequest
+	(*AdminJobRunListReply)(nil),                        // 61: api.AdminJobRunListReply
+	(*AdminDepositReorgListRequest)(nil),                // 62: api.AdminDepositReorgListRequest
+	(*AdminDepositReorgListReply)(nil),                  // 63: api.AdminDepositReorgListReply
+	(*AdminDepositUnmatchedListRequest)(nil),            // 64: api.AdminDepositUnmatchedListRequest
+	(*AdminDepositUnmatchedListReply)(nil),              // 65: api.AdminDepositUnmatchedListReply
+	(*AdminDepositUnmatchedHandleRequest)(nil),          // 66: api.AdminDepositUnmatchedHandleRequest
+	(*AdminDepositUnmatchedHandleReply)(nil),            // 67: api.AdminDepositUnmatchedHandleReply
+	(*AdminDepositTierListRequest)(nil),                 // 68: api.AdminDepositTierListRequest
+	(*AdminDepositTierListReply)(nil),                   // 69: api.AdminDepositTierListReply
+	(*AdminDepositTierSaveRequest)(nil),                 // 70: api.AdminDepositTierSaveRequest
+	(*AdminDepositTierSaveReply)(nil),                   // 71: api.AdminDepositTierSaveReply
+	(*AdminDepositSweepRequest)(nil),                    // 72: api.AdminDepositSweepRequest
+	(*AdminDepositSweepReply)(nil),                      // 73: api.AdminDepositSweepReply
+	(*AdminAllRequest)(nil),                             // 74: api.AdminAllRequest
+	(*AdminAllReply)(nil),                               // 75: api.AdminAllReply
+	(*AdminUserRecommendRequest)(nil),                   // 76: api.AdminUserRecommendRequest
+	(*AdminUserRecommendReply)(nil),                     // 77: api.AdminUserRecommendReply
+	(*AdminMonthRecommendRequest)(nil),                  // 78: api.AdminMonthRecommendRequest
+	(*AdminMonthRecommendReply)(nil),                    // 79: api.AdminMonthRecommendReply
+	(*AdminConfigRequest)(nil),                          // 80: api.AdminConfigRequest
+	(*AdminConfigReply)(nil),                            // 81: api.AdminConfigReply
+	(*AdminConfigUpdateRequest)(nil),                    // 82: api.AdminConfigUpdateRequest
+	(*AdminConfigUpdateReply)(nil),                      // 83: api.AdminConfigUpdateReply
+	(*EthAuthorizeRequest_SendBody)(nil),                // 84: api.EthAuthorizeRequest.SendBody
+	(*RefreshTokenRequest_SendBody)(nil),                // 85: api.RefreshTokenRequest.SendBody
+	(*AdminLoginRequest_SendBody)(nil),                  // 86: api.AdminLoginRequest.SendBody
+	(*UserInfoReply_List)(nil),                          // 87: api.UserInfoReply.List
+	(*RewardListReply_List)(nil),                        // 88: api.RewardListReply.List
+	(*RecommendRewardListReply_List)(nil),               // 89: api.RecommendRewardListReply.List
+	(*FeeRewardListReply_List)(nil),                     // 90: api.FeeRewardListReply.List
+	(*WithdrawListReply_List)(nil),                      // 91: api.WithdrawListReply.List
+	(*RecommendListReply_List)(nil),                     // 92: api.RecommendListReply.List
+	(*DepositPendingListReply_List)(nil),                // 93: api.DepositPendingListReply.List
+	(*DepositUnmatchedListReply_List)(nil),              // 94: api.DepositUnmatchedListReply.List
+	(*ChainListReply_Token)(nil),                        // 95: api.ChainListReply.Token
+	(*ChainListReply_List)(nil),                         // 96: api.ChainListReply.List
+	(*SubmitDepositTxRequest_SendBody)(nil),             // 97: api.SubmitDepositTxRequest.SendBody
+	(*DepositWebhookRequest_Transfer)(nil),              // 98: api.DepositWebhookRequest.Transfer
+	(*DepositWebhookRequest_SendBody)(nil),              // 99: api.DepositWebhookRequest.SendBody
+	(*WithdrawRequest_SendBody)(nil),                    // 100: api.WithdrawRequest.SendBody
+	(*WithdrawCancelRequest_SendBody)(nil),              // 101: api.WithdrawCancelRequest.SendBody
+	(*AdminRewardListReply_List)(nil),                   // 102: api.AdminRewardListReply.List
+	(*AdminUserListReply_UserList)(nil),                 // 103: api.AdminUserListReply.UserList
+	(*AdminLocationListReply_LocationList)(nil),         // 104: api.AdminLocationListReply.LocationList
+	(*AdminWithdrawListReply_List)(nil),                 // 105: api.AdminWithdrawListReply.List
+	(*AdminWithdrawUpdateRequest_SendBody)(nil),         // 106: api.AdminWithdrawUpdateRequest.SendBody
+	(*AdminWithdrawEventListReply_List)(nil),            // 107: api.AdminWithdrawEventListReply.List
+	(*AdminJobRunListReply_List)(nil),                   // 108: api.AdminJobRunListReply.List
+	(*AdminDepositReorgListReply_List)(nil),             // 109: api.AdminDepositReorgListReply.List
+	(*AdminDepositUnmatchedListReply_List)(nil),         // 110: api.AdminDepositUnmatchedListReply.List
+	(*AdminDepositUnmatchedHandleRequest_SendBody)(nil), // 111: api.AdminDepositUnmatchedHandleRequest.SendBody
+	(*AdminDepositTierListReply_List)(nil),              // 112: api.AdminDepositTierListReply.List
+	(*AdminDepositTierSaveRequest_SendBody)(nil),        // 113: api.AdminDepositTierSaveRequest.SendBody
+	(*AdminUserRecommendReply_List)(nil),                // 114: api.AdminUserRecommendReply.List
+	(*AdminMonthRecommendReply_List)(nil),               // 115: api.AdminMonthRecommendReply.List
+	(*AdminConfigReply_List)(nil),                       // 116: api.AdminConfigReply.List
+	(*AdminConfigUpdateRequest_SendBody)(nil),           // 117: api.AdminConfigUpdateRequest.SendBody
+}
+var file_api_app_proto_depIdxs = []int32{
+	84,  // 0: api.EthAuthorizeRequest.send_body:type_name -> api.EthAuthorizeRequest.SendBody
+	85,  // 1: api.RefreshTokenRequest.send_body:type_name -> api.RefreshTokenRequest.SendBody
+	86,  // 2: api.AdminLoginRequest.send_body:type_name -> api.AdminLoginRequest.SendBody
+	87,  // 3: api.UserInfoReply.topUser:type_name -> api.UserInfoReply.List
+	88,  // 4: api.RewardListReply.rewards:type_name -> api.RewardListReply.List
+	89,  // 5: api.RecommendRewardListReply.rewards:type_name -> api.RecommendRewardListReply.List
+	90,  // 6: api.FeeRewardListReply.rewards:type_name -> api.FeeRewardListReply.List
+	91,  // 7: api.WithdrawListReply.withdraw:type_name -> api.WithdrawListReply.List
+	92,  // 8: api.RecommendListReply.recommends:type_name -> api.RecommendListReply.List
+	93,  // 9: api.DepositPendingListReply.deposits:type_name -> api.DepositPendingListReply.List
+	94,  // 10: api.DepositUnmatchedListReply.deposits:type_name -> api.DepositUnmatchedListReply.List
+	96,  // 11: api.ChainListReply.chains:type_name -> api.ChainListReply.List
+	97,  // 12: api.SubmitDepositTxRequest.send_body:type_name -> api.SubmitDepositTxRequest.SendBody
+	99,  // 13: api.DepositWebhookRequest.send_body:type_name -> api.DepositWebhookRequest.SendBody
+	100, // 14: api.WithdrawRequest.send_body:type_name -> api.WithdrawRequest.SendBody
+	101, // 15: api.WithdrawCancelRequest.send_body:type_name -> api.WithdrawCancelRequest.SendBody
+	102, // 16: api.AdminRewardListReply.rewards:type_name -> api.AdminRewardListReply.List
+	103, // 17: api.AdminUserListReply.users:type_name -> api.AdminUserListReply.UserList
+	104, // 18: api.AdminLocationListReply.locations:type_name -> api.AdminLocationListReply.LocationList
+	105, // 19: api.AdminWithdrawListReply.withdraw:type_name -> api.AdminWithdrawListReply.List
+	106, // 20: api.AdminWithdrawUpdateRequest.send_body:type_name -> api.AdminWithdrawUpdateRequest.SendBody
+	107, // 21: api.AdminWithdrawEventListReply.events:type_name -> api.AdminWithdrawEventListReply.List
+	108, // 22: api.AdminJobRunListReply.jobRun:type_name -> api.AdminJobRunListReply.List
+	109, // 23: api.AdminDepositReorgListReply.records:type_name -> api.AdminDepositReorgListReply.List
+	110, // 24: api.AdminDepositUnmatchedListReply.records:type_name -> api.AdminDepositUnmatchedListReply.List
+	111, // 25: api.AdminDepositUnmatchedHandleRequest.send_body:type_name -> api.AdminDepositUnmatchedHandleRequest.SendBody
+	112, // 26: api.AdminDepositTierListReply.tiers:type_name -> api.AdminDepositTierListReply.List
+	113, // 27: api.AdminDepositTierSaveRequest.send_body:type_name -> api.AdminDepositTierSaveRequest.SendBody
+	114, // 28: api.AdminUserRecommendReply.users:type_name -> api.AdminUserRecommendReply.List
+	115, // 29: api.AdminMonthRecommendReply.users:type_name -> api.AdminMonthRecommendReply.List
+	116, // 30: api.AdminConfigReply.config:type_name -> api.AdminConfigReply.List
+	117, // 31: api.AdminConfigUpdateRequest.send_body:type_name -> api.AdminConfigUpdateRequest.SendBody
+	95,  // 32: api.ChainListReply.List.tokens:type_name -> api.ChainListReply.Token
+	98,  // 33: api.DepositWebhookRequest.SendBody.transfers:type_name -> api.DepositWebhookRequest.Transfer
+	0,   // 34: api.App.GetAuthNonce:input_type -> api.GetAuthNonceRequest
+	2,   // 35: api.App.EthAuthorize:input_type -> api.EthAuthorizeRequest
+	4,   // 36: api.App.RefreshToken:input_type -> api.RefreshTokenRequest
+	6,   // 37: api.App.Logout:input_type -> api.LogoutRequest
+	12,  // 38: api.App.UserInfo:input_type -> api.UserInfoRequest
+	14,  // 39: api.App.RewardList:input_type -> api.RewardListRequest
+	16,  // 40: api.App.RecommendRewardList:input_type -> api.RecommendRewardListRequest
+	18,  // 41: api.App.FeeRewardList:input_type -> api.FeeRewardListRequest
+	20,  // 42: api.App.WithdrawList:input_type -> api.WithdrawListRequest
+	22,  // 43: api.App.RecommendList:input_type -> api.RecommendListRequest
+	24,  // 44: api.App.DepositPendingList:input_type -> api.DepositPendingListRequest
+	26,  // 45: api.App.DepositUnmatchedList:input_type -> api.DepositUnmatchedListRequest
+	28,  // 46: api.App.ChainList:input_type -> api.ChainListRequest
+	30,  // 47: api.App.DepositAddress:input_type -> api.DepositAddressRequest
+	32,  // 48: api.App.SubmitDepositTx:input_type -> api.SubmitDepositTxRequest
+	34,  // 49: api.App.DepositWebhook:input_type -> api.DepositWebhookRequest
+	36,  // 50: api.App.Withdraw:input_type -> api.WithdrawRequest
+	38,  // 51: api.App.WithdrawCancel:input_type -> api.WithdrawCancelRequest
+	8,   // 52: api.App.AdminLogin:input_type -> api.AdminLoginRequest
+	10,  // 53: api.App.Deposit:input_type -> api.DepositRequest
+	48,  // 54: api.App.AdminWithdraw:input_type -> api.AdminWithdrawRequest
+	56,  // 55: api.App.AdminWithdrawEth:input_type -> api.AdminWithdrawEthRequest
+	50,  // 56: api.App.AdminWithdrawReceipt:input_type -> api.AdminWithdrawReceiptRequest
+	52,  // 57: api.App.AdminWithdrawUpdate:input_type -> api.AdminWithdrawUpdateRequest
+	54,  // 58: api.App.AdminWithdrawEventList:input_type -> api.AdminWithdrawEventListRequest
+	58,  // 59: api.App.AdminFee:input_type -> api.AdminFeeRequest
+	60,  // 60: api.App.AdminJobRunList:input_type -> api.AdminJobRunListRequest
+	62,  // 61: api.App.AdminDepositReorgList:input_type -> api.AdminDepositReorgListRequest
+	64,  // 62: api.App.AdminDepositUnmatchedList:input_type -> api.AdminDepositUnmatchedListRequest
+	66,  // 63: api.App.AdminDepositUnmatchedHandle:input_type -> api.AdminDepositUnmatchedHandleRequest
+	68,  // 64: api.App.AdminDepositTierList:input_type -> api.AdminDepositTierListRequest
+	70,  // 65: api.App.AdminDepositTierSave:input_type -> api.AdminDepositTierSaveRequest
+	72,  // 66: api.App.AdminDepositSweep:input_type -> api.AdminDepositSweepRequest
+	1,   // 67: api.App.GetAuthNonce:output_type -> api.GetAuthNonceReply
+	3,   // 68: api.App.EthAuthorize:output_type -> api.EthAuthorizeReply
+	5,   // 69: api.App.RefreshToken:output_type -> api.RefreshTokenReply
+	7,   // 70: api.App.Logout:output_type -> api.LogoutReply
+	13,  // 71: api.App.UserInfo:output_type -> api.UserInfoReply
+	15,  // 72: api.App.RewardList:output_type -> api.RewardListReply
+	17,  // 73: api.App.RecommendRewardList:output_type -> api.RecommendRewardListReply
+	19,  // 74: api.App.FeeRewardList:output_type -> api.FeeRewardListReply
+	21,  // 75: api.App.WithdrawList:output_type -> api.WithdrawListReply
+	23,  // 76: api.App.RecommendList:output_type -> api.RecommendListReply
+	25,  // 77: api.App.DepositPendingList:output_type -> api.DepositPendingListReply
+	27,  // 78: api.App.DepositUnmatchedList:output_type -> api.DepositUnmatchedListReply
+	29,  // 79: api.App.ChainList:output_type -> api.ChainListReply
+	31,  // 80: api.App.DepositAddress:output_type -> api.DepositAddressReply
+	33,  // 81: api.App.SubmitDepositTx:output_type -> api.SubmitDepositTxReply
+	35,  // 82: api.App.DepositWebhook:output_type -> api.DepositWebhookReply
+	37,  // 83: api.App.Withdraw:output_type -> api.WithdrawReply
+	39,  // 84: api.App.WithdrawCancel:output_type -> api.WithdrawCancelReply
+	9,   // 85: api.App.AdminLogin:output_type -> api.AdminLoginReply
+	11,  // 86: api.App.Deposit:output_type -> api.DepositReply
+	49,  // 87: api.App.AdminWithdraw:output_type -> api.AdminWithdrawReply
+	57,  // 88: api.App.AdminWithdrawEth:output_type -> api.AdminWithdrawEthReply
+	51,  // 89: api.App.AdminWithdrawReceipt:output_type -> api.AdminWithdrawReceiptReply
+	53,  // 90: api.App.AdminWithdrawUpdate:output_type -> api.AdminWithdrawUpdateReply
+	55,  // 91: api.App.AdminWithdrawEventList:output_type -> api.AdminWithdrawEventListReply
+	59,  // 92: api.App.AdminFee:output_type -> api.AdminFeeReply
+	61,  // 93: api.App.AdminJobRunList:output_type -> api.AdminJobRunListReply
+	63,  // 94: api.App.AdminDepositReorgList:output_type -> api.AdminDepositReorgListReply
+	65,  // 95: api.App.AdminDepositUnmatchedList:output_type -> api.AdminDepositUnmatchedListReply
+	67,  // 96: api.App.AdminDepositUnmatchedHandle:output_type -> api.AdminDepositUnmatchedHandleReply
+	69,  // 97: api.App.AdminDepositTierList:output_type -> api.AdminDepositTierListReply
+	71,  // 98: api.App.AdminDepositTierSave:output_type -> api.AdminDepositTierSaveReply
+	73,  // 99: api.App.AdminDepositSweep:output_type -> api.AdminDepositSweepReply
+	67,  // [67:100] is the sub-list for method output_type
+	34,  // [34:67] is the sub-list for method input_type
+	34,  // [34:34] is the sub-list for extension type_name
+	34,  // [34:34] is the sub-list for extension extendee
+	0,   // [0:34] is the sub-list for field type_name
+}
+
+func init() { file_api_app_proto_init() }
+func file_api_app_proto_init() {
+	if File_api_app_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_app_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuthNonceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuthNonceReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthAuthorizeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthAuthorizeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogoutReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLoginReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendRewardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendRewardListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeRewardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeRewardListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositPendingListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositPendingListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositUnmatchedListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositUnmatchedListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositAddressReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitDepositTxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitDepositTxReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositWebhookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositWebhookReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawCancelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawCancelReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4015,8 +8788,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthAuthorizeReply); i {
+		file_api_app_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4027,8 +8800,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositRequest); i {
+		file_api_app_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawReceiptRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4039,8 +8812,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositReply); i {
+		file_api_app_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawReceiptReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4051,8 +8824,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoRequest); i {
+		file_api_app_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4063,8 +8836,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoReply); i {
+		file_api_app_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawUpdateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4075,8 +8848,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardListRequest); i {
+		file_api_app_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawEventListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4087,8 +8860,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardListReply); i {
+		file_api_app_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawEventListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4099,8 +8872,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendRewardListRequest); i {
+		file_api_app_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawEthRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4111,8 +8884,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendRewardListReply); i {
+		file_api_app_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawEthReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4123,8 +8896,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeeRewardListRequest); i {
+		file_api_app_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminFeeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4135,8 +8908,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeeRewardListReply); i {
+		file_api_app_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminFeeReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4147,8 +8920,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawListRequest); i {
+		file_api_app_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminJobRunListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4159,8 +8932,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawListReply); i {
+		file_api_app_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminJobRunListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4171,8 +8944,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendListRequest); i {
+		file_api_app_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositReorgListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4183,8 +8956,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendListReply); i {
+		file_api_app_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositReorgListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4195,8 +8968,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawRequest); i {
+		file_api_app_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositUnmatchedListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4207,8 +8980,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawReply); i {
+		file_api_app_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositUnmatchedListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4219,8 +8992,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminRewardListRequest); i {
+		file_api_app_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositUnmatchedHandleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4231,8 +9004,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminRewardListReply); i {
+		file_api_app_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositUnmatchedHandleReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4243,8 +9016,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserListRequest); i {
+		file_api_app_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositTierListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4255,8 +9028,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserListReply); i {
+		file_api_app_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositTierListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4267,8 +9040,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationListRequest); i {
+		file_api_app_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositTierSaveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4279,8 +9052,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationListReply); i {
+		file_api_app_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositTierSaveReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4291,8 +9064,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawListRequest); i {
+		file_api_app_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositSweepRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4303,8 +9076,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawListReply); i {
+		file_api_app_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositSweepReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4315,8 +9088,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawRequest); i {
+		file_api_app_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAllRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4327,8 +9100,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawReply); i {
+		file_api_app_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAllReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4339,8 +9112,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawEthRequest); i {
+		file_api_app_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserRecommendRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4351,8 +9124,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawEthReply); i {
+		file_api_app_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserRecommendReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4363,8 +9136,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminFeeRequest); i {
+		file_api_app_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminMonthRecommendRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4375,8 +9148,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminFeeReply); i {
+		file_api_app_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminMonthRecommendReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4387,8 +9160,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminAllRequest); i {
+		file_api_app_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4399,8 +9172,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminAllReply); i {
+		file_api_app_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4411,8 +9184,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserRecommendRequest); i {
+		file_api_app_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4423,8 +9196,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserRecommendReply); i {
+		file_api_app_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigUpdateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4435,8 +9208,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminMonthRecommendRequest); i {
+		file_api_app_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthAuthorizeRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4447,8 +9220,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminMonthRecommendReply); i {
+		file_api_app_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4459,8 +9232,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigRequest); i {
+		file_api_app_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLoginRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4471,8 +9244,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigReply); i {
+		file_api_app_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4483,8 +9256,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigUpdateRequest); i {
+		file_api_app_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4495,8 +9268,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigUpdateReply); i {
+		file_api_app_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendRewardListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4507,8 +9280,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthAuthorizeRequest_SendBody); i {
+		file_api_app_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeRewardListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4519,8 +9292,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoReply_List); i {
+		file_api_app_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4531,8 +9304,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardListReply_List); i {
+		file_api_app_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4543,8 +9316,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendRewardListReply_List); i {
+		file_api_app_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositPendingListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4555,8 +9328,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeeRewardListReply_List); i {
+		file_api_app_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositUnmatchedListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4567,8 +9340,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawListReply_List); i {
+		file_api_app_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainListReply_Token); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4579,8 +9352,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendListReply_List); i {
+		file_api_app_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4591,7 +9364,43 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitDepositTxRequest_SendBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositWebhookRequest_Transfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositWebhookRequest_SendBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WithdrawRequest_SendBody); i {
 			case 0:
 				return &v.state
@@ -4603,7 +9412,19 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawCancelRequest_SendBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdminRewardListReply_List); i {
 			case 0:
 				return &v.state
@@ -4615,7 +9436,7 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdminUserListReply_UserList); i {
 			case 0:
 				return &v.state
@@ -4627,7 +9448,7 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdminLocationListReply_LocationList); i {
 			case 0:
 				return &v.state
@@ -4639,7 +9460,7 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdminWithdrawListReply_List); i {
 			case 0:
 				return &v.state
@@ -4651,7 +9472,103 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawUpdateRequest_SendBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawEventListReply_List); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminJobRunListReply_List); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositReorgListReply_List); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositUnmatchedListReply_List); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositUnmatchedHandleRequest_SendBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositTierListReply_List); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositTierSaveRequest_SendBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdminUserRecommendReply_List); i {
 			case 0:
 				return &v.state
@@ -4663,7 +9580,7 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdminMonthRecommendReply_List); i {
 			case 0:
 				return &v.state
@@ -4675,7 +9592,7 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdminConfigReply_List); i {
 			case 0:
 				return &v.state
@@ -4687,7 +9604,7 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdminConfigUpdateRequest_SendBody); i {
 			case 0:
 				return &v.state
@@ -4706,7 +9623,7 @@ func file_api_app_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_app_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   58,
+			NumMessages:   118,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -35,6 +35,218 @@ var (
 	_ = sort.Sort
 )
 
+// Validate checks the field values on GetAuthNonceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAuthNonceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAuthNonceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAuthNonceRequestMultiError, or nil if none found.
+func (m *GetAuthNonceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAuthNonceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return GetAuthNonceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAuthNonceRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAuthNonceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAuthNonceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAuthNonceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAuthNonceRequestMultiError) AllErrors() []error { return m }
+
+// GetAuthNonceRequestValidationError is the validation error returned by
+// GetAuthNonceRequest.Validate if the designated constraints aren't met.
+type GetAuthNonceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAuthNonceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAuthNonceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAuthNonceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAuthNonceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAuthNonceRequestValidationError) ErrorName() string {
+	return "GetAuthNonceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAuthNonceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAuthNonceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAuthNonceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAuthNonceRequestValidationError{}
+
+// Validate checks the field values on GetAuthNonceReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetAuthNonceReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAuthNonceReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAuthNonceReplyMultiError, or nil if none found.
+func (m *GetAuthNonceReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAuthNonceReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Nonce
+
+	// no validation rules for Message
+
+	// no validation rules for ExpiresAt
+
+	if len(errors) > 0 {
+		return GetAuthNonceReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAuthNonceReplyMultiError is an error wrapping multiple validation errors
+// returned by GetAuthNonceReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetAuthNonceReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAuthNonceReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAuthNonceReplyMultiError) AllErrors() []error { return m }
+
+// GetAuthNonceReplyValidationError is the validation error returned by
+// GetAuthNonceReply.Validate if the designated constraints aren't met.
+type GetAuthNonceReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAuthNonceReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAuthNonceReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAuthNonceReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAuthNonceReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAuthNonceReplyValidationError) ErrorName() string {
+	return "GetAuthNonceReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAuthNonceReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAuthNonceReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAuthNonceReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAuthNonceReplyValidationError{}
+
 // Validate checks the field values on EthAuthorizeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -190,6 +402,8 @@ func (m *EthAuthorizeReply) validate(all bool) error {
 
 	// no validation rules for Token
 
+	// no validation rules for RefreshToken
+
 	if len(errors) > 0 {
 		return EthAuthorizeReplyMultiError(errors)
 	}
@@ -270,42 +484,71 @@ var _ interface {
 	ErrorName() string
 } = EthAuthorizeReplyValidationError{}
 
-// Validate checks the field values on DepositRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DepositRequest) Validate() error {
+// Validate checks the field values on RefreshTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DepositRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DepositRequestMultiError,
-// or nil if none found.
-func (m *DepositRequest) ValidateAll() error {
+// ValidateAll checks the field values on RefreshTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshTokenRequestMultiError, or nil if none found.
+func (m *RefreshTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DepositRequest) validate(all bool) error {
+func (m *RefreshTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefreshTokenRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RefreshTokenRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefreshTokenRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return DepositRequestMultiError(errors)
+		return RefreshTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DepositRequestMultiError is an error wrapping multiple validation errors
-// returned by DepositRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DepositRequestMultiError []error
+// RefreshTokenRequestMultiError is an error wrapping multiple validation
+// errors returned by RefreshTokenRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DepositRequestMultiError) Error() string {
+func (m RefreshTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -314,11 +557,11 @@ func (m DepositRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DepositRequestMultiError) AllErrors() []error { return m }
+func (m RefreshTokenRequestMultiError) AllErrors() []error { return m }
 
-// DepositRequestValidationError is the validation error returned by
-// DepositRequest.Validate if the designated constraints aren't met.
-type DepositRequestValidationError struct {
+// RefreshTokenRequestValidationError is the validation error returned by
+// RefreshTokenRequest.Validate if the designated constraints aren't met.
+type RefreshTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -326,22 +569,24 @@ type DepositRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DepositRequestValidationError) Field() string { return e.field }
+func (e RefreshTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DepositRequestValidationError) Reason() string { return e.reason }
+func (e RefreshTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DepositRequestValidationError) Cause() error { return e.cause }
+func (e RefreshTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DepositRequestValidationError) Key() bool { return e.key }
+func (e RefreshTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DepositRequestValidationError) ErrorName() string { return "DepositRequestValidationError" }
+func (e RefreshTokenRequestValidationError) ErrorName() string {
+	return "RefreshTokenRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DepositRequestValidationError) Error() string {
+func (e RefreshTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -353,14 +598,14 @@ func (e DepositRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDepositRequest.%s: %s%s",
+		"invalid %sRefreshTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DepositRequestValidationError{}
+var _ error = RefreshTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -368,43 +613,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DepositRequestValidationError{}
+} = RefreshTokenRequestValidationError{}
 
-// Validate checks the field values on DepositReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DepositReply) Validate() error {
+// Validate checks the field values on RefreshTokenReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RefreshTokenReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DepositReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DepositReplyMultiError, or
-// nil if none found.
-func (m *DepositReply) ValidateAll() error {
+// ValidateAll checks the field values on RefreshTokenReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshTokenReplyMultiError, or nil if none found.
+func (m *RefreshTokenReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DepositReply) validate(all bool) error {
+func (m *RefreshTokenReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Token
+
+	// no validation rules for RefreshToken
+
 	if len(errors) > 0 {
-		return DepositReplyMultiError(errors)
+		return RefreshTokenReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// DepositReplyMultiError is an error wrapping multiple validation errors
-// returned by DepositReply.ValidateAll() if the designated constraints aren't met.
-type DepositReplyMultiError []error
+// RefreshTokenReplyMultiError is an error wrapping multiple validation errors
+// returned by RefreshTokenReply.ValidateAll() if the designated constraints
+// aren't met.
+type RefreshTokenReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DepositReplyMultiError) Error() string {
+func (m RefreshTokenReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -413,11 +663,11 @@ func (m DepositReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DepositReplyMultiError) AllErrors() []error { return m }
+func (m RefreshTokenReplyMultiError) AllErrors() []error { return m }
 
-// DepositReplyValidationError is the validation error returned by
-// DepositReply.Validate if the designated constraints aren't met.
-type DepositReplyValidationError struct {
+// RefreshTokenReplyValidationError is the validation error returned by
+// RefreshTokenReply.Validate if the designated constraints aren't met.
+type RefreshTokenReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -425,22 +675,24 @@ type DepositReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e DepositReplyValidationError) Field() string { return e.field }
+func (e RefreshTokenReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DepositReplyValidationError) Reason() string { return e.reason }
+func (e RefreshTokenReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DepositReplyValidationError) Cause() error { return e.cause }
+func (e RefreshTokenReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DepositReplyValidationError) Key() bool { return e.key }
+func (e RefreshTokenReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DepositReplyValidationError) ErrorName() string { return "DepositReplyValidationError" }
+func (e RefreshTokenReplyValidationError) ErrorName() string {
+	return "RefreshTokenReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DepositReplyValidationError) Error() string {
+func (e RefreshTokenReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -452,14 +704,14 @@ func (e DepositReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDepositReply.%s: %s%s",
+		"invalid %sRefreshTokenReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DepositReplyValidationError{}
+var _ error = RefreshTokenReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -467,24 +719,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DepositReplyValidationError{}
+} = RefreshTokenReplyValidationError{}
 
-// Validate checks the field values on UserInfoRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UserInfoRequest) Validate() error {
+// Validate checks the field values on LogoutRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LogoutRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfoRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserInfoRequestMultiError, or nil if none found.
-func (m *UserInfoRequest) ValidateAll() error {
+// ValidateAll checks the field values on LogoutRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LogoutRequestMultiError, or
+// nil if none found.
+func (m *LogoutRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfoRequest) validate(all bool) error {
+func (m *LogoutRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -492,19 +744,19 @@ func (m *UserInfoRequest) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return UserInfoRequestMultiError(errors)
+		return LogoutRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfoRequestMultiError is an error wrapping multiple validation errors
-// returned by UserInfoRequest.ValidateAll() if the designated constraints
+// LogoutRequestMultiError is an error wrapping multiple validation errors
+// returned by LogoutRequest.ValidateAll() if the designated constraints
 // aren't met.
-type UserInfoRequestMultiError []error
+type LogoutRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfoRequestMultiError) Error() string {
+func (m LogoutRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -513,11 +765,11 @@ func (m UserInfoRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfoRequestMultiError) AllErrors() []error { return m }
+func (m LogoutRequestMultiError) AllErrors() []error { return m }
 
-// UserInfoRequestValidationError is the validation error returned by
-// UserInfoRequest.Validate if the designated constraints aren't met.
-type UserInfoRequestValidationError struct {
+// LogoutRequestValidationError is the validation error returned by
+// LogoutRequest.Validate if the designated constraints aren't met.
+type LogoutRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -525,22 +777,22 @@ type UserInfoRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfoRequestValidationError) Field() string { return e.field }
+func (e LogoutRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfoRequestValidationError) Reason() string { return e.reason }
+func (e LogoutRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfoRequestValidationError) Cause() error { return e.cause }
+func (e LogoutRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfoRequestValidationError) Key() bool { return e.key }
+func (e LogoutRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfoRequestValidationError) ErrorName() string { return "UserInfoRequestValidationError" }
+func (e LogoutRequestValidationError) ErrorName() string { return "LogoutRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UserInfoRequestValidationError) Error() string {
+func (e LogoutRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -552,14 +804,14 @@ func (e UserInfoRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfoRequest.%s: %s%s",
+		"invalid %sLogoutRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfoRequestValidationError{}
+var _ error = LogoutRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -567,142 +819,172 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfoRequestValidationError{}
+} = LogoutRequestValidationError{}
 
-// Validate checks the field values on UserInfoReply with the rules defined in
+// Validate checks the field values on LogoutReply with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *UserInfoReply) Validate() error {
+func (m *LogoutReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfoReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in UserInfoReplyMultiError, or
+// ValidateAll checks the field values on LogoutReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LogoutReplyMultiError, or
 // nil if none found.
-func (m *UserInfoReply) ValidateAll() error {
+func (m *LogoutReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfoReply) validate(all bool) error {
+func (m *LogoutReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
-
-	// no validation rules for Level
-
-	// no validation rules for Status
-
-	// no validation rules for Amount
-
-	// no validation rules for BalanceUsdt
-
-	// no validation rules for BalanceDhb
-
-	// no validation rules for InviteUrl
-
-	// no validation rules for InviteUserAddress
+	if len(errors) > 0 {
+		return LogoutReplyMultiError(errors)
+	}
 
-	// no validation rules for RecommendNum
+	return nil
+}
 
-	// no validation rules for RecommendTeamNum
+// LogoutReplyMultiError is an error wrapping multiple validation errors
+// returned by LogoutReply.ValidateAll() if the designated constraints aren't met.
+type LogoutReplyMultiError []error
 
-	// no validation rules for Total
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogoutReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
-	// no validation rules for Row
+// AllErrors returns a list of validation violation errors.
+func (m LogoutReplyMultiError) AllErrors() []error { return m }
 
-	// no validation rules for Col
+// LogoutReplyValidationError is the validation error returned by
+// LogoutReply.Validate if the designated constraints aren't met.
+type LogoutReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-	// no validation rules for RecommendTotal
+// Field function returns field value.
+func (e LogoutReplyValidationError) Field() string { return e.field }
 
-	// no validation rules for LocationTotal
+// Reason function returns reason value.
+func (e LogoutReplyValidationError) Reason() string { return e.reason }
 
-	// no validation rules for Usdt
+// Cause function returns cause value.
+func (e LogoutReplyValidationError) Cause() error { return e.cause }
 
-	// no validation rules for Account
+// Key function returns key value.
+func (e LogoutReplyValidationError) Key() bool { return e.key }
 
-	// no validation rules for RecommendNumAll
+// ErrorName returns error name.
+func (e LogoutReplyValidationError) ErrorName() string { return "LogoutReplyValidationError" }
 
-	// no validation rules for RecommendTeamAll
+// Error satisfies the builtin error interface
+func (e LogoutReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
 
-	// no validation rules for AmountB
+	key := ""
+	if e.key {
+		key = "key for "
+	}
 
-	// no validation rules for RecommendVipTotal
+	return fmt.Sprintf(
+		"invalid %sLogoutReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-	// no validation rules for FeeDaily
+var _ error = LogoutReplyValidationError{}
 
-	// no validation rules for WithdrawAmount
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogoutReplyValidationError{}
 
-	// no validation rules for UserCount
+// Validate checks the field values on AdminLoginRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminLoginRequest) Validate() error {
+	return m.validate(false)
+}
 
-	// no validation rules for TotalDeposit
+// ValidateAll checks the field values on AdminLoginRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLoginRequestMultiError, or nil if none found.
+func (m *AdminLoginRequest) ValidateAll() error {
+	return m.validate(true)
+}
 
-	// no validation rules for PoolAmount
+func (m *AdminLoginRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
 
-	for idx, item := range m.GetTopUser() {
-		_, _ = idx, item
+	var errors []error
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UserInfoReplyValidationError{
-						field:  fmt.Sprintf("TopUser[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UserInfoReplyValidationError{
-						field:  fmt.Sprintf("TopUser[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminLoginRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return UserInfoReplyValidationError{
-					field:  fmt.Sprintf("TopUser[%v]", idx),
+				errors = append(errors, AdminLoginRequestValidationError{
+					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminLoginRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for LocationCount
-
-	// no validation rules for TodayReward
-
-	// no validation rules for RecommendTop
-
-	// no validation rules for LocationTotalCol
-
-	// no validation rules for LocationTotalRow
-
-	// no validation rules for BnbAmount
-
 	if len(errors) > 0 {
-		return UserInfoReplyMultiError(errors)
+		return AdminLoginRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfoReplyMultiError is an error wrapping multiple validation errors
-// returned by UserInfoReply.ValidateAll() if the designated constraints
+// AdminLoginRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminLoginRequest.ValidateAll() if the designated constraints
 // aren't met.
-type UserInfoReplyMultiError []error
+type AdminLoginRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfoReplyMultiError) Error() string {
+func (m AdminLoginRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -711,11 +993,11 @@ func (m UserInfoReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfoReplyMultiError) AllErrors() []error { return m }
+func (m AdminLoginRequestMultiError) AllErrors() []error { return m }
 
-// UserInfoReplyValidationError is the validation error returned by
-// UserInfoReply.Validate if the designated constraints aren't met.
-type UserInfoReplyValidationError struct {
+// AdminLoginRequestValidationError is the validation error returned by
+// AdminLoginRequest.Validate if the designated constraints aren't met.
+type AdminLoginRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -723,22 +1005,24 @@ type UserInfoReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfoReplyValidationError) Field() string { return e.field }
+func (e AdminLoginRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfoReplyValidationError) Reason() string { return e.reason }
+func (e AdminLoginRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfoReplyValidationError) Cause() error { return e.cause }
+func (e AdminLoginRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfoReplyValidationError) Key() bool { return e.key }
+func (e AdminLoginRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfoReplyValidationError) ErrorName() string { return "UserInfoReplyValidationError" }
+func (e AdminLoginRequestValidationError) ErrorName() string {
+	return "AdminLoginRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UserInfoReplyValidationError) Error() string {
+func (e AdminLoginRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -750,14 +1034,14 @@ func (e UserInfoReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfoReply.%s: %s%s",
+		"invalid %sAdminLoginRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfoReplyValidationError{}
+var _ error = AdminLoginRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -765,46 +1049,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfoReplyValidationError{}
+} = AdminLoginRequestValidationError{}
 
-// Validate checks the field values on RewardListRequest with the rules defined
+// Validate checks the field values on AdminLoginReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *RewardListRequest) Validate() error {
+func (m *AdminLoginReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RewardListRequest with the rules
+// ValidateAll checks the field values on AdminLoginReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RewardListRequestMultiError, or nil if none found.
-func (m *RewardListRequest) ValidateAll() error {
+// AdminLoginReplyMultiError, or nil if none found.
+func (m *AdminLoginReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RewardListRequest) validate(all bool) error {
+func (m *AdminLoginReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Type
+	// no validation rules for Token
 
 	if len(errors) > 0 {
-		return RewardListRequestMultiError(errors)
+		return AdminLoginReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// RewardListRequestMultiError is an error wrapping multiple validation errors
-// returned by RewardListRequest.ValidateAll() if the designated constraints
+// AdminLoginReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminLoginReply.ValidateAll() if the designated constraints
 // aren't met.
-type RewardListRequestMultiError []error
+type AdminLoginReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RewardListRequestMultiError) Error() string {
+func (m AdminLoginReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -813,11 +1097,11 @@ func (m RewardListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RewardListRequestMultiError) AllErrors() []error { return m }
+func (m AdminLoginReplyMultiError) AllErrors() []error { return m }
 
-// RewardListRequestValidationError is the validation error returned by
-// RewardListRequest.Validate if the designated constraints aren't met.
-type RewardListRequestValidationError struct {
+// AdminLoginReplyValidationError is the validation error returned by
+// AdminLoginReply.Validate if the designated constraints aren't met.
+type AdminLoginReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -825,24 +1109,22 @@ type RewardListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RewardListRequestValidationError) Field() string { return e.field }
+func (e AdminLoginReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RewardListRequestValidationError) Reason() string { return e.reason }
+func (e AdminLoginReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RewardListRequestValidationError) Cause() error { return e.cause }
+func (e AdminLoginReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RewardListRequestValidationError) Key() bool { return e.key }
+func (e AdminLoginReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RewardListRequestValidationError) ErrorName() string {
-	return "RewardListRequestValidationError"
-}
+func (e AdminLoginReplyValidationError) ErrorName() string { return "AdminLoginReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RewardListRequestValidationError) Error() string {
+func (e AdminLoginReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -854,14 +1136,14 @@ func (e RewardListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRewardListRequest.%s: %s%s",
+		"invalid %sAdminLoginReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RewardListRequestValidationError{}
+var _ error = AdminLoginReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -869,47 +1151,6479 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RewardListRequestValidationError{}
+} = AdminLoginReplyValidationError{}
 
-// Validate checks the field values on RewardListReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *RewardListReply) Validate() error {
+// Validate checks the field values on DepositRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DepositRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RewardListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RewardListReplyMultiError, or nil if none found.
-func (m *RewardListReply) ValidateAll() error {
+// ValidateAll checks the field values on DepositRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DepositRequestMultiError,
+// or nil if none found.
+func (m *DepositRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RewardListReply) validate(all bool) error {
+func (m *DepositRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRewards() {
-		_, _ = idx, item
+	if len(errors) > 0 {
+		return DepositRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DepositRequestMultiError is an error wrapping multiple validation errors
+// returned by DepositRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DepositRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DepositRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DepositRequestMultiError) AllErrors() []error { return m }
+
+// DepositRequestValidationError is the validation error returned by
+// DepositRequest.Validate if the designated constraints aren't met.
+type DepositRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DepositRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DepositRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DepositRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DepositRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DepositRequestValidationError) ErrorName() string { return "DepositRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DepositRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDepositRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DepositRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DepositRequestValidationError{}
+
+// Validate checks the field values on DepositReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DepositReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DepositReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DepositReplyMultiError, or
+// nil if none found.
+func (m *DepositReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DepositReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return DepositReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DepositReplyMultiError is an error wrapping multiple validation errors
+// returned by DepositReply.ValidateAll() if the designated constraints aren't met.
+type DepositReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DepositReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DepositReplyMultiError) AllErrors() []error { return m }
+
+// DepositReplyValidationError is the validation error returned by
+// DepositReply.Validate if the designated constraints aren't met.
+type DepositReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DepositReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DepositReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DepositReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DepositReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DepositReplyValidationError) ErrorName() string { return "DepositReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DepositReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDepositReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DepositReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DepositReplyValidationError{}
+
+// Validate checks the field values on UserInfoRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserInfoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserInfoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserInfoRequestMultiError, or nil if none found.
+func (m *UserInfoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserInfoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UserInfoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserInfoRequestMultiError is an error wrapping multiple validation errors
+// returned by UserInfoRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UserInfoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserInfoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserInfoRequestMultiError) AllErrors() []error { return m }
+
+// UserInfoRequestValidationError is the validation error returned by
+// UserInfoRequest.Validate if the designated constraints aren't met.
+type UserInfoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserInfoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserInfoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserInfoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserInfoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserInfoRequestValidationError) ErrorName() string { return "UserInfoRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserInfoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserInfoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserInfoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserInfoRequestValidationError{}
+
+// Validate checks the field values on UserInfoReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserInfoReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserInfoReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserInfoReplyMultiError, or
+// nil if none found.
+func (m *UserInfoReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserInfoReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Level
+
+	// no validation rules for Status
+
+	// no validation rules for Amount
+
+	// no validation rules for BalanceUsdt
+
+	// no validation rules for BalanceDhb
+
+	// no validation rules for InviteUrl
+
+	// no validation rules for InviteUserAddress
+
+	// no validation rules for RecommendNum
+
+	// no validation rules for RecommendTeamNum
+
+	// no validation rules for Total
+
+	// no validation rules for Row
+
+	// no validation rules for Col
+
+	// no validation rules for RecommendTotal
+
+	// no validation rules for LocationTotal
+
+	// no validation rules for Usdt
+
+	// no validation rules for Account
+
+	// no validation rules for RecommendNumAll
+
+	// no validation rules for RecommendTeamAll
+
+	// no validation rules for AmountB
+
+	// no validation rules for RecommendVipTotal
+
+	// no validation rules for FeeDaily
+
+	// no validation rules for WithdrawAmount
+
+	// no validation rules for UserCount
+
+	// no validation rules for TotalDeposit
+
+	// no validation rules for PoolAmount
+
+	for idx, item := range m.GetTopUser() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("TopUser[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("TopUser[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserInfoReplyValidationError{
+					field:  fmt.Sprintf("TopUser[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for LocationCount
+
+	// no validation rules for TodayReward
+
+	// no validation rules for RecommendTop
+
+	// no validation rules for LocationTotalCol
+
+	// no validation rules for LocationTotalRow
+
+	// no validation rules for BnbAmount
+
+	if len(errors) > 0 {
+		return UserInfoReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserInfoReplyMultiError is an error wrapping multiple validation errors
+// returned by UserInfoReply.ValidateAll() if the designated constraints
+// aren't met.
+type UserInfoReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserInfoReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserInfoReplyMultiError) AllErrors() []error { return m }
+
+// UserInfoReplyValidationError is the validation error returned by
+// UserInfoReply.Validate if the designated constraints aren't met.
+type UserInfoReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserInfoReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserInfoReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserInfoReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserInfoReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserInfoReplyValidationError) ErrorName() string { return "UserInfoReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserInfoReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserInfoReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserInfoReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserInfoReplyValidationError{}
+
+// Validate checks the field values on RewardListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RewardListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RewardListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RewardListRequestMultiError, or nil if none found.
+func (m *RewardListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RewardListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	if len(errors) > 0 {
+		return RewardListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RewardListRequestMultiError is an error wrapping multiple validation errors
+// returned by RewardListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RewardListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RewardListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RewardListRequestMultiError) AllErrors() []error { return m }
+
+// RewardListRequestValidationError is the validation error returned by
+// RewardListRequest.Validate if the designated constraints aren't met.
+type RewardListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RewardListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RewardListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RewardListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RewardListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RewardListRequestValidationError) ErrorName() string {
+	return "RewardListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RewardListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRewardListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RewardListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RewardListRequestValidationError{}
+
+// Validate checks the field values on RewardListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RewardListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RewardListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RewardListReplyMultiError, or nil if none found.
+func (m *RewardListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RewardListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRewards() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RewardListReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RewardListReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RewardListReplyValidationError{
+					field:  fmt.Sprintf("Rewards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RewardListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RewardListReplyMultiError is an error wrapping multiple validation errors
+// returned by RewardListReply.ValidateAll() if the designated constraints
+// aren't met.
+type RewardListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RewardListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RewardListReplyMultiError) AllErrors() []error { return m }
+
+// RewardListReplyValidationError is the validation error returned by
+// RewardListReply.Validate if the designated constraints aren't met.
+type RewardListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RewardListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RewardListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RewardListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RewardListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RewardListReplyValidationError) ErrorName() string { return "RewardListReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RewardListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRewardListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RewardListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RewardListReplyValidationError{}
+
+// Validate checks the field values on RecommendRewardListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendRewardListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecommendRewardListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendRewardListRequestMultiError, or nil if none found.
+func (m *RecommendRewardListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecommendRewardListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RecommendRewardListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecommendRewardListRequestMultiError is an error wrapping multiple
+// validation errors returned by RecommendRewardListRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RecommendRewardListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendRewardListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendRewardListRequestMultiError) AllErrors() []error { return m }
+
+// RecommendRewardListRequestValidationError is the validation error returned
+// by RecommendRewardListRequest.Validate if the designated constraints aren't met.
+type RecommendRewardListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendRewardListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendRewardListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendRewardListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendRewardListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendRewardListRequestValidationError) ErrorName() string {
+	return "RecommendRewardListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendRewardListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendRewardListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendRewardListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendRewardListRequestValidationError{}
+
+// Validate checks the field values on RecommendRewardListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendRewardListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecommendRewardListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendRewardListReplyMultiError, or nil if none found.
+func (m *RecommendRewardListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecommendRewardListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRewards() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RecommendRewardListReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RecommendRewardListReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RecommendRewardListReplyValidationError{
+					field:  fmt.Sprintf("Rewards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RecommendRewardListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecommendRewardListReplyMultiError is an error wrapping multiple validation
+// errors returned by RecommendRewardListReply.ValidateAll() if the designated
+// constraints aren't met.
+type RecommendRewardListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendRewardListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendRewardListReplyMultiError) AllErrors() []error { return m }
+
+// RecommendRewardListReplyValidationError is the validation error returned by
+// RecommendRewardListReply.Validate if the designated constraints aren't met.
+type RecommendRewardListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendRewardListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendRewardListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendRewardListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendRewardListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendRewardListReplyValidationError) ErrorName() string {
+	return "RecommendRewardListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendRewardListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendRewardListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendRewardListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendRewardListReplyValidationError{}
+
+// Validate checks the field values on FeeRewardListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FeeRewardListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FeeRewardListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FeeRewardListRequestMultiError, or nil if none found.
+func (m *FeeRewardListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FeeRewardListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return FeeRewardListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FeeRewardListRequestMultiError is an error wrapping multiple validation
+// errors returned by FeeRewardListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FeeRewardListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FeeRewardListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FeeRewardListRequestMultiError) AllErrors() []error { return m }
+
+// FeeRewardListRequestValidationError is the validation error returned by
+// FeeRewardListRequest.Validate if the designated constraints aren't met.
+type FeeRewardListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FeeRewardListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FeeRewardListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FeeRewardListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FeeRewardListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FeeRewardListRequestValidationError) ErrorName() string {
+	return "FeeRewardListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FeeRewardListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFeeRewardListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FeeRewardListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FeeRewardListRequestValidationError{}
+
+// Validate checks the field values on FeeRewardListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FeeRewardListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FeeRewardListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FeeRewardListReplyMultiError, or nil if none found.
+func (m *FeeRewardListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FeeRewardListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRewards() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FeeRewardListReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FeeRewardListReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FeeRewardListReplyValidationError{
+					field:  fmt.Sprintf("Rewards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FeeRewardListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// FeeRewardListReplyMultiError is an error wrapping multiple validation errors
+// returned by FeeRewardListReply.ValidateAll() if the designated constraints
+// aren't met.
+type FeeRewardListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FeeRewardListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FeeRewardListReplyMultiError) AllErrors() []error { return m }
+
+// FeeRewardListReplyValidationError is the validation error returned by
+// FeeRewardListReply.Validate if the designated constraints aren't met.
+type FeeRewardListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FeeRewardListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FeeRewardListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FeeRewardListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FeeRewardListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FeeRewardListReplyValidationError) ErrorName() string {
+	return "FeeRewardListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FeeRewardListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFeeRewardListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FeeRewardListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FeeRewardListReplyValidationError{}
+
+// Validate checks the field values on WithdrawListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawListRequestMultiError, or nil if none found.
+func (m *WithdrawListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	if len(errors) > 0 {
+		return WithdrawListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawListRequestMultiError is an error wrapping multiple validation
+// errors returned by WithdrawListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawListRequestMultiError) AllErrors() []error { return m }
+
+// WithdrawListRequestValidationError is the validation error returned by
+// WithdrawListRequest.Validate if the designated constraints aren't met.
+type WithdrawListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawListRequestValidationError) ErrorName() string {
+	return "WithdrawListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawListRequestValidationError{}
+
+// Validate checks the field values on WithdrawListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawListReplyMultiError, or nil if none found.
+func (m *WithdrawListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWithdraw() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WithdrawListReplyValidationError{
+						field:  fmt.Sprintf("Withdraw[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WithdrawListReplyValidationError{
+						field:  fmt.Sprintf("Withdraw[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WithdrawListReplyValidationError{
+					field:  fmt.Sprintf("Withdraw[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WithdrawListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawListReplyMultiError is an error wrapping multiple validation errors
+// returned by WithdrawListReply.ValidateAll() if the designated constraints
+// aren't met.
+type WithdrawListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawListReplyMultiError) AllErrors() []error { return m }
+
+// WithdrawListReplyValidationError is the validation error returned by
+// WithdrawListReply.Validate if the designated constraints aren't met.
+type WithdrawListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawListReplyValidationError) ErrorName() string {
+	return "WithdrawListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawListReplyValidationError{}
+
+// Validate checks the field values on RecommendListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecommendListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendListRequestMultiError, or nil if none found.
+func (m *RecommendListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecommendListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	if len(errors) > 0 {
+		return RecommendListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecommendListRequestMultiError is an error wrapping multiple validation
+// errors returned by RecommendListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RecommendListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendListRequestMultiError) AllErrors() []error { return m }
+
+// RecommendListRequestValidationError is the validation error returned by
+// RecommendListRequest.Validate if the designated constraints aren't met.
+type RecommendListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendListRequestValidationError) ErrorName() string {
+	return "RecommendListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendListRequestValidationError{}
+
+// Validate checks the field values on RecommendListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecommendListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendListReplyMultiError, or nil if none found.
+func (m *RecommendListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecommendListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecommends() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RecommendListReplyValidationError{
+						field:  fmt.Sprintf("Recommends[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RecommendListReplyValidationError{
+						field:  fmt.Sprintf("Recommends[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RecommendListReplyValidationError{
+					field:  fmt.Sprintf("Recommends[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RecommendListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecommendListReplyMultiError is an error wrapping multiple validation errors
+// returned by RecommendListReply.ValidateAll() if the designated constraints
+// aren't met.
+type RecommendListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendListReplyMultiError) AllErrors() []error { return m }
+
+// RecommendListReplyValidationError is the validation error returned by
+// RecommendListReply.Validate if the designated constraints aren't met.
+type RecommendListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendListReplyValidationError) ErrorName() string {
+	return "RecommendListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendListReplyValidationError{}
+
+// Validate checks the field values on DepositPendingListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DepositPendingListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DepositPendingListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DepositPendingListRequestMultiError, or nil if none found.
+func (m *DepositPendingListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DepositPendingListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DepositPendingListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DepositPendingListRequestMultiError is an error wrapping multiple validation
+// errors returned by DepositPendingListRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DepositPendingListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DepositPendingListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DepositPendingListRequestMultiError) AllErrors() []error { return m }
+
+// DepositPendingListRequestValidationError is the validation error returned by
+// DepositPendingListRequest.Validate if the designated constraints aren't met.
+type DepositPendingListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DepositPendingListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DepositPendingListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DepositPendingListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DepositPendingListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DepositPendingListRequestValidationError) ErrorName() string {
+	return "DepositPendingListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DepositPendingListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDepositPendingListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DepositPendingListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DepositPendingListRequestValidationError{}
+
+// Validate checks the field values on DepositPendingListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DepositPendingListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DepositPendingListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DepositPendingListReplyMultiError, or nil if none found.
+func (m *DepositPendingListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DepositPendingListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDeposits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DepositPendingListReplyValidationError{
+						field:  fmt.Sprintf("Deposits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DepositPendingListReplyValidationError{
+						field:  fmt.Sprintf("Deposits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DepositPendingListReplyValidationError{
+					field:  fmt.Sprintf("Deposits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DepositPendingListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DepositPendingListReplyMultiError is an error wrapping multiple validation
+// errors returned by DepositPendingListReply.ValidateAll() if the designated
+// constraints aren't met.
+type DepositPendingListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DepositPendingListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DepositPendingListReplyMultiError) AllErrors() []error { return m }
+
+// DepositPendingListReplyValidationError is the validation error returned by
+// DepositPendingListReply.Validate if the designated constraints aren't met.
+type DepositPendingListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DepositPendingListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DepositPendingListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DepositPendingListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DepositPendingListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DepositPendingListReplyValidationError) ErrorName() string {
+	return "DepositPendingListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DepositPendingListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDepositPendingListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DepositPendingListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DepositPendingListReplyValidationError{}
+
+// Validate checks the field values on DepositUnmatchedListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DepositUnmatchedListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DepositUnmatchedListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DepositUnmatchedListRequestMultiError, or nil if none found.
+func (m *DepositUnmatchedListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DepositUnmatchedListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DepositUnmatchedListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DepositUnmatchedListRequestMultiError is an error wrapping multiple
+// validation errors returned by DepositUnmatchedListRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DepositUnmatchedListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DepositUnmatchedListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DepositUnmatchedListRequestMultiError) AllErrors() []error { return m }
+
+// DepositUnmatchedListRequestValidationError is the validation error returned
+// by DepositUnmatchedListRequest.Validate if the designated constraints
+// aren't met.
+type DepositUnmatchedListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DepositUnmatchedListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DepositUnmatchedListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DepositUnmatchedListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DepositUnmatchedListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DepositUnmatchedListRequestValidationError) ErrorName() string {
+	return "DepositUnmatchedListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DepositUnmatchedListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDepositUnmatchedListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DepositUnmatchedListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DepositUnmatchedListRequestValidationError{}
+
+// Validate checks the field values on DepositUnmatchedListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DepositUnmatchedListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DepositUnmatchedListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DepositUnmatchedListReplyMultiError, or nil if none found.
+func (m *DepositUnmatchedListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DepositUnmatchedListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDeposits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DepositUnmatchedListReplyValidationError{
+						field:  fmt.Sprintf("Deposits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DepositUnmatchedListReplyValidationError{
+						field:  fmt.Sprintf("Deposits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DepositUnmatchedListReplyValidationError{
+					field:  fmt.Sprintf("Deposits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DepositUnmatchedListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DepositUnmatchedListReplyMultiError is an error wrapping multiple validation
+// errors returned by DepositUnmatchedListReply.ValidateAll() if the
+// designated constraints aren't met.
+type DepositUnmatchedListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DepositUnmatchedListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DepositUnmatchedListReplyMultiError) AllErrors() []error { return m }
+
+// DepositUnmatchedListReplyValidationError is the validation error returned by
+// DepositUnmatchedListReply.Validate if the designated constraints aren't met.
+type DepositUnmatchedListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DepositUnmatchedListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DepositUnmatchedListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DepositUnmatchedListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DepositUnmatchedListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DepositUnmatchedListReplyValidationError) ErrorName() string {
+	return "DepositUnmatchedListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DepositUnmatchedListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDepositUnmatchedListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DepositUnmatchedListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DepositUnmatchedListReplyValidationError{}
+
+// Validate checks the field values on ChainListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ChainListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChainListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChainListRequestMultiError, or nil if none found.
+func (m *ChainListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChainListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ChainListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChainListRequestMultiError is an error wrapping multiple validation errors
+// returned by ChainListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ChainListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChainListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChainListRequestMultiError) AllErrors() []error { return m }
+
+// ChainListRequestValidationError is the validation error returned by
+// ChainListRequest.Validate if the designated constraints aren't met.
+type ChainListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChainListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChainListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChainListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChainListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChainListRequestValidationError) ErrorName() string { return "ChainListRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChainListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChainListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChainListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChainListRequestValidationError{}
+
+// Validate checks the field values on ChainListReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ChainListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChainListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ChainListReplyMultiError,
+// or nil if none found.
+func (m *ChainListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChainListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChains() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChainListReplyValidationError{
+						field:  fmt.Sprintf("Chains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChainListReplyValidationError{
+						field:  fmt.Sprintf("Chains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChainListReplyValidationError{
+					field:  fmt.Sprintf("Chains[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ChainListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChainListReplyMultiError is an error wrapping multiple validation errors
+// returned by ChainListReply.ValidateAll() if the designated constraints
+// aren't met.
+type ChainListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChainListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChainListReplyMultiError) AllErrors() []error { return m }
+
+// ChainListReplyValidationError is the validation error returned by
+// ChainListReply.Validate if the designated constraints aren't met.
+type ChainListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChainListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChainListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChainListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChainListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChainListReplyValidationError) ErrorName() string { return "ChainListReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChainListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChainListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChainListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChainListReplyValidationError{}
+
+// Validate checks the field values on DepositAddressRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DepositAddressRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DepositAddressRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DepositAddressRequestMultiError, or nil if none found.
+func (m *DepositAddressRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DepositAddressRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ChainId
+
+	if len(errors) > 0 {
+		return DepositAddressRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DepositAddressRequestMultiError is an error wrapping multiple validation
+// errors returned by DepositAddressRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DepositAddressRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DepositAddressRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DepositAddressRequestMultiError) AllErrors() []error { return m }
+
+// DepositAddressRequestValidationError is the validation error returned by
+// DepositAddressRequest.Validate if the designated constraints aren't met.
+type DepositAddressRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DepositAddressRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DepositAddressRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DepositAddressRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DepositAddressRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DepositAddressRequestValidationError) ErrorName() string {
+	return "DepositAddressRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DepositAddressRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDepositAddressRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DepositAddressRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DepositAddressRequestValidationError{}
+
+// Validate checks the field values on DepositAddressReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DepositAddressReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DepositAddressReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DepositAddressReplyMultiError, or nil if none found.
+func (m *DepositAddressReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DepositAddressReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Shared
+
+	// no validation rules for ChainId
+
+	if len(errors) > 0 {
+		return DepositAddressReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DepositAddressReplyMultiError is an error wrapping multiple validation
+// errors returned by DepositAddressReply.ValidateAll() if the designated
+// constraints aren't met.
+type DepositAddressReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DepositAddressReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DepositAddressReplyMultiError) AllErrors() []error { return m }
+
+// DepositAddressReplyValidationError is the validation error returned by
+// DepositAddressReply.Validate if the designated constraints aren't met.
+type DepositAddressReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DepositAddressReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DepositAddressReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DepositAddressReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DepositAddressReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DepositAddressReplyValidationError) ErrorName() string {
+	return "DepositAddressReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DepositAddressReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDepositAddressReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DepositAddressReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DepositAddressReplyValidationError{}
+
+// Validate checks the field values on SubmitDepositTxRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitDepositTxRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitDepositTxRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitDepositTxRequestMultiError, or nil if none found.
+func (m *SubmitDepositTxRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitDepositTxRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubmitDepositTxRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubmitDepositTxRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubmitDepositTxRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SubmitDepositTxRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitDepositTxRequestMultiError is an error wrapping multiple validation
+// errors returned by SubmitDepositTxRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SubmitDepositTxRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitDepositTxRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitDepositTxRequestMultiError) AllErrors() []error { return m }
+
+// SubmitDepositTxRequestValidationError is the validation error returned by
+// SubmitDepositTxRequest.Validate if the designated constraints aren't met.
+type SubmitDepositTxRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitDepositTxRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitDepositTxRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitDepositTxRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitDepositTxRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitDepositTxRequestValidationError) ErrorName() string {
+	return "SubmitDepositTxRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitDepositTxRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitDepositTxRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitDepositTxRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitDepositTxRequestValidationError{}
+
+// Validate checks the field values on SubmitDepositTxReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitDepositTxReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitDepositTxReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitDepositTxReplyMultiError, or nil if none found.
+func (m *SubmitDepositTxReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitDepositTxReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for CoinType
+
+	// no validation rules for Amount
+
+	// no validation rules for Confirmations
+
+	// no validation rules for Required
+
+	if len(errors) > 0 {
+		return SubmitDepositTxReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitDepositTxReplyMultiError is an error wrapping multiple validation
+// errors returned by SubmitDepositTxReply.ValidateAll() if the designated
+// constraints aren't met.
+type SubmitDepositTxReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitDepositTxReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitDepositTxReplyMultiError) AllErrors() []error { return m }
+
+// SubmitDepositTxReplyValidationError is the validation error returned by
+// SubmitDepositTxReply.Validate if the designated constraints aren't met.
+type SubmitDepositTxReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitDepositTxReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitDepositTxReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitDepositTxReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitDepositTxReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitDepositTxReplyValidationError) ErrorName() string {
+	return "SubmitDepositTxReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitDepositTxReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitDepositTxReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitDepositTxReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitDepositTxReplyValidationError{}
+
+// Validate checks the field values on DepositWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DepositWebhookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DepositWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DepositWebhookRequestMultiError, or nil if none found.
+func (m *DepositWebhookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DepositWebhookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DepositWebhookRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DepositWebhookRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DepositWebhookRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DepositWebhookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DepositWebhookRequestMultiError is an error wrapping multiple validation
+// errors returned by DepositWebhookRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DepositWebhookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DepositWebhookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DepositWebhookRequestMultiError) AllErrors() []error { return m }
+
+// DepositWebhookRequestValidationError is the validation error returned by
+// DepositWebhookRequest.Validate if the designated constraints aren't met.
+type DepositWebhookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DepositWebhookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DepositWebhookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DepositWebhookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DepositWebhookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DepositWebhookRequestValidationError) ErrorName() string {
+	return "DepositWebhookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DepositWebhookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDepositWebhookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DepositWebhookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DepositWebhookRequestValidationError{}
+
+// Validate checks the field values on DepositWebhookReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DepositWebhookReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DepositWebhookReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DepositWebhookReplyMultiError, or nil if none found.
+func (m *DepositWebhookReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DepositWebhookReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	// no validation rules for Pending
+
+	// no validation rules for Ignored
+
+	if len(errors) > 0 {
+		return DepositWebhookReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DepositWebhookReplyMultiError is an error wrapping multiple validation
+// errors returned by DepositWebhookReply.ValidateAll() if the designated
+// constraints aren't met.
+type DepositWebhookReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DepositWebhookReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DepositWebhookReplyMultiError) AllErrors() []error { return m }
+
+// DepositWebhookReplyValidationError is the validation error returned by
+// DepositWebhookReply.Validate if the designated constraints aren't met.
+type DepositWebhookReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DepositWebhookReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DepositWebhookReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DepositWebhookReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DepositWebhookReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DepositWebhookReplyValidationError) ErrorName() string {
+	return "DepositWebhookReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DepositWebhookReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDepositWebhookReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DepositWebhookReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DepositWebhookReplyValidationError{}
+
+// Validate checks the field values on WithdrawRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawRequestMultiError, or nil if none found.
+func (m *WithdrawRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WithdrawRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WithdrawRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WithdrawRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WithdrawRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawRequestMultiError is an error wrapping multiple validation errors
+// returned by WithdrawRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WithdrawRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawRequestMultiError) AllErrors() []error { return m }
+
+// WithdrawRequestValidationError is the validation error returned by
+// WithdrawRequest.Validate if the designated constraints aren't met.
+type WithdrawRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawRequestValidationError) ErrorName() string { return "WithdrawRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WithdrawRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawRequestValidationError{}
+
+// Validate checks the field values on WithdrawReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WithdrawReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WithdrawReplyMultiError, or
+// nil if none found.
+func (m *WithdrawReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return WithdrawReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawReplyMultiError is an error wrapping multiple validation errors
+// returned by WithdrawReply.ValidateAll() if the designated constraints
+// aren't met.
+type WithdrawReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawReplyMultiError) AllErrors() []error { return m }
+
+// WithdrawReplyValidationError is the validation error returned by
+// WithdrawReply.Validate if the designated constraints aren't met.
+type WithdrawReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawReplyValidationError) ErrorName() string { return "WithdrawReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WithdrawReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawReplyValidationError{}
+
+// Validate checks the field values on WithdrawCancelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawCancelRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawCancelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawCancelRequestMultiError, or nil if none found.
+func (m *WithdrawCancelRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawCancelRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WithdrawCancelRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WithdrawCancelRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WithdrawCancelRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WithdrawCancelRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawCancelRequestMultiError is an error wrapping multiple validation
+// errors returned by WithdrawCancelRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawCancelRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawCancelRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawCancelRequestMultiError) AllErrors() []error { return m }
+
+// WithdrawCancelRequestValidationError is the validation error returned by
+// WithdrawCancelRequest.Validate if the designated constraints aren't met.
+type WithdrawCancelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawCancelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawCancelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawCancelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawCancelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawCancelRequestValidationError) ErrorName() string {
+	return "WithdrawCancelRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawCancelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawCancelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawCancelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawCancelRequestValidationError{}
+
+// Validate checks the field values on WithdrawCancelReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawCancelReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawCancelReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawCancelReplyMultiError, or nil if none found.
+func (m *WithdrawCancelReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawCancelReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return WithdrawCancelReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawCancelReplyMultiError is an error wrapping multiple validation
+// errors returned by WithdrawCancelReply.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawCancelReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawCancelReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawCancelReplyMultiError) AllErrors() []error { return m }
+
+// WithdrawCancelReplyValidationError is the validation error returned by
+// WithdrawCancelReply.Validate if the designated constraints aren't met.
+type WithdrawCancelReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawCancelReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawCancelReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawCancelReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawCancelReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawCancelReplyValidationError) ErrorName() string {
+	return "WithdrawCancelReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawCancelReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawCancelReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawCancelReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawCancelReplyValidationError{}
+
+// Validate checks the field values on AdminRewardListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRewardListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminRewardListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminRewardListRequestMultiError, or nil if none found.
+func (m *AdminRewardListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminRewardListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return AdminRewardListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminRewardListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminRewardListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminRewardListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminRewardListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminRewardListRequestMultiError) AllErrors() []error { return m }
+
+// AdminRewardListRequestValidationError is the validation error returned by
+// AdminRewardListRequest.Validate if the designated constraints aren't met.
+type AdminRewardListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminRewardListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminRewardListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminRewardListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminRewardListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminRewardListRequestValidationError) ErrorName() string {
+	return "AdminRewardListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminRewardListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminRewardListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminRewardListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminRewardListRequestValidationError{}
+
+// Validate checks the field values on AdminRewardListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRewardListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminRewardListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminRewardListReplyMultiError, or nil if none found.
+func (m *AdminRewardListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminRewardListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRewards() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminRewardListReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminRewardListReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminRewardListReplyValidationError{
+					field:  fmt.Sprintf("Rewards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminRewardListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminRewardListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminRewardListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminRewardListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminRewardListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminRewardListReplyMultiError) AllErrors() []error { return m }
+
+// AdminRewardListReplyValidationError is the validation error returned by
+// AdminRewardListReply.Validate if the designated constraints aren't met.
+type AdminRewardListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminRewardListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminRewardListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminRewardListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminRewardListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminRewardListReplyValidationError) ErrorName() string {
+	return "AdminRewardListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminRewardListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminRewardListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminRewardListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminRewardListReplyValidationError{}
+
+// Validate checks the field values on AdminUserListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserListRequestMultiError, or nil if none found.
+func (m *AdminUserListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return AdminUserListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminUserListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUserListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserListRequestMultiError) AllErrors() []error { return m }
+
+// AdminUserListRequestValidationError is the validation error returned by
+// AdminUserListRequest.Validate if the designated constraints aren't met.
+type AdminUserListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserListRequestValidationError) ErrorName() string {
+	return "AdminUserListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserListRequestValidationError{}
+
+// Validate checks the field values on AdminUserListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserListReplyMultiError, or nil if none found.
+func (m *AdminUserListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminUserListReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminUserListReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminUserListReplyValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminUserListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserListReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminUserListReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminUserListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserListReplyMultiError) AllErrors() []error { return m }
+
+// AdminUserListReplyValidationError is the validation error returned by
+// AdminUserListReply.Validate if the designated constraints aren't met.
+type AdminUserListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserListReplyValidationError) ErrorName() string {
+	return "AdminUserListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserListReplyValidationError{}
+
+// Validate checks the field values on AdminLocationListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLocationListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLocationListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLocationListRequestMultiError, or nil if none found.
+func (m *AdminLocationListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLocationListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return AdminLocationListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLocationListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminLocationListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLocationListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLocationListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLocationListRequestMultiError) AllErrors() []error { return m }
+
+// AdminLocationListRequestValidationError is the validation error returned by
+// AdminLocationListRequest.Validate if the designated constraints aren't met.
+type AdminLocationListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLocationListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLocationListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLocationListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLocationListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLocationListRequestValidationError) ErrorName() string {
+	return "AdminLocationListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLocationListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLocationListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLocationListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLocationListRequestValidationError{}
+
+// Validate checks the field values on AdminLocationListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLocationListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLocationListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLocationListReplyMultiError, or nil if none found.
+func (m *AdminLocationListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLocationListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLocations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminLocationListReplyValidationError{
+						field:  fmt.Sprintf("Locations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminLocationListReplyValidationError{
+						field:  fmt.Sprintf("Locations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminLocationListReplyValidationError{
+					field:  fmt.Sprintf("Locations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminLocationListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLocationListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminLocationListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLocationListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLocationListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLocationListReplyMultiError) AllErrors() []error { return m }
+
+// AdminLocationListReplyValidationError is the validation error returned by
+// AdminLocationListReply.Validate if the designated constraints aren't met.
+type AdminLocationListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLocationListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLocationListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLocationListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLocationListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLocationListReplyValidationError) ErrorName() string {
+	return "AdminLocationListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLocationListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLocationListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLocationListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLocationListReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawListRequestMultiError, or nil if none found.
+func (m *AdminWithdrawListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return AdminWithdrawListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawListRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawListRequestValidationError is the validation error returned by
+// AdminWithdrawListRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawListRequestValidationError) ErrorName() string {
+	return "AdminWithdrawListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawListRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawListReplyMultiError, or nil if none found.
+func (m *AdminWithdrawListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWithdraw() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminWithdrawListReplyValidationError{
+						field:  fmt.Sprintf("Withdraw[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminWithdrawListReplyValidationError{
+						field:  fmt.Sprintf("Withdraw[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminWithdrawListReplyValidationError{
+					field:  fmt.Sprintf("Withdraw[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminWithdrawListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawListReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawListReplyValidationError is the validation error returned by
+// AdminWithdrawListReply.Validate if the designated constraints aren't met.
+type AdminWithdrawListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawListReplyValidationError) ErrorName() string {
+	return "AdminWithdrawListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawListReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRequestMultiError, or nil if none found.
+func (m *AdminWithdrawRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWithdrawRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawRequestValidationError is the validation error returned by
+// AdminWithdrawRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawRequestValidationError) ErrorName() string {
+	return "AdminWithdrawRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawReplyMultiError, or nil if none found.
+func (m *AdminWithdrawReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminWithdrawReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminWithdrawReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminWithdrawReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawReplyValidationError is the validation error returned by
+// AdminWithdrawReply.Validate if the designated constraints aren't met.
+type AdminWithdrawReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawReplyValidationError) ErrorName() string {
+	return "AdminWithdrawReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawReceiptRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawReceiptRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawReceiptRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawReceiptRequestMultiError, or nil if none found.
+func (m *AdminWithdrawReceiptRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawReceiptRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWithdrawReceiptRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawReceiptRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawReceiptRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminWithdrawReceiptRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawReceiptRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawReceiptRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawReceiptRequestValidationError is the validation error returned
+// by AdminWithdrawReceiptRequest.Validate if the designated constraints
+// aren't met.
+type AdminWithdrawReceiptRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawReceiptRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawReceiptRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawReceiptRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawReceiptRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawReceiptRequestValidationError) ErrorName() string {
+	return "AdminWithdrawReceiptRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawReceiptRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawReceiptRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawReceiptRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawReceiptRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawReceiptReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawReceiptReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawReceiptReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawReceiptReplyMultiError, or nil if none found.
+func (m *AdminWithdrawReceiptReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawReceiptReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminWithdrawReceiptReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawReceiptReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawReceiptReply.ValidateAll() if the
+// designated constraints aren't met.
+type AdminWithdrawReceiptReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawReceiptReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawReceiptReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawReceiptReplyValidationError is the validation error returned by
+// AdminWithdrawReceiptReply.Validate if the designated constraints aren't met.
+type AdminWithdrawReceiptReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawReceiptReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawReceiptReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawReceiptReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawReceiptReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawReceiptReplyValidationError) ErrorName() string {
+	return "AdminWithdrawReceiptReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawReceiptReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawReceiptReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawReceiptReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawReceiptReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawUpdateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawUpdateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawUpdateRequestMultiError, or nil if none found.
+func (m *AdminWithdrawUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWithdrawUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWithdrawUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWithdrawUpdateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminWithdrawUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawUpdateRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawUpdateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminWithdrawUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawUpdateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawUpdateRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawUpdateRequestValidationError is the validation error returned
+// by AdminWithdrawUpdateRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawUpdateRequestValidationError) ErrorName() string {
+	return "AdminWithdrawUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawUpdateRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawUpdateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawUpdateReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawUpdateReplyMultiError, or nil if none found.
+func (m *AdminWithdrawUpdateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawUpdateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return AdminWithdrawUpdateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawUpdateReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawUpdateReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawUpdateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawUpdateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawUpdateReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawUpdateReplyValidationError is the validation error returned by
+// AdminWithdrawUpdateReply.Validate if the designated constraints aren't met.
+type AdminWithdrawUpdateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawUpdateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawUpdateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawUpdateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawUpdateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawUpdateReplyValidationError) ErrorName() string {
+	return "AdminWithdrawUpdateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawUpdateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawUpdateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawUpdateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawUpdateReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawEventListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawEventListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawEventListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawEventListRequestMultiError, or nil if none found.
+func (m *AdminWithdrawEventListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawEventListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return AdminWithdrawEventListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawEventListRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawEventListRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminWithdrawEventListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawEventListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawEventListRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawEventListRequestValidationError is the validation error
+// returned by AdminWithdrawEventListRequest.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawEventListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawEventListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawEventListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawEventListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawEventListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawEventListRequestValidationError) ErrorName() string {
+	return "AdminWithdrawEventListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawEventListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawEventListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawEventListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawEventListRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawEventListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawEventListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawEventListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawEventListReplyMultiError, or nil if none found.
+func (m *AdminWithdrawEventListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawEventListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminWithdrawEventListReplyValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminWithdrawEventListReplyValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminWithdrawEventListReplyValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminWithdrawEventListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawEventListReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawEventListReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminWithdrawEventListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawEventListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawEventListReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawEventListReplyValidationError is the validation error returned
+// by AdminWithdrawEventListReply.Validate if the designated constraints
+// aren't met.
+type AdminWithdrawEventListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawEventListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawEventListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawEventListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawEventListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawEventListReplyValidationError) ErrorName() string {
+	return "AdminWithdrawEventListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawEventListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawEventListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawEventListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawEventListReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawEthRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawEthRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawEthRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawEthRequestMultiError, or nil if none found.
+func (m *AdminWithdrawEthRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawEthRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWithdrawEthRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawEthRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawEthRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawEthRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawEthRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawEthRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawEthRequestValidationError is the validation error returned by
+// AdminWithdrawEthRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawEthRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawEthRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawEthRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawEthRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawEthRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawEthRequestValidationError) ErrorName() string {
+	return "AdminWithdrawEthRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawEthRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawEthRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawEthRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawEthRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawEthReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawEthReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawEthReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawEthReplyMultiError, or nil if none found.
+func (m *AdminWithdrawEthReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawEthReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminWithdrawEthReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawEthReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawEthReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawEthReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawEthReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawEthReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawEthReplyValidationError is the validation error returned by
+// AdminWithdrawEthReply.Validate if the designated constraints aren't met.
+type AdminWithdrawEthReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawEthReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawEthReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawEthReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawEthReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawEthReplyValidationError) ErrorName() string {
+	return "AdminWithdrawEthReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawEthReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawEthReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawEthReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawEthReplyValidationError{}
+
+// Validate checks the field values on AdminFeeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminFeeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminFeeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminFeeRequestMultiError, or nil if none found.
+func (m *AdminFeeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminFeeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminFeeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminFeeRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminFeeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminFeeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminFeeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminFeeRequestMultiError) AllErrors() []error { return m }
+
+// AdminFeeRequestValidationError is the validation error returned by
+// AdminFeeRequest.Validate if the designated constraints aren't met.
+type AdminFeeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminFeeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminFeeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminFeeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminFeeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminFeeRequestValidationError) ErrorName() string { return "AdminFeeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminFeeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminFeeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminFeeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminFeeRequestValidationError{}
+
+// Validate checks the field values on AdminFeeReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminFeeReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminFeeReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminFeeReplyMultiError, or
+// nil if none found.
+func (m *AdminFeeReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminFeeReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminFeeReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminFeeReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminFeeReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminFeeReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminFeeReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminFeeReplyMultiError) AllErrors() []error { return m }
+
+// AdminFeeReplyValidationError is the validation error returned by
+// AdminFeeReply.Validate if the designated constraints aren't met.
+type AdminFeeReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminFeeReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminFeeReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminFeeReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminFeeReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminFeeReplyValidationError) ErrorName() string { return "AdminFeeReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminFeeReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminFeeReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminFeeReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminFeeReplyValidationError{}
+
+// Validate checks the field values on AdminJobRunListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminJobRunListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminJobRunListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminJobRunListRequestMultiError, or nil if none found.
+func (m *AdminJobRunListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminJobRunListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return AdminJobRunListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminJobRunListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminJobRunListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminJobRunListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminJobRunListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminJobRunListRequestMultiError) AllErrors() []error { return m }
+
+// AdminJobRunListRequestValidationError is the validation error returned by
+// AdminJobRunListRequest.Validate if the designated constraints aren't met.
+type AdminJobRunListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminJobRunListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminJobRunListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminJobRunListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminJobRunListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminJobRunListRequestValidationError) ErrorName() string {
+	return "AdminJobRunListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminJobRunListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminJobRunListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminJobRunListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminJobRunListRequestValidationError{}
+
+// Validate checks the field values on AdminJobRunListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminJobRunListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminJobRunListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminJobRunListReplyMultiError, or nil if none found.
+func (m *AdminJobRunListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminJobRunListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetJobRun() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminJobRunListReplyValidationError{
+						field:  fmt.Sprintf("JobRun[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminJobRunListReplyValidationError{
+						field:  fmt.Sprintf("JobRun[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminJobRunListReplyValidationError{
+					field:  fmt.Sprintf("JobRun[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminJobRunListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminJobRunListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminJobRunListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminJobRunListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminJobRunListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminJobRunListReplyMultiError) AllErrors() []error { return m }
+
+// AdminJobRunListReplyValidationError is the validation error returned by
+// AdminJobRunListReply.Validate if the designated constraints aren't met.
+type AdminJobRunListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminJobRunListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminJobRunListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminJobRunListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminJobRunListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminJobRunListReplyValidationError) ErrorName() string {
+	return "AdminJobRunListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminJobRunListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminJobRunListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminJobRunListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminJobRunListReplyValidationError{}
+
+// Validate checks the field values on AdminDepositReorgListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositReorgListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositReorgListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDepositReorgListRequestMultiError, or nil if none found.
+func (m *AdminDepositReorgListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDepositReorgListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	if len(errors) > 0 {
+		return AdminDepositReorgListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDepositReorgListRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminDepositReorgListRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminDepositReorgListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDepositReorgListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDepositReorgListRequestMultiError) AllErrors() []error { return m }
+
+// AdminDepositReorgListRequestValidationError is the validation error returned
+// by AdminDepositReorgListRequest.Validate if the designated constraints
+// aren't met.
+type AdminDepositReorgListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDepositReorgListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDepositReorgListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDepositReorgListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDepositReorgListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDepositReorgListRequestValidationError) ErrorName() string {
+	return "AdminDepositReorgListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDepositReorgListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDepositReorgListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDepositReorgListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDepositReorgListRequestValidationError{}
+
+// Validate checks the field values on AdminDepositReorgListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositReorgListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositReorgListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDepositReorgListReplyMultiError, or nil if none found.
+func (m *AdminDepositReorgListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDepositReorgListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminDepositReorgListReplyValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminDepositReorgListReplyValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminDepositReorgListReplyValidationError{
+					field:  fmt.Sprintf("Records[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminDepositReorgListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDepositReorgListReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminDepositReorgListReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminDepositReorgListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDepositReorgListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDepositReorgListReplyMultiError) AllErrors() []error { return m }
+
+// AdminDepositReorgListReplyValidationError is the validation error returned
+// by AdminDepositReorgListReply.Validate if the designated constraints aren't met.
+type AdminDepositReorgListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDepositReorgListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDepositReorgListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDepositReorgListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDepositReorgListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDepositReorgListReplyValidationError) ErrorName() string {
+	return "AdminDepositReorgListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDepositReorgListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDepositReorgListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDepositReorgListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDepositReorgListReplyValidationError{}
+
+// Validate checks the field values on AdminDepositUnmatchedListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminDepositUnmatchedListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositUnmatchedListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminDepositUnmatchedListRequestMultiError, or nil if none found.
+func (m *AdminDepositUnmatchedListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDepositUnmatchedListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return AdminDepositUnmatchedListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDepositUnmatchedListRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminDepositUnmatchedListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminDepositUnmatchedListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDepositUnmatchedListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDepositUnmatchedListRequestMultiError) AllErrors() []error { return m }
+
+// AdminDepositUnmatchedListRequestValidationError is the validation error
+// returned by AdminDepositUnmatchedListRequest.Validate if the designated
+// constraints aren't met.
+type AdminDepositUnmatchedListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDepositUnmatchedListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDepositUnmatchedListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDepositUnmatchedListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDepositUnmatchedListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDepositUnmatchedListRequestValidationError) ErrorName() string {
+	return "AdminDepositUnmatchedListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDepositUnmatchedListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDepositUnmatchedListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDepositUnmatchedListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDepositUnmatchedListRequestValidationError{}
+
+// Validate checks the field values on AdminDepositUnmatchedListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositUnmatchedListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositUnmatchedListReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminDepositUnmatchedListReplyMultiError, or nil if none found.
+func (m *AdminDepositUnmatchedListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDepositUnmatchedListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecords() {
+		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RewardListReplyValidationError{
-						field:  fmt.Sprintf("Rewards[%v]", idx),
+					errors = append(errors, AdminDepositUnmatchedListReplyValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, RewardListReplyValidationError{
-						field:  fmt.Sprintf("Rewards[%v]", idx),
+					errors = append(errors, AdminDepositUnmatchedListReplyValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -917,8 +7631,8 @@ func (m *RewardListReply) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return RewardListReplyValidationError{
-					field:  fmt.Sprintf("Rewards[%v]", idx),
+				return AdminDepositUnmatchedListReplyValidationError{
+					field:  fmt.Sprintf("Records[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -927,20 +7641,22 @@ func (m *RewardListReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for Count
+
 	if len(errors) > 0 {
-		return RewardListReplyMultiError(errors)
+		return AdminDepositUnmatchedListReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// RewardListReplyMultiError is an error wrapping multiple validation errors
-// returned by RewardListReply.ValidateAll() if the designated constraints
-// aren't met.
-type RewardListReplyMultiError []error
+// AdminDepositUnmatchedListReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminDepositUnmatchedListReply.ValidateAll()
+// if the designated constraints aren't met.
+type AdminDepositUnmatchedListReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RewardListReplyMultiError) Error() string {
+func (m AdminDepositUnmatchedListReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -949,11 +7665,12 @@ func (m RewardListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RewardListReplyMultiError) AllErrors() []error { return m }
+func (m AdminDepositUnmatchedListReplyMultiError) AllErrors() []error { return m }
 
-// RewardListReplyValidationError is the validation error returned by
-// RewardListReply.Validate if the designated constraints aren't met.
-type RewardListReplyValidationError struct {
+// AdminDepositUnmatchedListReplyValidationError is the validation error
+// returned by AdminDepositUnmatchedListReply.Validate if the designated
+// constraints aren't met.
+type AdminDepositUnmatchedListReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -961,22 +7678,24 @@ type RewardListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e RewardListReplyValidationError) Field() string { return e.field }
+func (e AdminDepositUnmatchedListReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RewardListReplyValidationError) Reason() string { return e.reason }
+func (e AdminDepositUnmatchedListReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RewardListReplyValidationError) Cause() error { return e.cause }
+func (e AdminDepositUnmatchedListReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RewardListReplyValidationError) Key() bool { return e.key }
+func (e AdminDepositUnmatchedListReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RewardListReplyValidationError) ErrorName() string { return "RewardListReplyValidationError" }
+func (e AdminDepositUnmatchedListReplyValidationError) ErrorName() string {
+	return "AdminDepositUnmatchedListReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RewardListReplyValidationError) Error() string {
+func (e AdminDepositUnmatchedListReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -988,14 +7707,14 @@ func (e RewardListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRewardListReply.%s: %s%s",
+		"invalid %sAdminDepositUnmatchedListReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RewardListReplyValidationError{}
+var _ error = AdminDepositUnmatchedListReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1003,44 +7722,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RewardListReplyValidationError{}
+} = AdminDepositUnmatchedListReplyValidationError{}
 
-// Validate checks the field values on RecommendRewardListRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendRewardListRequest) Validate() error {
+// Validate checks the field values on AdminDepositUnmatchedHandleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminDepositUnmatchedHandleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendRewardListRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RecommendRewardListRequestMultiError, or nil if none found.
-func (m *RecommendRewardListRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminDepositUnmatchedHandleRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminDepositUnmatchedHandleRequestMultiError, or nil if none found.
+func (m *AdminDepositUnmatchedHandleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendRewardListRequest) validate(all bool) error {
+func (m *AdminDepositUnmatchedHandleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminDepositUnmatchedHandleRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminDepositUnmatchedHandleRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminDepositUnmatchedHandleRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return RecommendRewardListRequestMultiError(errors)
+		return AdminDepositUnmatchedHandleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendRewardListRequestMultiError is an error wrapping multiple
-// validation errors returned by RecommendRewardListRequest.ValidateAll() if
-// the designated constraints aren't met.
-type RecommendRewardListRequestMultiError []error
+// AdminDepositUnmatchedHandleRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminDepositUnmatchedHandleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminDepositUnmatchedHandleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendRewardListRequestMultiError) Error() string {
+func (m AdminDepositUnmatchedHandleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1049,11 +7799,12 @@ func (m RecommendRewardListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendRewardListRequestMultiError) AllErrors() []error { return m }
+func (m AdminDepositUnmatchedHandleRequestMultiError) AllErrors() []error { return m }
 
-// RecommendRewardListRequestValidationError is the validation error returned
-// by RecommendRewardListRequest.Validate if the designated constraints aren't met.
-type RecommendRewardListRequestValidationError struct {
+// AdminDepositUnmatchedHandleRequestValidationError is the validation error
+// returned by AdminDepositUnmatchedHandleRequest.Validate if the designated
+// constraints aren't met.
+type AdminDepositUnmatchedHandleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1061,24 +7812,24 @@ type RecommendRewardListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendRewardListRequestValidationError) Field() string { return e.field }
+func (e AdminDepositUnmatchedHandleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendRewardListRequestValidationError) Reason() string { return e.reason }
+func (e AdminDepositUnmatchedHandleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendRewardListRequestValidationError) Cause() error { return e.cause }
+func (e AdminDepositUnmatchedHandleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendRewardListRequestValidationError) Key() bool { return e.key }
+func (e AdminDepositUnmatchedHandleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendRewardListRequestValidationError) ErrorName() string {
-	return "RecommendRewardListRequestValidationError"
+func (e AdminDepositUnmatchedHandleRequestValidationError) ErrorName() string {
+	return "AdminDepositUnmatchedHandleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendRewardListRequestValidationError) Error() string {
+func (e AdminDepositUnmatchedHandleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1090,14 +7841,14 @@ func (e RecommendRewardListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendRewardListRequest.%s: %s%s",
+		"invalid %sAdminDepositUnmatchedHandleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendRewardListRequestValidationError{}
+var _ error = AdminDepositUnmatchedHandleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1105,78 +7856,149 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendRewardListRequestValidationError{}
+} = AdminDepositUnmatchedHandleRequestValidationError{}
 
-// Validate checks the field values on RecommendRewardListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendRewardListReply) Validate() error {
+// Validate checks the field values on AdminDepositUnmatchedHandleReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminDepositUnmatchedHandleReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendRewardListReply with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RecommendRewardListReplyMultiError, or nil if none found.
-func (m *RecommendRewardListReply) ValidateAll() error {
+// ValidateAll checks the field values on AdminDepositUnmatchedHandleReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminDepositUnmatchedHandleReplyMultiError, or nil if none found.
+func (m *AdminDepositUnmatchedHandleReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendRewardListReply) validate(all bool) error {
+func (m *AdminDepositUnmatchedHandleReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRewards() {
-		_, _ = idx, item
+	if len(errors) > 0 {
+		return AdminDepositUnmatchedHandleReplyMultiError(errors)
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RecommendRewardListReplyValidationError{
-						field:  fmt.Sprintf("Rewards[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, RecommendRewardListReplyValidationError{
-						field:  fmt.Sprintf("Rewards[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return RecommendRewardListReplyValidationError{
-					field:  fmt.Sprintf("Rewards[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	return nil
+}
+
+// AdminDepositUnmatchedHandleReplyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminDepositUnmatchedHandleReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminDepositUnmatchedHandleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDepositUnmatchedHandleReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDepositUnmatchedHandleReplyMultiError) AllErrors() []error { return m }
+
+// AdminDepositUnmatchedHandleReplyValidationError is the validation error
+// returned by AdminDepositUnmatchedHandleReply.Validate if the designated
+// constraints aren't met.
+type AdminDepositUnmatchedHandleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDepositUnmatchedHandleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDepositUnmatchedHandleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDepositUnmatchedHandleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDepositUnmatchedHandleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDepositUnmatchedHandleReplyValidationError) ErrorName() string {
+	return "AdminDepositUnmatchedHandleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDepositUnmatchedHandleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDepositUnmatchedHandleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDepositUnmatchedHandleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDepositUnmatchedHandleReplyValidationError{}
+
+// Validate checks the field values on AdminDepositTierListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositTierListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositTierListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDepositTierListRequestMultiError, or nil if none found.
+func (m *AdminDepositTierListRequest) ValidateAll() error {
+	return m.validate(true)
+}
 
+func (m *AdminDepositTierListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
 	if len(errors) > 0 {
-		return RecommendRewardListReplyMultiError(errors)
+		return AdminDepositTierListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendRewardListReplyMultiError is an error wrapping multiple validation
-// errors returned by RecommendRewardListReply.ValidateAll() if the designated
-// constraints aren't met.
-type RecommendRewardListReplyMultiError []error
+// AdminDepositTierListRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminDepositTierListRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminDepositTierListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendRewardListReplyMultiError) Error() string {
+func (m AdminDepositTierListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1185,11 +8007,12 @@ func (m RecommendRewardListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendRewardListReplyMultiError) AllErrors() []error { return m }
+func (m AdminDepositTierListRequestMultiError) AllErrors() []error { return m }
 
-// RecommendRewardListReplyValidationError is the validation error returned by
-// RecommendRewardListReply.Validate if the designated constraints aren't met.
-type RecommendRewardListReplyValidationError struct {
+// AdminDepositTierListRequestValidationError is the validation error returned
+// by AdminDepositTierListRequest.Validate if the designated constraints
+// aren't met.
+type AdminDepositTierListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1197,24 +8020,24 @@ type RecommendRewardListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendRewardListReplyValidationError) Field() string { return e.field }
+func (e AdminDepositTierListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendRewardListReplyValidationError) Reason() string { return e.reason }
+func (e AdminDepositTierListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendRewardListReplyValidationError) Cause() error { return e.cause }
+func (e AdminDepositTierListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendRewardListReplyValidationError) Key() bool { return e.key }
+func (e AdminDepositTierListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendRewardListReplyValidationError) ErrorName() string {
-	return "RecommendRewardListReplyValidationError"
+func (e AdminDepositTierListRequestValidationError) ErrorName() string {
+	return "AdminDepositTierListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendRewardListReplyValidationError) Error() string {
+func (e AdminDepositTierListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1226,14 +8049,14 @@ func (e RecommendRewardListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendRewardListReply.%s: %s%s",
+		"invalid %sAdminDepositTierListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendRewardListReplyValidationError{}
+var _ error = AdminDepositTierListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1241,44 +8064,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendRewardListReplyValidationError{}
+} = AdminDepositTierListRequestValidationError{}
 
-// Validate checks the field values on FeeRewardListRequest with the rules
+// Validate checks the field values on AdminDepositTierListReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *FeeRewardListRequest) Validate() error {
+func (m *AdminDepositTierListReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FeeRewardListRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminDepositTierListReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// FeeRewardListRequestMultiError, or nil if none found.
-func (m *FeeRewardListRequest) ValidateAll() error {
+// AdminDepositTierListReplyMultiError, or nil if none found.
+func (m *AdminDepositTierListReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FeeRewardListRequest) validate(all bool) error {
+func (m *AdminDepositTierListReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetTiers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminDepositTierListReplyValidationError{
+						field:  fmt.Sprintf("Tiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminDepositTierListReplyValidationError{
+						field:  fmt.Sprintf("Tiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminDepositTierListReplyValidationError{
+					field:  fmt.Sprintf("Tiers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return FeeRewardListRequestMultiError(errors)
+		return AdminDepositTierListReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// FeeRewardListRequestMultiError is an error wrapping multiple validation
-// errors returned by FeeRewardListRequest.ValidateAll() if the designated
-// constraints aren't met.
-type FeeRewardListRequestMultiError []error
+// AdminDepositTierListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminDepositTierListReply.ValidateAll() if the
+// designated constraints aren't met.
+type AdminDepositTierListReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FeeRewardListRequestMultiError) Error() string {
+func (m AdminDepositTierListReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1287,11 +8144,11 @@ func (m FeeRewardListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FeeRewardListRequestMultiError) AllErrors() []error { return m }
+func (m AdminDepositTierListReplyMultiError) AllErrors() []error { return m }
 
-// FeeRewardListRequestValidationError is the validation error returned by
-// FeeRewardListRequest.Validate if the designated constraints aren't met.
-type FeeRewardListRequestValidationError struct {
+// AdminDepositTierListReplyValidationError is the validation error returned by
+// AdminDepositTierListReply.Validate if the designated constraints aren't met.
+type AdminDepositTierListReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1299,24 +8156,24 @@ type FeeRewardListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e FeeRewardListRequestValidationError) Field() string { return e.field }
+func (e AdminDepositTierListReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FeeRewardListRequestValidationError) Reason() string { return e.reason }
+func (e AdminDepositTierListReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FeeRewardListRequestValidationError) Cause() error { return e.cause }
+func (e AdminDepositTierListReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FeeRewardListRequestValidationError) Key() bool { return e.key }
+func (e AdminDepositTierListReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FeeRewardListRequestValidationError) ErrorName() string {
-	return "FeeRewardListRequestValidationError"
+func (e AdminDepositTierListReplyValidationError) ErrorName() string {
+	return "AdminDepositTierListReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e FeeRewardListRequestValidationError) Error() string {
+func (e AdminDepositTierListReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1328,14 +8185,14 @@ func (e FeeRewardListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFeeRewardListRequest.%s: %s%s",
+		"invalid %sAdminDepositTierListReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FeeRewardListRequestValidationError{}
+var _ error = AdminDepositTierListReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1343,78 +8200,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FeeRewardListRequestValidationError{}
+} = AdminDepositTierListReplyValidationError{}
 
-// Validate checks the field values on FeeRewardListReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminDepositTierSaveRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *FeeRewardListReply) Validate() error {
+func (m *AdminDepositTierSaveRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FeeRewardListReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminDepositTierSaveRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// FeeRewardListReplyMultiError, or nil if none found.
-func (m *FeeRewardListReply) ValidateAll() error {
+// AdminDepositTierSaveRequestMultiError, or nil if none found.
+func (m *AdminDepositTierSaveRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FeeRewardListReply) validate(all bool) error {
+func (m *AdminDepositTierSaveRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRewards() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, FeeRewardListReplyValidationError{
-						field:  fmt.Sprintf("Rewards[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, FeeRewardListReplyValidationError{
-						field:  fmt.Sprintf("Rewards[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminDepositTierSaveRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return FeeRewardListReplyValidationError{
-					field:  fmt.Sprintf("Rewards[%v]", idx),
+				errors = append(errors, AdminDepositTierSaveRequestValidationError{
+					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminDepositTierSaveRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return FeeRewardListReplyMultiError(errors)
+		return AdminDepositTierSaveRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// FeeRewardListReplyMultiError is an error wrapping multiple validation errors
-// returned by FeeRewardListReply.ValidateAll() if the designated constraints
-// aren't met.
-type FeeRewardListReplyMultiError []error
+// AdminDepositTierSaveRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminDepositTierSaveRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminDepositTierSaveRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FeeRewardListReplyMultiError) Error() string {
+func (m AdminDepositTierSaveRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1423,11 +8275,12 @@ func (m FeeRewardListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FeeRewardListReplyMultiError) AllErrors() []error { return m }
+func (m AdminDepositTierSaveRequestMultiError) AllErrors() []error { return m }
 
-// FeeRewardListReplyValidationError is the validation error returned by
-// FeeRewardListReply.Validate if the designated constraints aren't met.
-type FeeRewardListReplyValidationError struct {
+// AdminDepositTierSaveRequestValidationError is the validation error returned
+// by AdminDepositTierSaveRequest.Validate if the designated constraints
+// aren't met.
+type AdminDepositTierSaveRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1435,24 +8288,24 @@ type FeeRewardListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e FeeRewardListReplyValidationError) Field() string { return e.field }
+func (e AdminDepositTierSaveRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FeeRewardListReplyValidationError) Reason() string { return e.reason }
+func (e AdminDepositTierSaveRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FeeRewardListReplyValidationError) Cause() error { return e.cause }
+func (e AdminDepositTierSaveRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FeeRewardListReplyValidationError) Key() bool { return e.key }
+func (e AdminDepositTierSaveRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FeeRewardListReplyValidationError) ErrorName() string {
-	return "FeeRewardListReplyValidationError"
+func (e AdminDepositTierSaveRequestValidationError) ErrorName() string {
+	return "AdminDepositTierSaveRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e FeeRewardListReplyValidationError) Error() string {
+func (e AdminDepositTierSaveRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1464,14 +8317,14 @@ func (e FeeRewardListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFeeRewardListReply.%s: %s%s",
+		"invalid %sAdminDepositTierSaveRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FeeRewardListReplyValidationError{}
+var _ error = AdminDepositTierSaveRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1479,46 +8332,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FeeRewardListReplyValidationError{}
+} = AdminDepositTierSaveRequestValidationError{}
 
-// Validate checks the field values on WithdrawListRequest with the rules
+// Validate checks the field values on AdminDepositTierSaveReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawListRequest) Validate() error {
+func (m *AdminDepositTierSaveReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawListRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminDepositTierSaveReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WithdrawListRequestMultiError, or nil if none found.
-func (m *WithdrawListRequest) ValidateAll() error {
+// AdminDepositTierSaveReplyMultiError, or nil if none found.
+func (m *AdminDepositTierSaveReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawListRequest) validate(all bool) error {
+func (m *AdminDepositTierSaveReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Type
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return WithdrawListRequestMultiError(errors)
+		return AdminDepositTierSaveReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawListRequestMultiError is an error wrapping multiple validation
-// errors returned by WithdrawListRequest.ValidateAll() if the designated
-// constraints aren't met.
-type WithdrawListRequestMultiError []error
+// AdminDepositTierSaveReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminDepositTierSaveReply.ValidateAll() if the
+// designated constraints aren't met.
+type AdminDepositTierSaveReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawListRequestMultiError) Error() string {
+func (m AdminDepositTierSaveReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1527,11 +8380,11 @@ func (m WithdrawListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawListRequestMultiError) AllErrors() []error { return m }
+func (m AdminDepositTierSaveReplyMultiError) AllErrors() []error { return m }
 
-// WithdrawListRequestValidationError is the validation error returned by
-// WithdrawListRequest.Validate if the designated constraints aren't met.
-type WithdrawListRequestValidationError struct {
+// AdminDepositTierSaveReplyValidationError is the validation error returned by
+// AdminDepositTierSaveReply.Validate if the designated constraints aren't met.
+type AdminDepositTierSaveReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1539,24 +8392,24 @@ type WithdrawListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawListRequestValidationError) Field() string { return e.field }
+func (e AdminDepositTierSaveReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawListRequestValidationError) Reason() string { return e.reason }
+func (e AdminDepositTierSaveReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawListRequestValidationError) Cause() error { return e.cause }
+func (e AdminDepositTierSaveReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawListRequestValidationError) Key() bool { return e.key }
+func (e AdminDepositTierSaveReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawListRequestValidationError) ErrorName() string {
-	return "WithdrawListRequestValidationError"
+func (e AdminDepositTierSaveReplyValidationError) ErrorName() string {
+	return "AdminDepositTierSaveReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WithdrawListRequestValidationError) Error() string {
+func (e AdminDepositTierSaveReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1568,14 +8421,14 @@ func (e WithdrawListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawListRequest.%s: %s%s",
+		"invalid %sAdminDepositTierSaveReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawListRequestValidationError{}
+var _ error = AdminDepositTierSaveReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1583,78 +8436,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawListRequestValidationError{}
+} = AdminDepositTierSaveReplyValidationError{}
 
-// Validate checks the field values on WithdrawListReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawListReply) Validate() error {
+// Validate checks the field values on AdminDepositSweepRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositSweepRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawListReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminDepositSweepRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WithdrawListReplyMultiError, or nil if none found.
-func (m *WithdrawListReply) ValidateAll() error {
+// AdminDepositSweepRequestMultiError, or nil if none found.
+func (m *AdminDepositSweepRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawListReply) validate(all bool) error {
+func (m *AdminDepositSweepRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetWithdraw() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, WithdrawListReplyValidationError{
-						field:  fmt.Sprintf("Withdraw[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, WithdrawListReplyValidationError{
-						field:  fmt.Sprintf("Withdraw[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return WithdrawListReplyValidationError{
-					field:  fmt.Sprintf("Withdraw[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return WithdrawListReplyMultiError(errors)
+		return AdminDepositSweepRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawListReplyMultiError is an error wrapping multiple validation errors
-// returned by WithdrawListReply.ValidateAll() if the designated constraints
-// aren't met.
-type WithdrawListReplyMultiError []error
+// AdminDepositSweepRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminDepositSweepRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminDepositSweepRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawListReplyMultiError) Error() string {
+func (m AdminDepositSweepRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1663,11 +8482,11 @@ func (m WithdrawListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawListReplyMultiError) AllErrors() []error { return m }
+func (m AdminDepositSweepRequestMultiError) AllErrors() []error { return m }
 
-// WithdrawListReplyValidationError is the validation error returned by
-// WithdrawListReply.Validate if the designated constraints aren't met.
-type WithdrawListReplyValidationError struct {
+// AdminDepositSweepRequestValidationError is the validation error returned by
+// AdminDepositSweepRequest.Validate if the designated constraints aren't met.
+type AdminDepositSweepRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1675,24 +8494,24 @@ type WithdrawListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawListReplyValidationError) Field() string { return e.field }
+func (e AdminDepositSweepRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawListReplyValidationError) Reason() string { return e.reason }
+func (e AdminDepositSweepRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawListReplyValidationError) Cause() error { return e.cause }
+func (e AdminDepositSweepRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawListReplyValidationError) Key() bool { return e.key }
+func (e AdminDepositSweepRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawListReplyValidationError) ErrorName() string {
-	return "WithdrawListReplyValidationError"
+func (e AdminDepositSweepRequestValidationError) ErrorName() string {
+	return "AdminDepositSweepRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WithdrawListReplyValidationError) Error() string {
+func (e AdminDepositSweepRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1704,14 +8523,14 @@ func (e WithdrawListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawListReply.%s: %s%s",
+		"invalid %sAdminDepositSweepRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawListReplyValidationError{}
+var _ error = AdminDepositSweepRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1719,46 +8538,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawListReplyValidationError{}
+} = AdminDepositSweepRequestValidationError{}
 
-// Validate checks the field values on RecommendListRequest with the rules
+// Validate checks the field values on AdminDepositSweepReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendListRequest) Validate() error {
+func (m *AdminDepositSweepReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendListRequest with the rules
+// ValidateAll checks the field values on AdminDepositSweepReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RecommendListRequestMultiError, or nil if none found.
-func (m *RecommendListRequest) ValidateAll() error {
+// AdminDepositSweepReplyMultiError, or nil if none found.
+func (m *AdminDepositSweepReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendListRequest) validate(all bool) error {
+func (m *AdminDepositSweepReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Type
+	// no validation rules for Count
 
 	if len(errors) > 0 {
-		return RecommendListRequestMultiError(errors)
+		return AdminDepositSweepReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendListRequestMultiError is an error wrapping multiple validation
-// errors returned by RecommendListRequest.ValidateAll() if the designated
+// AdminDepositSweepReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminDepositSweepReply.ValidateAll() if the designated
 // constraints aren't met.
-type RecommendListRequestMultiError []error
+type AdminDepositSweepReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendListRequestMultiError) Error() string {
+func (m AdminDepositSweepReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1767,11 +8586,11 @@ func (m RecommendListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendListRequestMultiError) AllErrors() []error { return m }
+func (m AdminDepositSweepReplyMultiError) AllErrors() []error { return m }
 
-// RecommendListRequestValidationError is the validation error returned by
-// RecommendListRequest.Validate if the designated constraints aren't met.
-type RecommendListRequestValidationError struct {
+// AdminDepositSweepReplyValidationError is the validation error returned by
+// AdminDepositSweepReply.Validate if the designated constraints aren't met.
+type AdminDepositSweepReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1779,24 +8598,24 @@ type RecommendListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendListRequestValidationError) Field() string { return e.field }
+func (e AdminDepositSweepReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendListRequestValidationError) Reason() string { return e.reason }
+func (e AdminDepositSweepReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendListRequestValidationError) Cause() error { return e.cause }
+func (e AdminDepositSweepReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendListRequestValidationError) Key() bool { return e.key }
+func (e AdminDepositSweepReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendListRequestValidationError) ErrorName() string {
-	return "RecommendListRequestValidationError"
+func (e AdminDepositSweepReplyValidationError) ErrorName() string {
+	return "AdminDepositSweepReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendListRequestValidationError) Error() string {
+func (e AdminDepositSweepReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1808,14 +8627,14 @@ func (e RecommendListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendListRequest.%s: %s%s",
+		"invalid %sAdminDepositSweepReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendListRequestValidationError{}
+var _ error = AdminDepositSweepReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1823,78 +8642,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendListRequestValidationError{}
+} = AdminDepositSweepReplyValidationError{}
 
-// Validate checks the field values on RecommendListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendListReply) Validate() error {
+// Validate checks the field values on AdminAllRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminAllRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendListReply with the rules
+// ValidateAll checks the field values on AdminAllRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RecommendListReplyMultiError, or nil if none found.
-func (m *RecommendListReply) ValidateAll() error {
+// AdminAllRequestMultiError, or nil if none found.
+func (m *AdminAllRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendListReply) validate(all bool) error {
+func (m *AdminAllRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRecommends() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RecommendListReplyValidationError{
-						field:  fmt.Sprintf("Recommends[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, RecommendListReplyValidationError{
-						field:  fmt.Sprintf("Recommends[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return RecommendListReplyValidationError{
-					field:  fmt.Sprintf("Recommends[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return RecommendListReplyMultiError(errors)
+		return AdminAllRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendListReplyMultiError is an error wrapping multiple validation errors
-// returned by RecommendListReply.ValidateAll() if the designated constraints
+// AdminAllRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminAllRequest.ValidateAll() if the designated constraints
 // aren't met.
-type RecommendListReplyMultiError []error
+type AdminAllRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendListReplyMultiError) Error() string {
+func (m AdminAllRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1903,11 +8688,11 @@ func (m RecommendListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendListReplyMultiError) AllErrors() []error { return m }
+func (m AdminAllRequestMultiError) AllErrors() []error { return m }
 
-// RecommendListReplyValidationError is the validation error returned by
-// RecommendListReply.Validate if the designated constraints aren't met.
-type RecommendListReplyValidationError struct {
+// AdminAllRequestValidationError is the validation error returned by
+// AdminAllRequest.Validate if the designated constraints aren't met.
+type AdminAllRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1915,24 +8700,22 @@ type RecommendListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendListReplyValidationError) Field() string { return e.field }
+func (e AdminAllRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendListReplyValidationError) Reason() string { return e.reason }
+func (e AdminAllRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendListReplyValidationError) Cause() error { return e.cause }
+func (e AdminAllRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendListReplyValidationError) Key() bool { return e.key }
+func (e AdminAllRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendListReplyValidationError) ErrorName() string {
-	return "RecommendListReplyValidationError"
-}
+func (e AdminAllRequestValidationError) ErrorName() string { return "AdminAllRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RecommendListReplyValidationError) Error() string {
+func (e AdminAllRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1944,14 +8727,14 @@ func (e RecommendListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendListReply.%s: %s%s",
+		"invalid %sAdminAllRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendListReplyValidationError{}
+var _ error = AdminAllRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1959,73 +8742,62 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendListReplyValidationError{}
+} = AdminAllRequestValidationError{}
 
-// Validate checks the field values on WithdrawRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawRequest) Validate() error {
+// Validate checks the field values on AdminAllReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminAllReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// WithdrawRequestMultiError, or nil if none found.
-func (m *WithdrawRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminAllReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminAllReplyMultiError, or
+// nil if none found.
+func (m *AdminAllReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawRequest) validate(all bool) error {
+func (m *AdminAllReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WithdrawRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, WithdrawRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return WithdrawRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for TodayTotalUser
+
+	// no validation rules for TotalUser
+
+	// no validation rules for AllBalance
+
+	// no validation rules for TodayLocation
+
+	// no validation rules for AllLocation
+
+	// no validation rules for TodayWithdraw
+
+	// no validation rules for AllWithdraw
+
+	// no validation rules for AllReward
+
+	// no validation rules for AllSystemRewardAndFee
 
 	if len(errors) > 0 {
-		return WithdrawRequestMultiError(errors)
+		return AdminAllReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawRequestMultiError is an error wrapping multiple validation errors
-// returned by WithdrawRequest.ValidateAll() if the designated constraints
+// AdminAllReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminAllReply.ValidateAll() if the designated constraints
 // aren't met.
-type WithdrawRequestMultiError []error
+type AdminAllReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawRequestMultiError) Error() string {
+func (m AdminAllReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2034,11 +8806,11 @@ func (m WithdrawRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawRequestMultiError) AllErrors() []error { return m }
+func (m AdminAllReplyMultiError) AllErrors() []error { return m }
 
-// WithdrawRequestValidationError is the validation error returned by
-// WithdrawRequest.Validate if the designated constraints aren't met.
-type WithdrawRequestValidationError struct {
+// AdminAllReplyValidationError is the validation error returned by
+// AdminAllReply.Validate if the designated constraints aren't met.
+type AdminAllReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2046,22 +8818,22 @@ type WithdrawRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawRequestValidationError) Field() string { return e.field }
+func (e AdminAllReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawRequestValidationError) Reason() string { return e.reason }
+func (e AdminAllReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawRequestValidationError) Cause() error { return e.cause }
+func (e AdminAllReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawRequestValidationError) Key() bool { return e.key }
+func (e AdminAllReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawRequestValidationError) ErrorName() string { return "WithdrawRequestValidationError" }
+func (e AdminAllReplyValidationError) ErrorName() string { return "AdminAllReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e WithdrawRequestValidationError) Error() string {
+func (e AdminAllReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2073,14 +8845,14 @@ func (e WithdrawRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawRequest.%s: %s%s",
+		"invalid %sAdminAllReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawRequestValidationError{}
+var _ error = AdminAllReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2088,46 +8860,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawRequestValidationError{}
+} = AdminAllReplyValidationError{}
 
-// Validate checks the field values on WithdrawReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *WithdrawReply) Validate() error {
+// Validate checks the field values on AdminUserRecommendRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserRecommendRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in WithdrawReplyMultiError, or
-// nil if none found.
-func (m *WithdrawReply) ValidateAll() error {
+// ValidateAll checks the field values on AdminUserRecommendRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserRecommendRequestMultiError, or nil if none found.
+func (m *AdminUserRecommendRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawReply) validate(all bool) error {
+func (m *AdminUserRecommendRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Status
+	// no validation rules for UserId
 
 	if len(errors) > 0 {
-		return WithdrawReplyMultiError(errors)
+		return AdminUserRecommendRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawReplyMultiError is an error wrapping multiple validation errors
-// returned by WithdrawReply.ValidateAll() if the designated constraints
-// aren't met.
-type WithdrawReplyMultiError []error
+// AdminUserRecommendRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminUserRecommendRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminUserRecommendRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawReplyMultiError) Error() string {
+func (m AdminUserRecommendRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2136,11 +8908,11 @@ func (m WithdrawReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawReplyMultiError) AllErrors() []error { return m }
+func (m AdminUserRecommendRequestMultiError) AllErrors() []error { return m }
 
-// WithdrawReplyValidationError is the validation error returned by
-// WithdrawReply.Validate if the designated constraints aren't met.
-type WithdrawReplyValidationError struct {
+// AdminUserRecommendRequestValidationError is the validation error returned by
+// AdminUserRecommendRequest.Validate if the designated constraints aren't met.
+type AdminUserRecommendRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2148,22 +8920,24 @@ type WithdrawReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawReplyValidationError) Field() string { return e.field }
+func (e AdminUserRecommendRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawReplyValidationError) Reason() string { return e.reason }
+func (e AdminUserRecommendRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawReplyValidationError) Cause() error { return e.cause }
+func (e AdminUserRecommendRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawReplyValidationError) Key() bool { return e.key }
+func (e AdminUserRecommendRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawReplyValidationError) ErrorName() string { return "WithdrawReplyValidationError" }
+func (e AdminUserRecommendRequestValidationError) ErrorName() string {
+	return "AdminUserRecommendRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e WithdrawReplyValidationError) Error() string {
+func (e AdminUserRecommendRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2175,14 +8949,14 @@ func (e WithdrawReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawReply.%s: %s%s",
+		"invalid %sAdminUserRecommendRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawReplyValidationError{}
+var _ error = AdminUserRecommendRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2190,48 +8964,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawReplyValidationError{}
+} = AdminUserRecommendRequestValidationError{}
 
-// Validate checks the field values on AdminRewardListRequest with the rules
+// Validate checks the field values on AdminUserRecommendReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminRewardListRequest) Validate() error {
+func (m *AdminUserRecommendReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminRewardListRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminUserRecommendReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminRewardListRequestMultiError, or nil if none found.
-func (m *AdminRewardListRequest) ValidateAll() error {
+// AdminUserRecommendReplyMultiError, or nil if none found.
+func (m *AdminUserRecommendReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminRewardListRequest) validate(all bool) error {
+func (m *AdminUserRecommendReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
 
-	// no validation rules for Address
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminUserRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminUserRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminUserRecommendReplyValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return AdminRewardListRequestMultiError(errors)
+		return AdminUserRecommendReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminRewardListRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminRewardListRequest.ValidateAll() if the designated
+// AdminUserRecommendReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminUserRecommendReply.ValidateAll() if the designated
 // constraints aren't met.
-type AdminRewardListRequestMultiError []error
+type AdminUserRecommendReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminRewardListRequestMultiError) Error() string {
+func (m AdminUserRecommendReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2240,11 +9044,11 @@ func (m AdminRewardListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminRewardListRequestMultiError) AllErrors() []error { return m }
+func (m AdminUserRecommendReplyMultiError) AllErrors() []error { return m }
 
-// AdminRewardListRequestValidationError is the validation error returned by
-// AdminRewardListRequest.Validate if the designated constraints aren't met.
-type AdminRewardListRequestValidationError struct {
+// AdminUserRecommendReplyValidationError is the validation error returned by
+// AdminUserRecommendReply.Validate if the designated constraints aren't met.
+type AdminUserRecommendReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2252,24 +9056,24 @@ type AdminRewardListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminRewardListRequestValidationError) Field() string { return e.field }
+func (e AdminUserRecommendReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminRewardListRequestValidationError) Reason() string { return e.reason }
+func (e AdminUserRecommendReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminRewardListRequestValidationError) Cause() error { return e.cause }
+func (e AdminUserRecommendReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminRewardListRequestValidationError) Key() bool { return e.key }
+func (e AdminUserRecommendReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminRewardListRequestValidationError) ErrorName() string {
-	return "AdminRewardListRequestValidationError"
+func (e AdminUserRecommendReplyValidationError) ErrorName() string {
+	return "AdminUserRecommendReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminRewardListRequestValidationError) Error() string {
+func (e AdminUserRecommendReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2281,14 +9085,14 @@ func (e AdminRewardListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminRewardListRequest.%s: %s%s",
+		"invalid %sAdminUserRecommendReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminRewardListRequestValidationError{}
+var _ error = AdminUserRecommendReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2296,80 +9100,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminRewardListRequestValidationError{}
+} = AdminUserRecommendReplyValidationError{}
 
-// Validate checks the field values on AdminRewardListReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminMonthRecommendRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminRewardListReply) Validate() error {
+func (m *AdminMonthRecommendRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminRewardListReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminMonthRecommendRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminRewardListReplyMultiError, or nil if none found.
-func (m *AdminRewardListReply) ValidateAll() error {
+// AdminMonthRecommendRequestMultiError, or nil if none found.
+func (m *AdminMonthRecommendRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminRewardListReply) validate(all bool) error {
+func (m *AdminMonthRecommendRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRewards() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminRewardListReplyValidationError{
-						field:  fmt.Sprintf("Rewards[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminRewardListReplyValidationError{
-						field:  fmt.Sprintf("Rewards[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminRewardListReplyValidationError{
-					field:  fmt.Sprintf("Rewards[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Address
 
-	// no validation rules for Count
+	// no validation rules for Page
 
 	if len(errors) > 0 {
-		return AdminRewardListReplyMultiError(errors)
+		return AdminMonthRecommendRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminRewardListReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminRewardListReply.ValidateAll() if the designated
-// constraints aren't met.
-type AdminRewardListReplyMultiError []error
+// AdminMonthRecommendRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminMonthRecommendRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminMonthRecommendRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminRewardListReplyMultiError) Error() string {
+func (m AdminMonthRecommendRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2378,11 +9150,11 @@ func (m AdminRewardListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminRewardListReplyMultiError) AllErrors() []error { return m }
+func (m AdminMonthRecommendRequestMultiError) AllErrors() []error { return m }
 
-// AdminRewardListReplyValidationError is the validation error returned by
-// AdminRewardListReply.Validate if the designated constraints aren't met.
-type AdminRewardListReplyValidationError struct {
+// AdminMonthRecommendRequestValidationError is the validation error returned
+// by AdminMonthRecommendRequest.Validate if the designated constraints aren't met.
+type AdminMonthRecommendRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2390,24 +9162,24 @@ type AdminRewardListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminRewardListReplyValidationError) Field() string { return e.field }
+func (e AdminMonthRecommendRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminRewardListReplyValidationError) Reason() string { return e.reason }
+func (e AdminMonthRecommendRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminRewardListReplyValidationError) Cause() error { return e.cause }
+func (e AdminMonthRecommendRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminRewardListReplyValidationError) Key() bool { return e.key }
+func (e AdminMonthRecommendRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminRewardListReplyValidationError) ErrorName() string {
-	return "AdminRewardListReplyValidationError"
+func (e AdminMonthRecommendRequestValidationError) ErrorName() string {
+	return "AdminMonthRecommendRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminRewardListReplyValidationError) Error() string {
+func (e AdminMonthRecommendRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2419,14 +9191,14 @@ func (e AdminRewardListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminRewardListReply.%s: %s%s",
+		"invalid %sAdminMonthRecommendRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminRewardListReplyValidationError{}
+var _ error = AdminMonthRecommendRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2434,48 +9206,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminRewardListReplyValidationError{}
+} = AdminMonthRecommendRequestValidationError{}
 
-// Validate checks the field values on AdminUserListRequest with the rules
+// Validate checks the field values on AdminMonthRecommendReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserListRequest) Validate() error {
+func (m *AdminMonthRecommendReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserListRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminMonthRecommendReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUserListRequestMultiError, or nil if none found.
-func (m *AdminUserListRequest) ValidateAll() error {
+// AdminMonthRecommendReplyMultiError, or nil if none found.
+func (m *AdminMonthRecommendReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserListRequest) validate(all bool) error {
+func (m *AdminMonthRecommendReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminMonthRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminMonthRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminMonthRecommendReplyValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
-	// no validation rules for Address
+	// no validation rules for Count
 
 	if len(errors) > 0 {
-		return AdminUserListRequestMultiError(errors)
+		return AdminMonthRecommendReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserListRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminUserListRequest.ValidateAll() if the designated
+// AdminMonthRecommendReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminMonthRecommendReply.ValidateAll() if the designated
 // constraints aren't met.
-type AdminUserListRequestMultiError []error
+type AdminMonthRecommendReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserListRequestMultiError) Error() string {
+func (m AdminMonthRecommendReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2484,11 +9288,11 @@ func (m AdminUserListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserListRequestMultiError) AllErrors() []error { return m }
+func (m AdminMonthRecommendReplyMultiError) AllErrors() []error { return m }
 
-// AdminUserListRequestValidationError is the validation error returned by
-// AdminUserListRequest.Validate if the designated constraints aren't met.
-type AdminUserListRequestValidationError struct {
+// AdminMonthRecommendReplyValidationError is the validation error returned by
+// AdminMonthRecommendReply.Validate if the designated constraints aren't met.
+type AdminMonthRecommendReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2496,24 +9300,24 @@ type AdminUserListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserListRequestValidationError) Field() string { return e.field }
+func (e AdminMonthRecommendReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserListRequestValidationError) Reason() string { return e.reason }
+func (e AdminMonthRecommendReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserListRequestValidationError) Cause() error { return e.cause }
+func (e AdminMonthRecommendReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserListRequestValidationError) Key() bool { return e.key }
+func (e AdminMonthRecommendReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserListRequestValidationError) ErrorName() string {
-	return "AdminUserListRequestValidationError"
+func (e AdminMonthRecommendReplyValidationError) ErrorName() string {
+	return "AdminMonthRecommendReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserListRequestValidationError) Error() string {
+func (e AdminMonthRecommendReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2525,14 +9329,14 @@ func (e AdminUserListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserListRequest.%s: %s%s",
+		"invalid %sAdminMonthRecommendReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserListRequestValidationError{}
+var _ error = AdminMonthRecommendReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2540,80 +9344,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserListRequestValidationError{}
+} = AdminMonthRecommendReplyValidationError{}
 
-// Validate checks the field values on AdminUserListReply with the rules
+// Validate checks the field values on AdminConfigRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserListReply) Validate() error {
+func (m *AdminConfigRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserListReply with the rules
+// ValidateAll checks the field values on AdminConfigRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUserListReplyMultiError, or nil if none found.
-func (m *AdminUserListReply) ValidateAll() error {
+// AdminConfigRequestMultiError, or nil if none found.
+func (m *AdminConfigRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserListReply) validate(all bool) error {
+func (m *AdminConfigRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminUserListReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminUserListReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminUserListReplyValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for UserId
 
-	// no validation rules for Count
+	// no validation rules for Page
 
 	if len(errors) > 0 {
-		return AdminUserListReplyMultiError(errors)
+		return AdminConfigRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserListReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminUserListReply.ValidateAll() if the designated constraints
+// AdminConfigRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminConfigRequest.ValidateAll() if the designated constraints
 // aren't met.
-type AdminUserListReplyMultiError []error
+type AdminConfigRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserListReplyMultiError) Error() string {
+func (m AdminConfigRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2622,11 +9394,11 @@ func (m AdminUserListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserListReplyMultiError) AllErrors() []error { return m }
+func (m AdminConfigRequestMultiError) AllErrors() []error { return m }
 
-// AdminUserListReplyValidationError is the validation error returned by
-// AdminUserListReply.Validate if the designated constraints aren't met.
-type AdminUserListReplyValidationError struct {
+// AdminConfigRequestValidationError is the validation error returned by
+// AdminConfigRequest.Validate if the designated constraints aren't met.
+type AdminConfigRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2634,24 +9406,24 @@ type AdminUserListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserListReplyValidationError) Field() string { return e.field }
+func (e AdminConfigRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserListReplyValidationError) Reason() string { return e.reason }
+func (e AdminConfigRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserListReplyValidationError) Cause() error { return e.cause }
+func (e AdminConfigRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserListReplyValidationError) Key() bool { return e.key }
+func (e AdminConfigRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserListReplyValidationError) ErrorName() string {
-	return "AdminUserListReplyValidationError"
+func (e AdminConfigRequestValidationError) ErrorName() string {
+	return "AdminConfigRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserListReplyValidationError) Error() string {
+func (e AdminConfigRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2663,14 +9435,14 @@ func (e AdminUserListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserListReply.%s: %s%s",
+		"invalid %sAdminConfigRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserListReplyValidationError{}
+var _ error = AdminConfigRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2678,48 +9450,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserListReplyValidationError{}
+} = AdminConfigRequestValidationError{}
 
-// Validate checks the field values on AdminLocationListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminLocationListRequest) Validate() error {
+// Validate checks the field values on AdminConfigReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationListRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminConfigReply with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminLocationListRequestMultiError, or nil if none found.
-func (m *AdminLocationListRequest) ValidateAll() error {
+// AdminConfigReplyMultiError, or nil if none found.
+func (m *AdminConfigReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationListRequest) validate(all bool) error {
+func (m *AdminConfigReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
+	for idx, item := range m.GetConfig() {
+		_, _ = idx, item
 
-	// no validation rules for Address
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminConfigReplyValidationError{
+						field:  fmt.Sprintf("Config[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminConfigReplyValidationError{
+						field:  fmt.Sprintf("Config[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminConfigReplyValidationError{
+					field:  fmt.Sprintf("Config[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
 
 	if len(errors) > 0 {
-		return AdminLocationListRequestMultiError(errors)
+		return AdminConfigReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationListRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminLocationListRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminLocationListRequestMultiError []error
+// AdminConfigReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminConfigReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminConfigReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationListRequestMultiError) Error() string {
+func (m AdminConfigReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2728,11 +9532,11 @@ func (m AdminLocationListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationListRequestMultiError) AllErrors() []error { return m }
+func (m AdminConfigReplyMultiError) AllErrors() []error { return m }
 
-// AdminLocationListRequestValidationError is the validation error returned by
-// AdminLocationListRequest.Validate if the designated constraints aren't met.
-type AdminLocationListRequestValidationError struct {
+// AdminConfigReplyValidationError is the validation error returned by
+// AdminConfigReply.Validate if the designated constraints aren't met.
+type AdminConfigReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2740,24 +9544,22 @@ type AdminLocationListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationListRequestValidationError) Field() string { return e.field }
+func (e AdminConfigReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationListRequestValidationError) Reason() string { return e.reason }
+func (e AdminConfigReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationListRequestValidationError) Cause() error { return e.cause }
+func (e AdminConfigReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationListRequestValidationError) Key() bool { return e.key }
+func (e AdminConfigReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationListRequestValidationError) ErrorName() string {
-	return "AdminLocationListRequestValidationError"
-}
+func (e AdminConfigReplyValidationError) ErrorName() string { return "AdminConfigReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationListRequestValidationError) Error() string {
+func (e AdminConfigReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2769,14 +9571,14 @@ func (e AdminLocationListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationListRequest.%s: %s%s",
+		"invalid %sAdminConfigReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationListRequestValidationError{}
+var _ error = AdminConfigReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2784,80 +9586,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationListRequestValidationError{}
+} = AdminConfigReplyValidationError{}
 
-// Validate checks the field values on AdminLocationListReply with the rules
+// Validate checks the field values on AdminConfigUpdateRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminLocationListReply) Validate() error {
+func (m *AdminConfigUpdateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationListReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminConfigUpdateRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminLocationListReplyMultiError, or nil if none found.
-func (m *AdminLocationListReply) ValidateAll() error {
+// AdminConfigUpdateRequestMultiError, or nil if none found.
+func (m *AdminConfigUpdateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationListReply) validate(all bool) error {
+func (m *AdminConfigUpdateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetLocations() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminLocationListReplyValidationError{
-						field:  fmt.Sprintf("Locations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminLocationListReplyValidationError{
-						field:  fmt.Sprintf("Locations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminConfigUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return AdminLocationListReplyValidationError{
-					field:  fmt.Sprintf("Locations[%v]", idx),
+				errors = append(errors, AdminConfigUpdateRequestValidationError{
+					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminConfigUpdateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for Count
-
 	if len(errors) > 0 {
-		return AdminLocationListReplyMultiError(errors)
+		return AdminConfigUpdateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationListReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminLocationListReply.ValidateAll() if the designated
+// AdminConfigUpdateRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminConfigUpdateRequest.ValidateAll() if the designated
 // constraints aren't met.
-type AdminLocationListReplyMultiError []error
+type AdminConfigUpdateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationListReplyMultiError) Error() string {
+func (m AdminConfigUpdateRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2866,11 +9661,11 @@ func (m AdminLocationListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationListReplyMultiError) AllErrors() []error { return m }
+func (m AdminConfigUpdateRequestMultiError) AllErrors() []error { return m }
 
-// AdminLocationListReplyValidationError is the validation error returned by
-// AdminLocationListReply.Validate if the designated constraints aren't met.
-type AdminLocationListReplyValidationError struct {
+// AdminConfigUpdateRequestValidationError is the validation error returned by
+// AdminConfigUpdateRequest.Validate if the designated constraints aren't met.
+type AdminConfigUpdateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2878,24 +9673,24 @@ type AdminLocationListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationListReplyValidationError) Field() string { return e.field }
+func (e AdminConfigUpdateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationListReplyValidationError) Reason() string { return e.reason }
+func (e AdminConfigUpdateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationListReplyValidationError) Cause() error { return e.cause }
+func (e AdminConfigUpdateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationListReplyValidationError) Key() bool { return e.key }
+func (e AdminConfigUpdateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationListReplyValidationError) ErrorName() string {
-	return "AdminLocationListReplyValidationError"
+func (e AdminConfigUpdateRequestValidationError) ErrorName() string {
+	return "AdminConfigUpdateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationListReplyValidationError) Error() string {
+func (e AdminConfigUpdateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2907,14 +9702,14 @@ func (e AdminLocationListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationListReply.%s: %s%s",
+		"invalid %sAdminConfigUpdateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationListReplyValidationError{}
+var _ error = AdminConfigUpdateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2922,48 +9717,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationListReplyValidationError{}
+} = AdminConfigUpdateRequestValidationError{}
 
-// Validate checks the field values on AdminWithdrawListRequest with the rules
+// Validate checks the field values on AdminConfigUpdateReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawListRequest) Validate() error {
+func (m *AdminConfigUpdateReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawListRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminConfigUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawListRequestMultiError, or nil if none found.
-func (m *AdminWithdrawListRequest) ValidateAll() error {
+// AdminConfigUpdateReplyMultiError, or nil if none found.
+func (m *AdminConfigUpdateReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawListRequest) validate(all bool) error {
+func (m *AdminConfigUpdateReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
-
-	// no validation rules for Address
-
 	if len(errors) > 0 {
-		return AdminWithdrawListRequestMultiError(errors)
+		return AdminConfigUpdateReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawListRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawListRequest.ValidateAll() if the designated
+// AdminConfigUpdateReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminConfigUpdateReply.ValidateAll() if the designated
 // constraints aren't met.
-type AdminWithdrawListRequestMultiError []error
+type AdminConfigUpdateReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawListRequestMultiError) Error() string {
+func (m AdminConfigUpdateReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2972,11 +9763,11 @@ func (m AdminWithdrawListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawListRequestMultiError) AllErrors() []error { return m }
+func (m AdminConfigUpdateReplyMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawListRequestValidationError is the validation error returned by
-// AdminWithdrawListRequest.Validate if the designated constraints aren't met.
-type AdminWithdrawListRequestValidationError struct {
+// AdminConfigUpdateReplyValidationError is the validation error returned by
+// AdminConfigUpdateReply.Validate if the designated constraints aren't met.
+type AdminConfigUpdateReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2984,24 +9775,24 @@ type AdminWithdrawListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawListRequestValidationError) Field() string { return e.field }
+func (e AdminConfigUpdateReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawListRequestValidationError) Reason() string { return e.reason }
+func (e AdminConfigUpdateReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawListRequestValidationError) Cause() error { return e.cause }
+func (e AdminConfigUpdateReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawListRequestValidationError) Key() bool { return e.key }
+func (e AdminConfigUpdateReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawListRequestValidationError) ErrorName() string {
-	return "AdminWithdrawListRequestValidationError"
+func (e AdminConfigUpdateReplyValidationError) ErrorName() string {
+	return "AdminConfigUpdateReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawListRequestValidationError) Error() string {
+func (e AdminConfigUpdateReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3013,14 +9804,14 @@ func (e AdminWithdrawListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawListRequest.%s: %s%s",
+		"invalid %sAdminConfigUpdateReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawListRequestValidationError{}
+var _ error = AdminConfigUpdateReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3028,80 +9819,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawListRequestValidationError{}
+} = AdminConfigUpdateReplyValidationError{}
 
-// Validate checks the field values on AdminWithdrawListReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on EthAuthorizeRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawListReply) Validate() error {
+func (m *EthAuthorizeRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawListReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on EthAuthorizeRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawListReplyMultiError, or nil if none found.
-func (m *AdminWithdrawListReply) ValidateAll() error {
+// EthAuthorizeRequest_SendBodyMultiError, or nil if none found.
+func (m *EthAuthorizeRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawListReply) validate(all bool) error {
+func (m *EthAuthorizeRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetWithdraw() {
-		_, _ = idx, item
+	// no validation rules for Address
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminWithdrawListReplyValidationError{
-						field:  fmt.Sprintf("Withdraw[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminWithdrawListReplyValidationError{
-						field:  fmt.Sprintf("Withdraw[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminWithdrawListReplyValidationError{
-					field:  fmt.Sprintf("Withdraw[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Code
 
-	}
+	// no validation rules for Nonce
 
-	// no validation rules for Count
+	// no validation rules for Sign
+
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return AdminWithdrawListReplyMultiError(errors)
+		return EthAuthorizeRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawListReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawListReply.ValidateAll() if the designated
-// constraints aren't met.
-type AdminWithdrawListReplyMultiError []error
+// EthAuthorizeRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by EthAuthorizeRequest_SendBody.ValidateAll() if
+// the designated constraints aren't met.
+type EthAuthorizeRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawListReplyMultiError) Error() string {
+func (m EthAuthorizeRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3110,11 +9875,12 @@ func (m AdminWithdrawListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawListReplyMultiError) AllErrors() []error { return m }
+func (m EthAuthorizeRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawListReplyValidationError is the validation error returned by
-// AdminWithdrawListReply.Validate if the designated constraints aren't met.
-type AdminWithdrawListReplyValidationError struct {
+// EthAuthorizeRequest_SendBodyValidationError is the validation error returned
+// by EthAuthorizeRequest_SendBody.Validate if the designated constraints
+// aren't met.
+type EthAuthorizeRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3122,24 +9888,24 @@ type AdminWithdrawListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawListReplyValidationError) Field() string { return e.field }
+func (e EthAuthorizeRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawListReplyValidationError) Reason() string { return e.reason }
+func (e EthAuthorizeRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawListReplyValidationError) Cause() error { return e.cause }
+func (e EthAuthorizeRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawListReplyValidationError) Key() bool { return e.key }
+func (e EthAuthorizeRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawListReplyValidationError) ErrorName() string {
-	return "AdminWithdrawListReplyValidationError"
+func (e EthAuthorizeRequest_SendBodyValidationError) ErrorName() string {
+	return "EthAuthorizeRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawListReplyValidationError) Error() string {
+func (e EthAuthorizeRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3151,14 +9917,14 @@ func (e AdminWithdrawListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawListReply.%s: %s%s",
+		"invalid %sEthAuthorizeRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawListReplyValidationError{}
+var _ error = EthAuthorizeRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3166,44 +9932,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawListReplyValidationError{}
+} = EthAuthorizeRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminWithdrawRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RefreshTokenRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawRequest) Validate() error {
+func (m *RefreshTokenRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RefreshTokenRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawRequestMultiError, or nil if none found.
-func (m *AdminWithdrawRequest) ValidateAll() error {
+// RefreshTokenRequest_SendBodyMultiError, or nil if none found.
+func (m *RefreshTokenRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawRequest) validate(all bool) error {
+func (m *RefreshTokenRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for RefreshToken
+
 	if len(errors) > 0 {
-		return AdminWithdrawRequestMultiError(errors)
+		return RefreshTokenRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminWithdrawRequestMultiError []error
+// RefreshTokenRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by RefreshTokenRequest_SendBody.ValidateAll() if
+// the designated constraints aren't met.
+type RefreshTokenRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawRequestMultiError) Error() string {
+func (m RefreshTokenRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3212,11 +9980,12 @@ func (m AdminWithdrawRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawRequestMultiError) AllErrors() []error { return m }
+func (m RefreshTokenRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawRequestValidationError is the validation error returned by
-// AdminWithdrawRequest.Validate if the designated constraints aren't met.
-type AdminWithdrawRequestValidationError struct {
+// RefreshTokenRequest_SendBodyValidationError is the validation error returned
+// by RefreshTokenRequest_SendBody.Validate if the designated constraints
+// aren't met.
+type RefreshTokenRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3224,24 +9993,24 @@ type AdminWithdrawRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawRequestValidationError) Field() string { return e.field }
+func (e RefreshTokenRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawRequestValidationError) Reason() string { return e.reason }
+func (e RefreshTokenRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawRequestValidationError) Cause() error { return e.cause }
+func (e RefreshTokenRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawRequestValidationError) Key() bool { return e.key }
+func (e RefreshTokenRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawRequestValidationError) ErrorName() string {
-	return "AdminWithdrawRequestValidationError"
+func (e RefreshTokenRequest_SendBodyValidationError) ErrorName() string {
+	return "RefreshTokenRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawRequestValidationError) Error() string {
+func (e RefreshTokenRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3253,14 +10022,14 @@ func (e AdminWithdrawRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawRequest.%s: %s%s",
+		"invalid %sRefreshTokenRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawRequestValidationError{}
+var _ error = RefreshTokenRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3268,44 +10037,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawRequestValidationError{}
+} = RefreshTokenRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminWithdrawReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminLoginRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawReply) Validate() error {
+func (m *AdminLoginRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminLoginRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawReplyMultiError, or nil if none found.
-func (m *AdminWithdrawReply) ValidateAll() error {
+// AdminLoginRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminLoginRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawReply) validate(all bool) error {
+func (m *AdminLoginRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Account
+
+	// no validation rules for Password
+
 	if len(errors) > 0 {
-		return AdminWithdrawReplyMultiError(errors)
+		return AdminLoginRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminWithdrawReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminWithdrawReplyMultiError []error
+// AdminLoginRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by AdminLoginRequest_SendBody.ValidateAll() if
+// the designated constraints aren't met.
+type AdminLoginRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawReplyMultiError) Error() string {
+func (m AdminLoginRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3314,11 +10087,11 @@ func (m AdminWithdrawReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawReplyMultiError) AllErrors() []error { return m }
+func (m AdminLoginRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawReplyValidationError is the validation error returned by
-// AdminWithdrawReply.Validate if the designated constraints aren't met.
-type AdminWithdrawReplyValidationError struct {
+// AdminLoginRequest_SendBodyValidationError is the validation error returned
+// by AdminLoginRequest_SendBody.Validate if the designated constraints aren't met.
+type AdminLoginRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3326,24 +10099,24 @@ type AdminWithdrawReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawReplyValidationError) Field() string { return e.field }
+func (e AdminLoginRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawReplyValidationError) Reason() string { return e.reason }
+func (e AdminLoginRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawReplyValidationError) Cause() error { return e.cause }
+func (e AdminLoginRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawReplyValidationError) Key() bool { return e.key }
+func (e AdminLoginRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawReplyValidationError) ErrorName() string {
-	return "AdminWithdrawReplyValidationError"
+func (e AdminLoginRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminLoginRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawReplyValidationError) Error() string {
+func (e AdminLoginRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3355,14 +10128,14 @@ func (e AdminWithdrawReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawReply.%s: %s%s",
+		"invalid %sAdminLoginRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawReplyValidationError{}
+var _ error = AdminLoginRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3370,44 +10143,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawReplyValidationError{}
+} = AdminLoginRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminWithdrawEthRequest with the rules
+// Validate checks the field values on UserInfoReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawEthRequest) Validate() error {
+func (m *UserInfoReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawEthRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UserInfoReply_List with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawEthRequestMultiError, or nil if none found.
-func (m *AdminWithdrawEthRequest) ValidateAll() error {
+// UserInfoReply_ListMultiError, or nil if none found.
+func (m *UserInfoReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawEthRequest) validate(all bool) error {
+func (m *UserInfoReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Account
+
+	// no validation rules for RecommendReward
+
+	// no validation rules for Reward
+
 	if len(errors) > 0 {
-		return AdminWithdrawEthRequestMultiError(errors)
+		return UserInfoReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawEthRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawEthRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminWithdrawEthRequestMultiError []error
+// UserInfoReply_ListMultiError is an error wrapping multiple validation errors
+// returned by UserInfoReply_List.ValidateAll() if the designated constraints
+// aren't met.
+type UserInfoReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawEthRequestMultiError) Error() string {
+func (m UserInfoReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3416,11 +10195,11 @@ func (m AdminWithdrawEthRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawEthRequestMultiError) AllErrors() []error { return m }
+func (m UserInfoReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawEthRequestValidationError is the validation error returned by
-// AdminWithdrawEthRequest.Validate if the designated constraints aren't met.
-type AdminWithdrawEthRequestValidationError struct {
+// UserInfoReply_ListValidationError is the validation error returned by
+// UserInfoReply_List.Validate if the designated constraints aren't met.
+type UserInfoReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3428,24 +10207,24 @@ type AdminWithdrawEthRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawEthRequestValidationError) Field() string { return e.field }
+func (e UserInfoReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawEthRequestValidationError) Reason() string { return e.reason }
+func (e UserInfoReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawEthRequestValidationError) Cause() error { return e.cause }
+func (e UserInfoReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawEthRequestValidationError) Key() bool { return e.key }
+func (e UserInfoReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawEthRequestValidationError) ErrorName() string {
-	return "AdminWithdrawEthRequestValidationError"
+func (e UserInfoReply_ListValidationError) ErrorName() string {
+	return "UserInfoReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawEthRequestValidationError) Error() string {
+func (e UserInfoReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3457,14 +10236,14 @@ func (e AdminWithdrawEthRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawEthRequest.%s: %s%s",
+		"invalid %sUserInfoReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawEthRequestValidationError{}
+var _ error = UserInfoReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -3472,44 +10251,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawEthRequestValidationError{}
+} = UserInfoReply_ListValidationError{}
 
-// Validate checks the field values on AdminWithdrawEthReply with the rules
+// Validate checks the field values on RewardListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawEthReply) Validate() error {
+func (m *RewardListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawEthReply with the rules
+// ValidateAll checks the field values on RewardListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawEthReplyMultiError, or nil if none found.
-func (m *AdminWithdrawEthReply) ValidateAll() error {
+// RewardListReply_ListMultiError, or nil if none found.
+func (m *RewardListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawEthReply) validate(all bool) error {
+func (m *RewardListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for LocationStatus
+
+	// no validation rules for Type
+
 	if len(errors) > 0 {
-		return AdminWithdrawEthReplyMultiError(errors)
+		return RewardListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawEthReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawEthReply.ValidateAll() if the designated
+// RewardListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by RewardListReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type AdminWithdrawEthReplyMultiError []error
+type RewardListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawEthReplyMultiError) Error() string {
+func (m RewardListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3518,11 +10305,11 @@ func (m AdminWithdrawEthReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawEthReplyMultiError) AllErrors() []error { return m }
+func (m RewardListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawEthReplyValidationError is the validation error returned by
-// AdminWithdrawEthReply.Validate if the designated constraints aren't met.
-type AdminWithdrawEthReplyValidationError struct {
+// RewardListReply_ListValidationError is the validation error returned by
+// RewardListReply_List.Validate if the designated constraints aren't met.
+type RewardListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3530,24 +10317,24 @@ type AdminWithdrawEthReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawEthReplyValidationError) Field() string { return e.field }
+func (e RewardListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawEthReplyValidationError) Reason() string { return e.reason }
+func (e RewardListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawEthReplyValidationError) Cause() error { return e.cause }
+func (e RewardListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawEthReplyValidationError) Key() bool { return e.key }
+func (e RewardListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawEthReplyValidationError) ErrorName() string {
-	return "AdminWithdrawEthReplyValidationError"
+func (e RewardListReply_ListValidationError) ErrorName() string {
+	return "RewardListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawEthReplyValidationError) Error() string {
+func (e RewardListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3559,14 +10346,14 @@ func (e AdminWithdrawEthReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawEthReply.%s: %s%s",
+		"invalid %sRewardListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawEthReplyValidationError{}
+var _ error = RewardListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -3574,44 +10361,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawEthReplyValidationError{}
+} = RewardListReply_ListValidationError{}
 
-// Validate checks the field values on AdminFeeRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminFeeRequest) Validate() error {
+// Validate checks the field values on RecommendRewardListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendRewardListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminFeeRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminFeeRequestMultiError, or nil if none found.
-func (m *AdminFeeRequest) ValidateAll() error {
+// ValidateAll checks the field values on RecommendRewardListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RecommendRewardListReply_ListMultiError, or nil if none found.
+func (m *RecommendRewardListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminFeeRequest) validate(all bool) error {
+func (m *RecommendRewardListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for Type
+
+	// no validation rules for Reason
+
 	if len(errors) > 0 {
-		return AdminFeeRequestMultiError(errors)
+		return RecommendRewardListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminFeeRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminFeeRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AdminFeeRequestMultiError []error
+// RecommendRewardListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by RecommendRewardListReply_List.ValidateAll()
+// if the designated constraints aren't met.
+type RecommendRewardListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminFeeRequestMultiError) Error() string {
+func (m RecommendRewardListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3620,11 +10415,12 @@ func (m AdminFeeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminFeeRequestMultiError) AllErrors() []error { return m }
+func (m RecommendRewardListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminFeeRequestValidationError is the validation error returned by
-// AdminFeeRequest.Validate if the designated constraints aren't met.
-type AdminFeeRequestValidationError struct {
+// RecommendRewardListReply_ListValidationError is the validation error
+// returned by RecommendRewardListReply_List.Validate if the designated
+// constraints aren't met.
+type RecommendRewardListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3632,22 +10428,24 @@ type AdminFeeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminFeeRequestValidationError) Field() string { return e.field }
+func (e RecommendRewardListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminFeeRequestValidationError) Reason() string { return e.reason }
+func (e RecommendRewardListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminFeeRequestValidationError) Cause() error { return e.cause }
+func (e RecommendRewardListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminFeeRequestValidationError) Key() bool { return e.key }
+func (e RecommendRewardListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminFeeRequestValidationError) ErrorName() string { return "AdminFeeRequestValidationError" }
+func (e RecommendRewardListReply_ListValidationError) ErrorName() string {
+	return "RecommendRewardListReply_ListValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminFeeRequestValidationError) Error() string {
+func (e RecommendRewardListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3659,14 +10457,14 @@ func (e AdminFeeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminFeeRequest.%s: %s%s",
+		"invalid %sRecommendRewardListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminFeeRequestValidationError{}
+var _ error = RecommendRewardListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -3674,44 +10472,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminFeeRequestValidationError{}
+} = RecommendRewardListReply_ListValidationError{}
 
-// Validate checks the field values on AdminFeeReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AdminFeeReply) Validate() error {
+// Validate checks the field values on FeeRewardListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FeeRewardListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminFeeReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AdminFeeReplyMultiError, or
-// nil if none found.
-func (m *AdminFeeReply) ValidateAll() error {
+// ValidateAll checks the field values on FeeRewardListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FeeRewardListReply_ListMultiError, or nil if none found.
+func (m *FeeRewardListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminFeeReply) validate(all bool) error {
+func (m *FeeRewardListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
 	if len(errors) > 0 {
-		return AdminFeeReplyMultiError(errors)
+		return FeeRewardListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminFeeReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminFeeReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminFeeReplyMultiError []error
+// FeeRewardListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by FeeRewardListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type FeeRewardListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminFeeReplyMultiError) Error() string {
+func (m FeeRewardListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3720,11 +10522,11 @@ func (m AdminFeeReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminFeeReplyMultiError) AllErrors() []error { return m }
+func (m FeeRewardListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminFeeReplyValidationError is the validation error returned by
-// AdminFeeReply.Validate if the designated constraints aren't met.
-type AdminFeeReplyValidationError struct {
+// FeeRewardListReply_ListValidationError is the validation error returned by
+// FeeRewardListReply_List.Validate if the designated constraints aren't met.
+type FeeRewardListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3732,22 +10534,24 @@ type AdminFeeReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminFeeReplyValidationError) Field() string { return e.field }
+func (e FeeRewardListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminFeeReplyValidationError) Reason() string { return e.reason }
+func (e FeeRewardListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminFeeReplyValidationError) Cause() error { return e.cause }
+func (e FeeRewardListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminFeeReplyValidationError) Key() bool { return e.key }
+func (e FeeRewardListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminFeeReplyValidationError) ErrorName() string { return "AdminFeeReplyValidationError" }
+func (e FeeRewardListReply_ListValidationError) ErrorName() string {
+	return "FeeRewardListReply_ListValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminFeeReplyValidationError) Error() string {
+func (e FeeRewardListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3759,14 +10563,14 @@ func (e AdminFeeReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminFeeReply.%s: %s%s",
+		"invalid %sFeeRewardListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminFeeReplyValidationError{}
+var _ error = FeeRewardListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -3774,44 +10578,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminFeeReplyValidationError{}
+} = FeeRewardListReply_ListValidationError{}
 
-// Validate checks the field values on AdminAllRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminAllRequest) Validate() error {
+// Validate checks the field values on WithdrawListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminAllRequest with the rules
+// ValidateAll checks the field values on WithdrawListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminAllRequestMultiError, or nil if none found.
-func (m *AdminAllRequest) ValidateAll() error {
+// WithdrawListReply_ListMultiError, or nil if none found.
+func (m *WithdrawListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminAllRequest) validate(all bool) error {
+func (m *WithdrawListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for Type
+
+	// no validation rules for Status
+
+	// no validation rules for ChainId
+
+	// no validation rules for Id
+
+	// no validation rules for TxHash
+
 	if len(errors) > 0 {
-		return AdminAllRequestMultiError(errors)
+		return WithdrawListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminAllRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminAllRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AdminAllRequestMultiError []error
+// WithdrawListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by WithdrawListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminAllRequestMultiError) Error() string {
+func (m WithdrawListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3820,11 +10638,11 @@ func (m AdminAllRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminAllRequestMultiError) AllErrors() []error { return m }
+func (m WithdrawListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminAllRequestValidationError is the validation error returned by
-// AdminAllRequest.Validate if the designated constraints aren't met.
-type AdminAllRequestValidationError struct {
+// WithdrawListReply_ListValidationError is the validation error returned by
+// WithdrawListReply_List.Validate if the designated constraints aren't met.
+type WithdrawListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3832,22 +10650,24 @@ type AdminAllRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminAllRequestValidationError) Field() string { return e.field }
+func (e WithdrawListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminAllRequestValidationError) Reason() string { return e.reason }
+func (e WithdrawListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminAllRequestValidationError) Cause() error { return e.cause }
+func (e WithdrawListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminAllRequestValidationError) Key() bool { return e.key }
+func (e WithdrawListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminAllRequestValidationError) ErrorName() string { return "AdminAllRequestValidationError" }
+func (e WithdrawListReply_ListValidationError) ErrorName() string {
+	return "WithdrawListReply_ListValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminAllRequestValidationError) Error() string {
+func (e WithdrawListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3859,14 +10679,14 @@ func (e AdminAllRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminAllRequest.%s: %s%s",
+		"invalid %sWithdrawListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminAllRequestValidationError{}
+var _ error = WithdrawListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -3874,62 +10694,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminAllRequestValidationError{}
+} = WithdrawListReply_ListValidationError{}
 
-// Validate checks the field values on AdminAllReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AdminAllReply) Validate() error {
+// Validate checks the field values on RecommendListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminAllReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AdminAllReplyMultiError, or
-// nil if none found.
-func (m *AdminAllReply) ValidateAll() error {
+// ValidateAll checks the field values on RecommendListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendListReply_ListMultiError, or nil if none found.
+func (m *RecommendListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminAllReply) validate(all bool) error {
+func (m *RecommendListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TodayTotalUser
-
-	// no validation rules for TotalUser
-
-	// no validation rules for AllBalance
-
-	// no validation rules for TodayLocation
-
-	// no validation rules for AllLocation
-
-	// no validation rules for TodayWithdraw
-
-	// no validation rules for AllWithdraw
-
-	// no validation rules for AllReward
+	// no validation rules for Amount
 
-	// no validation rules for AllSystemRewardAndFee
+	// no validation rules for Status
 
 	if len(errors) > 0 {
-		return AdminAllReplyMultiError(errors)
+		return RecommendListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminAllReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminAllReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminAllReplyMultiError []error
+// RecommendListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by RecommendListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type RecommendListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminAllReplyMultiError) Error() string {
+func (m RecommendListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3938,11 +10744,11 @@ func (m AdminAllReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminAllReplyMultiError) AllErrors() []error { return m }
+func (m RecommendListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminAllReplyValidationError is the validation error returned by
-// AdminAllReply.Validate if the designated constraints aren't met.
-type AdminAllReplyValidationError struct {
+// RecommendListReply_ListValidationError is the validation error returned by
+// RecommendListReply_List.Validate if the designated constraints aren't met.
+type RecommendListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3950,22 +10756,24 @@ type AdminAllReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminAllReplyValidationError) Field() string { return e.field }
+func (e RecommendListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminAllReplyValidationError) Reason() string { return e.reason }
+func (e RecommendListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminAllReplyValidationError) Cause() error { return e.cause }
+func (e RecommendListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminAllReplyValidationError) Key() bool { return e.key }
+func (e RecommendListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminAllReplyValidationError) ErrorName() string { return "AdminAllReplyValidationError" }
+func (e RecommendListReply_ListValidationError) ErrorName() string {
+	return "RecommendListReply_ListValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminAllReplyValidationError) Error() string {
+func (e RecommendListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3977,14 +10785,14 @@ func (e AdminAllReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminAllReply.%s: %s%s",
+		"invalid %sRecommendListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminAllReplyValidationError{}
+var _ error = RecommendListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -3992,46 +10800,62 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminAllReplyValidationError{}
+} = RecommendListReply_ListValidationError{}
 
-// Validate checks the field values on AdminUserRecommendRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DepositPendingListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserRecommendRequest) Validate() error {
+func (m *DepositPendingListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserRecommendRequest with the
+// ValidateAll checks the field values on DepositPendingListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUserRecommendRequestMultiError, or nil if none found.
-func (m *AdminUserRecommendRequest) ValidateAll() error {
+// DepositPendingListReply_ListMultiError, or nil if none found.
+func (m *DepositPendingListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserRecommendRequest) validate(all bool) error {
+func (m *DepositPendingListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	// no validation rules for Hash
+
+	// no validation rules for Amount
+
+	// no validation rules for BlockNumber
+
+	// no validation rules for Confirmations
+
+	// no validation rules for Required
+
+	// no validation rules for Status
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for CoinType
+
+	// no validation rules for ChainId
 
 	if len(errors) > 0 {
-		return AdminUserRecommendRequestMultiError(errors)
+		return DepositPendingListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserRecommendRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminUserRecommendRequest.ValidateAll() if the
-// designated constraints aren't met.
-type AdminUserRecommendRequestMultiError []error
+// DepositPendingListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by DepositPendingListReply_List.ValidateAll() if
+// the designated constraints aren't met.
+type DepositPendingListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserRecommendRequestMultiError) Error() string {
+func (m DepositPendingListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4040,11 +10864,12 @@ func (m AdminUserRecommendRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserRecommendRequestMultiError) AllErrors() []error { return m }
+func (m DepositPendingListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminUserRecommendRequestValidationError is the validation error returned by
-// AdminUserRecommendRequest.Validate if the designated constraints aren't met.
-type AdminUserRecommendRequestValidationError struct {
+// DepositPendingListReply_ListValidationError is the validation error returned
+// by DepositPendingListReply_List.Validate if the designated constraints
+// aren't met.
+type DepositPendingListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4052,24 +10877,24 @@ type AdminUserRecommendRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserRecommendRequestValidationError) Field() string { return e.field }
+func (e DepositPendingListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserRecommendRequestValidationError) Reason() string { return e.reason }
+func (e DepositPendingListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserRecommendRequestValidationError) Cause() error { return e.cause }
+func (e DepositPendingListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserRecommendRequestValidationError) Key() bool { return e.key }
+func (e DepositPendingListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserRecommendRequestValidationError) ErrorName() string {
-	return "AdminUserRecommendRequestValidationError"
+func (e DepositPendingListReply_ListValidationError) ErrorName() string {
+	return "DepositPendingListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserRecommendRequestValidationError) Error() string {
+func (e DepositPendingListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4081,14 +10906,14 @@ func (e AdminUserRecommendRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserRecommendRequest.%s: %s%s",
+		"invalid %sDepositPendingListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserRecommendRequestValidationError{}
+var _ error = DepositPendingListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -4096,78 +10921,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserRecommendRequestValidationError{}
+} = DepositPendingListReply_ListValidationError{}
 
-// Validate checks the field values on AdminUserRecommendReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DepositUnmatchedListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserRecommendReply) Validate() error {
+func (m *DepositUnmatchedListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserRecommendReply with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminUserRecommendReplyMultiError, or nil if none found.
-func (m *AdminUserRecommendReply) ValidateAll() error {
+// ValidateAll checks the field values on DepositUnmatchedListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DepositUnmatchedListReply_ListMultiError, or nil if none found.
+func (m *DepositUnmatchedListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserRecommendReply) validate(all bool) error {
+func (m *DepositUnmatchedListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
+	// no validation rules for Hash
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminUserRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminUserRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminUserRecommendReplyValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Amount
 
-	}
+	// no validation rules for Status
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for CoinType
+
+	// no validation rules for ChainId
 
 	if len(errors) > 0 {
-		return AdminUserRecommendReplyMultiError(errors)
+		return DepositUnmatchedListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserRecommendReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminUserRecommendReply.ValidateAll() if the designated
-// constraints aren't met.
-type AdminUserRecommendReplyMultiError []error
+// DepositUnmatchedListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by DepositUnmatchedListReply_List.ValidateAll()
+// if the designated constraints aren't met.
+type DepositUnmatchedListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserRecommendReplyMultiError) Error() string {
+func (m DepositUnmatchedListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4176,11 +10979,12 @@ func (m AdminUserRecommendReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserRecommendReplyMultiError) AllErrors() []error { return m }
+func (m DepositUnmatchedListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminUserRecommendReplyValidationError is the validation error returned by
-// AdminUserRecommendReply.Validate if the designated constraints aren't met.
-type AdminUserRecommendReplyValidationError struct {
+// DepositUnmatchedListReply_ListValidationError is the validation error
+// returned by DepositUnmatchedListReply_List.Validate if the designated
+// constraints aren't met.
+type DepositUnmatchedListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4188,24 +10992,24 @@ type AdminUserRecommendReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserRecommendReplyValidationError) Field() string { return e.field }
+func (e DepositUnmatchedListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserRecommendReplyValidationError) Reason() string { return e.reason }
+func (e DepositUnmatchedListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserRecommendReplyValidationError) Cause() error { return e.cause }
+func (e DepositUnmatchedListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserRecommendReplyValidationError) Key() bool { return e.key }
+func (e DepositUnmatchedListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserRecommendReplyValidationError) ErrorName() string {
-	return "AdminUserRecommendReplyValidationError"
+func (e DepositUnmatchedListReply_ListValidationError) ErrorName() string {
+	return "DepositUnmatchedListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserRecommendReplyValidationError) Error() string {
+func (e DepositUnmatchedListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4217,14 +11021,14 @@ func (e AdminUserRecommendReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserRecommendReply.%s: %s%s",
+		"invalid %sDepositUnmatchedListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserRecommendReplyValidationError{}
+var _ error = DepositUnmatchedListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -4232,48 +11036,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserRecommendReplyValidationError{}
+} = DepositUnmatchedListReply_ListValidationError{}
 
-// Validate checks the field values on AdminMonthRecommendRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ChainListReply_Token with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminMonthRecommendRequest) Validate() error {
+func (m *ChainListReply_Token) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminMonthRecommendRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ChainListReply_Token with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminMonthRecommendRequestMultiError, or nil if none found.
-func (m *AdminMonthRecommendRequest) ValidateAll() error {
+// ChainListReply_TokenMultiError, or nil if none found.
+func (m *ChainListReply_Token) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminMonthRecommendRequest) validate(all bool) error {
+func (m *ChainListReply_Token) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
+	// no validation rules for Symbol
 
-	// no validation rules for Page
+	// no validation rules for Contract
+
+	// no validation rules for Decimals
 
 	if len(errors) > 0 {
-		return AdminMonthRecommendRequestMultiError(errors)
+		return ChainListReply_TokenMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminMonthRecommendRequestMultiError is an error wrapping multiple
-// validation errors returned by AdminMonthRecommendRequest.ValidateAll() if
-// the designated constraints aren't met.
-type AdminMonthRecommendRequestMultiError []error
+// ChainListReply_TokenMultiError is an error wrapping multiple validation
+// errors returned by ChainListReply_Token.ValidateAll() if the designated
+// constraints aren't met.
+type ChainListReply_TokenMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminMonthRecommendRequestMultiError) Error() string {
+func (m ChainListReply_TokenMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4282,11 +11088,11 @@ func (m AdminMonthRecommendRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminMonthRecommendRequestMultiError) AllErrors() []error { return m }
+func (m ChainListReply_TokenMultiError) AllErrors() []error { return m }
 
-// AdminMonthRecommendRequestValidationError is the validation error returned
-// by AdminMonthRecommendRequest.Validate if the designated constraints aren't met.
-type AdminMonthRecommendRequestValidationError struct {
+// ChainListReply_TokenValidationError is the validation error returned by
+// ChainListReply_Token.Validate if the designated constraints aren't met.
+type ChainListReply_TokenValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4294,24 +11100,24 @@ type AdminMonthRecommendRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminMonthRecommendRequestValidationError) Field() string { return e.field }
+func (e ChainListReply_TokenValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminMonthRecommendRequestValidationError) Reason() string { return e.reason }
+func (e ChainListReply_TokenValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminMonthRecommendRequestValidationError) Cause() error { return e.cause }
+func (e ChainListReply_TokenValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminMonthRecommendRequestValidationError) Key() bool { return e.key }
+func (e ChainListReply_TokenValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminMonthRecommendRequestValidationError) ErrorName() string {
-	return "AdminMonthRecommendRequestValidationError"
+func (e ChainListReply_TokenValidationError) ErrorName() string {
+	return "ChainListReply_TokenValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminMonthRecommendRequestValidationError) Error() string {
+func (e ChainListReply_TokenValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4323,14 +11129,14 @@ func (e AdminMonthRecommendRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminMonthRecommendRequest.%s: %s%s",
+		"invalid %sChainListReply_Token.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminMonthRecommendRequestValidationError{}
+var _ error = ChainListReply_TokenValidationError{}
 
 var _ interface {
 	Field() string
@@ -4338,47 +11144,51 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminMonthRecommendRequestValidationError{}
+} = ChainListReply_TokenValidationError{}
 
-// Validate checks the field values on AdminMonthRecommendReply with the rules
+// Validate checks the field values on ChainListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminMonthRecommendReply) Validate() error {
+func (m *ChainListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminMonthRecommendReply with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ChainListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminMonthRecommendReplyMultiError, or nil if none found.
-func (m *AdminMonthRecommendReply) ValidateAll() error {
+// ChainListReply_ListMultiError, or nil if none found.
+func (m *ChainListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminMonthRecommendReply) validate(all bool) error {
+func (m *ChainListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
+	// no validation rules for ChainId
+
+	// no validation rules for Name
+
+	for idx, item := range m.GetTokens() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminMonthRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
+					errors = append(errors, ChainListReply_ListValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminMonthRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
+					errors = append(errors, ChainListReply_ListValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4386,8 +11196,8 @@ func (m *AdminMonthRecommendReply) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AdminMonthRecommendReplyValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
+				return ChainListReply_ListValidationError{
+					field:  fmt.Sprintf("Tokens[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -4396,22 +11206,22 @@ func (m *AdminMonthRecommendReply) validate(all bool) error {
 
 	}
 
-	// no validation rules for Count
+	// no validation rules for Confirmations
 
 	if len(errors) > 0 {
-		return AdminMonthRecommendReplyMultiError(errors)
+		return ChainListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminMonthRecommendReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminMonthRecommendReply.ValidateAll() if the designated
+// ChainListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by ChainListReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type AdminMonthRecommendReplyMultiError []error
+type ChainListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminMonthRecommendReplyMultiError) Error() string {
+func (m ChainListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4420,11 +11230,11 @@ func (m AdminMonthRecommendReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminMonthRecommendReplyMultiError) AllErrors() []error { return m }
+func (m ChainListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminMonthRecommendReplyValidationError is the validation error returned by
-// AdminMonthRecommendReply.Validate if the designated constraints aren't met.
-type AdminMonthRecommendReplyValidationError struct {
+// ChainListReply_ListValidationError is the validation error returned by
+// ChainListReply_List.Validate if the designated constraints aren't met.
+type ChainListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4432,24 +11242,24 @@ type AdminMonthRecommendReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminMonthRecommendReplyValidationError) Field() string { return e.field }
+func (e ChainListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminMonthRecommendReplyValidationError) Reason() string { return e.reason }
+func (e ChainListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminMonthRecommendReplyValidationError) Cause() error { return e.cause }
+func (e ChainListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminMonthRecommendReplyValidationError) Key() bool { return e.key }
+func (e ChainListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminMonthRecommendReplyValidationError) ErrorName() string {
-	return "AdminMonthRecommendReplyValidationError"
+func (e ChainListReply_ListValidationError) ErrorName() string {
+	return "ChainListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminMonthRecommendReplyValidationError) Error() string {
+func (e ChainListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4461,14 +11271,14 @@ func (e AdminMonthRecommendReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminMonthRecommendReply.%s: %s%s",
+		"invalid %sChainListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminMonthRecommendReplyValidationError{}
+var _ error = ChainListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -4476,48 +11286,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminMonthRecommendReplyValidationError{}
+} = ChainListReply_ListValidationError{}
 
-// Validate checks the field values on AdminConfigRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SubmitDepositTxRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigRequest) Validate() error {
+func (m *SubmitDepositTxRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminConfigRequestMultiError, or nil if none found.
-func (m *AdminConfigRequest) ValidateAll() error {
+// ValidateAll checks the field values on SubmitDepositTxRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SubmitDepositTxRequest_SendBodyMultiError, or nil if none found.
+func (m *SubmitDepositTxRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigRequest) validate(all bool) error {
+func (m *SubmitDepositTxRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	// no validation rules for Hash
 
-	// no validation rules for Page
+	// no validation rules for ChainId
 
 	if len(errors) > 0 {
-		return AdminConfigRequestMultiError(errors)
+		return SubmitDepositTxRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminConfigRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AdminConfigRequestMultiError []error
+// SubmitDepositTxRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by SubmitDepositTxRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type SubmitDepositTxRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigRequestMultiError) Error() string {
+func (m SubmitDepositTxRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4526,11 +11336,12 @@ func (m AdminConfigRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigRequestMultiError) AllErrors() []error { return m }
+func (m SubmitDepositTxRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminConfigRequestValidationError is the validation error returned by
-// AdminConfigRequest.Validate if the designated constraints aren't met.
-type AdminConfigRequestValidationError struct {
+// SubmitDepositTxRequest_SendBodyValidationError is the validation error
+// returned by SubmitDepositTxRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type SubmitDepositTxRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4538,24 +11349,24 @@ type AdminConfigRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigRequestValidationError) Field() string { return e.field }
+func (e SubmitDepositTxRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigRequestValidationError) Reason() string { return e.reason }
+func (e SubmitDepositTxRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigRequestValidationError) Cause() error { return e.cause }
+func (e SubmitDepositTxRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigRequestValidationError) Key() bool { return e.key }
+func (e SubmitDepositTxRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigRequestValidationError) ErrorName() string {
-	return "AdminConfigRequestValidationError"
+func (e SubmitDepositTxRequest_SendBodyValidationError) ErrorName() string {
+	return "SubmitDepositTxRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigRequestValidationError) Error() string {
+func (e SubmitDepositTxRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4567,14 +11378,14 @@ func (e AdminConfigRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigRequest.%s: %s%s",
+		"invalid %sSubmitDepositTxRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigRequestValidationError{}
+var _ error = SubmitDepositTxRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -4582,47 +11393,166 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigRequestValidationError{}
+} = SubmitDepositTxRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminConfigReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigReply) Validate() error {
+// Validate checks the field values on DepositWebhookRequest_Transfer with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DepositWebhookRequest_Transfer) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminConfigReplyMultiError, or nil if none found.
-func (m *AdminConfigReply) ValidateAll() error {
+// ValidateAll checks the field values on DepositWebhookRequest_Transfer with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DepositWebhookRequest_TransferMultiError, or nil if none found.
+func (m *DepositWebhookRequest_Transfer) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigReply) validate(all bool) error {
+func (m *DepositWebhookRequest_Transfer) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetConfig() {
+	// no validation rules for Hash
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	// no validation rules for Value
+
+	// no validation rules for Contract
+
+	// no validation rules for BlockNumber
+
+	// no validation rules for TxIndex
+
+	// no validation rules for LogIndex
+
+	if len(errors) > 0 {
+		return DepositWebhookRequest_TransferMultiError(errors)
+	}
+
+	return nil
+}
+
+// DepositWebhookRequest_TransferMultiError is an error wrapping multiple
+// validation errors returned by DepositWebhookRequest_Transfer.ValidateAll()
+// if the designated constraints aren't met.
+type DepositWebhookRequest_TransferMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DepositWebhookRequest_TransferMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DepositWebhookRequest_TransferMultiError) AllErrors() []error { return m }
+
+// DepositWebhookRequest_TransferValidationError is the validation error
+// returned by DepositWebhookRequest_Transfer.Validate if the designated
+// constraints aren't met.
+type DepositWebhookRequest_TransferValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DepositWebhookRequest_TransferValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DepositWebhookRequest_TransferValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DepositWebhookRequest_TransferValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DepositWebhookRequest_TransferValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DepositWebhookRequest_TransferValidationError) ErrorName() string {
+	return "DepositWebhookRequest_TransferValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DepositWebhookRequest_TransferValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDepositWebhookRequest_Transfer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DepositWebhookRequest_TransferValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DepositWebhookRequest_TransferValidationError{}
+
+// Validate checks the field values on DepositWebhookRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DepositWebhookRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DepositWebhookRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DepositWebhookRequest_SendBodyMultiError, or nil if none found.
+func (m *DepositWebhookRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DepositWebhookRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTransfers() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminConfigReplyValidationError{
-						field:  fmt.Sprintf("Config[%v]", idx),
+					errors = append(errors, DepositWebhookRequest_SendBodyValidationError{
+						field:  fmt.Sprintf("Transfers[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminConfigReplyValidationError{
-						field:  fmt.Sprintf("Config[%v]", idx),
+					errors = append(errors, DepositWebhookRequest_SendBodyValidationError{
+						field:  fmt.Sprintf("Transfers[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4630,8 +11560,8 @@ func (m *AdminConfigReply) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AdminConfigReplyValidationError{
-					field:  fmt.Sprintf("Config[%v]", idx),
+				return DepositWebhookRequest_SendBodyValidationError{
+					field:  fmt.Sprintf("Transfers[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -4640,22 +11570,22 @@ func (m *AdminConfigReply) validate(all bool) error {
 
 	}
 
-	// no validation rules for Count
+	// no validation rules for ChainId
 
 	if len(errors) > 0 {
-		return AdminConfigReplyMultiError(errors)
+		return DepositWebhookRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminConfigReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminConfigReplyMultiError []error
+// DepositWebhookRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by DepositWebhookRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type DepositWebhookRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigReplyMultiError) Error() string {
+func (m DepositWebhookRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4664,11 +11594,12 @@ func (m AdminConfigReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigReplyMultiError) AllErrors() []error { return m }
+func (m DepositWebhookRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminConfigReplyValidationError is the validation error returned by
-// AdminConfigReply.Validate if the designated constraints aren't met.
-type AdminConfigReplyValidationError struct {
+// DepositWebhookRequest_SendBodyValidationError is the validation error
+// returned by DepositWebhookRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type DepositWebhookRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4676,22 +11607,24 @@ type AdminConfigReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigReplyValidationError) Field() string { return e.field }
+func (e DepositWebhookRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigReplyValidationError) Reason() string { return e.reason }
+func (e DepositWebhookRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigReplyValidationError) Cause() error { return e.cause }
+func (e DepositWebhookRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigReplyValidationError) Key() bool { return e.key }
+func (e DepositWebhookRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigReplyValidationError) ErrorName() string { return "AdminConfigReplyValidationError" }
+func (e DepositWebhookRequest_SendBodyValidationError) ErrorName() string {
+	return "DepositWebhookRequest_SendBodyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminConfigReplyValidationError) Error() string {
+func (e DepositWebhookRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4703,14 +11636,14 @@ func (e AdminConfigReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigReply.%s: %s%s",
+		"invalid %sDepositWebhookRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigReplyValidationError{}
+var _ error = DepositWebhookRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -4718,73 +11651,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigReplyValidationError{}
+} = DepositWebhookRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminConfigUpdateRequest with the rules
+// Validate checks the field values on WithdrawRequest_SendBody with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigUpdateRequest) Validate() error {
+func (m *WithdrawRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigUpdateRequest with the
+// ValidateAll checks the field values on WithdrawRequest_SendBody with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminConfigUpdateRequestMultiError, or nil if none found.
-func (m *AdminConfigUpdateRequest) ValidateAll() error {
+// WithdrawRequest_SendBodyMultiError, or nil if none found.
+func (m *WithdrawRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigUpdateRequest) validate(all bool) error {
+func (m *WithdrawRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminConfigUpdateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminConfigUpdateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AdminConfigUpdateRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Type
+
+	// no validation rules for Amount
+
+	// no validation rules for ChainId
 
 	if len(errors) > 0 {
-		return AdminConfigUpdateRequestMultiError(errors)
+		return WithdrawRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigUpdateRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminConfigUpdateRequest.ValidateAll() if the designated
+// WithdrawRequest_SendBodyMultiError is an error wrapping multiple validation
+// errors returned by WithdrawRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type AdminConfigUpdateRequestMultiError []error
+type WithdrawRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigUpdateRequestMultiError) Error() string {
+func (m WithdrawRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4793,11 +11703,11 @@ func (m AdminConfigUpdateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigUpdateRequestMultiError) AllErrors() []error { return m }
+func (m WithdrawRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminConfigUpdateRequestValidationError is the validation error returned by
-// AdminConfigUpdateRequest.Validate if the designated constraints aren't met.
-type AdminConfigUpdateRequestValidationError struct {
+// WithdrawRequest_SendBodyValidationError is the validation error returned by
+// WithdrawRequest_SendBody.Validate if the designated constraints aren't met.
+type WithdrawRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4805,24 +11715,24 @@ type AdminConfigUpdateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigUpdateRequestValidationError) Field() string { return e.field }
+func (e WithdrawRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigUpdateRequestValidationError) Reason() string { return e.reason }
+func (e WithdrawRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigUpdateRequestValidationError) Cause() error { return e.cause }
+func (e WithdrawRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigUpdateRequestValidationError) Key() bool { return e.key }
+func (e WithdrawRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigUpdateRequestValidationError) ErrorName() string {
-	return "AdminConfigUpdateRequestValidationError"
+func (e WithdrawRequest_SendBodyValidationError) ErrorName() string {
+	return "WithdrawRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigUpdateRequestValidationError) Error() string {
+func (e WithdrawRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4834,14 +11744,14 @@ func (e AdminConfigUpdateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigUpdateRequest.%s: %s%s",
+		"invalid %sWithdrawRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigUpdateRequestValidationError{}
+var _ error = WithdrawRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -4849,44 +11759,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigUpdateRequestValidationError{}
+} = WithdrawRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminConfigUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on WithdrawCancelRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigUpdateReply) Validate() error {
+func (m *WithdrawCancelRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminConfigUpdateReplyMultiError, or nil if none found.
-func (m *AdminConfigUpdateReply) ValidateAll() error {
+// ValidateAll checks the field values on WithdrawCancelRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// WithdrawCancelRequest_SendBodyMultiError, or nil if none found.
+func (m *WithdrawCancelRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigUpdateReply) validate(all bool) error {
+func (m *WithdrawCancelRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	if len(errors) > 0 {
-		return AdminConfigUpdateReplyMultiError(errors)
+		return WithdrawCancelRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigUpdateReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminConfigUpdateReply.ValidateAll() if the designated
-// constraints aren't met.
-type AdminConfigUpdateReplyMultiError []error
+// WithdrawCancelRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by WithdrawCancelRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type WithdrawCancelRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigUpdateReplyMultiError) Error() string {
+func (m WithdrawCancelRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4895,11 +11807,12 @@ func (m AdminConfigUpdateReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigUpdateReplyMultiError) AllErrors() []error { return m }
+func (m WithdrawCancelRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminConfigUpdateReplyValidationError is the validation error returned by
-// AdminConfigUpdateReply.Validate if the designated constraints aren't met.
-type AdminConfigUpdateReplyValidationError struct {
+// WithdrawCancelRequest_SendBodyValidationError is the validation error
+// returned by WithdrawCancelRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type WithdrawCancelRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4907,24 +11820,24 @@ type AdminConfigUpdateReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigUpdateReplyValidationError) Field() string { return e.field }
+func (e WithdrawCancelRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigUpdateReplyValidationError) Reason() string { return e.reason }
+func (e WithdrawCancelRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigUpdateReplyValidationError) Cause() error { return e.cause }
+func (e WithdrawCancelRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigUpdateReplyValidationError) Key() bool { return e.key }
+func (e WithdrawCancelRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigUpdateReplyValidationError) ErrorName() string {
-	return "AdminConfigUpdateReplyValidationError"
+func (e WithdrawCancelRequest_SendBodyValidationError) ErrorName() string {
+	return "WithdrawCancelRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigUpdateReplyValidationError) Error() string {
+func (e WithdrawCancelRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4936,14 +11849,14 @@ func (e AdminConfigUpdateReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigUpdateReply.%s: %s%s",
+		"invalid %sWithdrawCancelRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigUpdateReplyValidationError{}
+var _ error = WithdrawCancelRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -4951,48 +11864,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigUpdateReplyValidationError{}
+} = WithdrawCancelRequest_SendBodyValidationError{}
 
-// Validate checks the field values on EthAuthorizeRequest_SendBody with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminRewardListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *EthAuthorizeRequest_SendBody) Validate() error {
+func (m *AdminRewardListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EthAuthorizeRequest_SendBody with the
+// ValidateAll checks the field values on AdminRewardListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// EthAuthorizeRequest_SendBodyMultiError, or nil if none found.
-func (m *EthAuthorizeRequest_SendBody) ValidateAll() error {
+// AdminRewardListReply_ListMultiError, or nil if none found.
+func (m *AdminRewardListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EthAuthorizeRequest_SendBody) validate(all bool) error {
+func (m *AdminRewardListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for Type
+
 	// no validation rules for Address
 
-	// no validation rules for Code
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return EthAuthorizeRequest_SendBodyMultiError(errors)
+		return AdminRewardListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// EthAuthorizeRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by EthAuthorizeRequest_SendBody.ValidateAll() if
-// the designated constraints aren't met.
-type EthAuthorizeRequest_SendBodyMultiError []error
+// AdminRewardListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by AdminRewardListReply_List.ValidateAll() if the
+// designated constraints aren't met.
+type AdminRewardListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EthAuthorizeRequest_SendBodyMultiError) Error() string {
+func (m AdminRewardListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5001,12 +11920,11 @@ func (m EthAuthorizeRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EthAuthorizeRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminRewardListReply_ListMultiError) AllErrors() []error { return m }
 
-// EthAuthorizeRequest_SendBodyValidationError is the validation error returned
-// by EthAuthorizeRequest_SendBody.Validate if the designated constraints
-// aren't met.
-type EthAuthorizeRequest_SendBodyValidationError struct {
+// AdminRewardListReply_ListValidationError is the validation error returned by
+// AdminRewardListReply_List.Validate if the designated constraints aren't met.
+type AdminRewardListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5014,24 +11932,24 @@ type EthAuthorizeRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminRewardListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminRewardListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminRewardListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminRewardListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EthAuthorizeRequest_SendBodyValidationError) ErrorName() string {
-	return "EthAuthorizeRequest_SendBodyValidationError"
+func (e AdminRewardListReply_ListValidationError) ErrorName() string {
+	return "AdminRewardListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EthAuthorizeRequest_SendBodyValidationError) Error() string {
+func (e AdminRewardListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5043,14 +11961,14 @@ func (e EthAuthorizeRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEthAuthorizeRequest_SendBody.%s: %s%s",
+		"invalid %sAdminRewardListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EthAuthorizeRequest_SendBodyValidationError{}
+var _ error = AdminRewardListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -5058,50 +11976,60 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EthAuthorizeRequest_SendBodyValidationError{}
+} = AdminRewardListReply_ListValidationError{}
 
-// Validate checks the field values on UserInfoReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminUserListReply_UserList with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfoReply_List) Validate() error {
+func (m *AdminUserListReply_UserList) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfoReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminUserListReply_UserList with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UserInfoReply_ListMultiError, or nil if none found.
-func (m *UserInfoReply_List) ValidateAll() error {
+// AdminUserListReply_UserListMultiError, or nil if none found.
+func (m *AdminUserListReply_UserList) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfoReply_List) validate(all bool) error {
+func (m *AdminUserListReply_UserList) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Account
+	// no validation rules for UserId
 
-	// no validation rules for RecommendReward
+	// no validation rules for CreatedAt
 
-	// no validation rules for Reward
+	// no validation rules for Address
+
+	// no validation rules for BalanceUsdt
+
+	// no validation rules for BalanceDhb
+
+	// no validation rules for Vip
+
+	// no validation rules for MonthRecommend
+
+	// no validation rules for HistoryRecommend
 
 	if len(errors) > 0 {
-		return UserInfoReply_ListMultiError(errors)
+		return AdminUserListReply_UserListMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfoReply_ListMultiError is an error wrapping multiple validation errors
-// returned by UserInfoReply_List.ValidateAll() if the designated constraints
-// aren't met.
-type UserInfoReply_ListMultiError []error
+// AdminUserListReply_UserListMultiError is an error wrapping multiple
+// validation errors returned by AdminUserListReply_UserList.ValidateAll() if
+// the designated constraints aren't met.
+type AdminUserListReply_UserListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfoReply_ListMultiError) Error() string {
+func (m AdminUserListReply_UserListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5110,11 +12038,12 @@ func (m UserInfoReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfoReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminUserListReply_UserListMultiError) AllErrors() []error { return m }
 
-// UserInfoReply_ListValidationError is the validation error returned by
-// UserInfoReply_List.Validate if the designated constraints aren't met.
-type UserInfoReply_ListValidationError struct {
+// AdminUserListReply_UserListValidationError is the validation error returned
+// by AdminUserListReply_UserList.Validate if the designated constraints
+// aren't met.
+type AdminUserListReply_UserListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5122,24 +12051,24 @@ type UserInfoReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfoReply_ListValidationError) Field() string { return e.field }
+func (e AdminUserListReply_UserListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfoReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminUserListReply_UserListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfoReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminUserListReply_UserListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfoReply_ListValidationError) Key() bool { return e.key }
+func (e AdminUserListReply_UserListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfoReply_ListValidationError) ErrorName() string {
-	return "UserInfoReply_ListValidationError"
+func (e AdminUserListReply_UserListValidationError) ErrorName() string {
+	return "AdminUserListReply_UserListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfoReply_ListValidationError) Error() string {
+func (e AdminUserListReply_UserListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5151,14 +12080,14 @@ func (e UserInfoReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfoReply_List.%s: %s%s",
+		"invalid %sAdminUserListReply_UserList.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfoReply_ListValidationError{}
+var _ error = AdminUserListReply_UserListValidationError{}
 
 var _ interface {
 	Field() string
@@ -5166,24 +12095,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfoReply_ListValidationError{}
+} = AdminUserListReply_UserListValidationError{}
 
-// Validate checks the field values on RewardListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RewardListReply_List) Validate() error {
+// Validate checks the field values on AdminLocationListReply_LocationList with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminLocationListReply_LocationList) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RewardListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RewardListReply_ListMultiError, or nil if none found.
-func (m *RewardListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminLocationListReply_LocationList
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminLocationListReply_LocationListMultiError, or nil if none found.
+func (m *AdminLocationListReply_LocationList) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RewardListReply_List) validate(all bool) error {
+func (m *AdminLocationListReply_LocationList) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5192,26 +12122,35 @@ func (m *RewardListReply_List) validate(all bool) error {
 
 	// no validation rules for CreatedAt
 
-	// no validation rules for Amount
+	// no validation rules for Address
 
-	// no validation rules for LocationStatus
+	// no validation rules for Row
 
-	// no validation rules for Type
+	// no validation rules for Col
+
+	// no validation rules for Status
+
+	// no validation rules for CurrentLevel
+
+	// no validation rules for Current
+
+	// no validation rules for CurrentMax
 
 	if len(errors) > 0 {
-		return RewardListReply_ListMultiError(errors)
+		return AdminLocationListReply_LocationListMultiError(errors)
 	}
 
 	return nil
 }
 
-// RewardListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by RewardListReply_List.ValidateAll() if the designated
+// AdminLocationListReply_LocationListMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminLocationListReply_LocationList.ValidateAll() if the designated
 // constraints aren't met.
-type RewardListReply_ListMultiError []error
+type AdminLocationListReply_LocationListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RewardListReply_ListMultiError) Error() string {
+func (m AdminLocationListReply_LocationListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5220,11 +12159,12 @@ func (m RewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminLocationListReply_LocationListMultiError) AllErrors() []error { return m }
 
-// RewardListReply_ListValidationError is the validation error returned by
-// RewardListReply_List.Validate if the designated constraints aren't met.
-type RewardListReply_ListValidationError struct {
+// AdminLocationListReply_LocationListValidationError is the validation error
+// returned by AdminLocationListReply_LocationList.Validate if the designated
+// constraints aren't met.
+type AdminLocationListReply_LocationListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5232,24 +12172,24 @@ type RewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e RewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminLocationListReply_LocationListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminLocationListReply_LocationListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminLocationListReply_LocationListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminLocationListReply_LocationListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RewardListReply_ListValidationError) ErrorName() string {
-	return "RewardListReply_ListValidationError"
+func (e AdminLocationListReply_LocationListValidationError) ErrorName() string {
+	return "AdminLocationListReply_LocationListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RewardListReply_ListValidationError) Error() string {
+func (e AdminLocationListReply_LocationListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5261,14 +12201,14 @@ func (e RewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRewardListReply_List.%s: %s%s",
+		"invalid %sAdminLocationListReply_LocationList.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RewardListReply_ListValidationError{}
+var _ error = AdminLocationListReply_LocationListValidationError{}
 
 var _ interface {
 	Field() string
@@ -5276,52 +12216,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RewardListReply_ListValidationError{}
+} = AdminLocationListReply_LocationListValidationError{}
 
-// Validate checks the field values on RecommendRewardListReply_List with the
+// Validate checks the field values on AdminWithdrawListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendRewardListReply_List) Validate() error {
+func (m *AdminWithdrawListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendRewardListReply_List with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RecommendRewardListReply_ListMultiError, or nil if none found.
-func (m *RecommendRewardListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminWithdrawListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawListReply_ListMultiError, or nil if none found.
+func (m *AdminWithdrawListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendRewardListReply_List) validate(all bool) error {
+func (m *AdminWithdrawListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Address
+
+	// no validation rules for Id
+
 	// no validation rules for CreatedAt
 
 	// no validation rules for Amount
 
+	// no validation rules for RelAmount
+
 	// no validation rules for Type
 
-	// no validation rules for Reason
+	// no validation rules for Status
+
+	// no validation rules for ChainId
+
+	// no validation rules for TxHash
+
+	// no validation rules for GasUsed
+
+	// no validation rules for BlockNumber
 
 	if len(errors) > 0 {
-		return RecommendRewardListReply_ListMultiError(errors)
+		return AdminWithdrawListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendRewardListReply_ListMultiError is an error wrapping multiple
-// validation errors returned by RecommendRewardListReply_List.ValidateAll()
-// if the designated constraints aren't met.
-type RecommendRewardListReply_ListMultiError []error
+// AdminWithdrawListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawListReply_List.ValidateAll() if
+// the designated constraints aren't met.
+type AdminWithdrawListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendRewardListReply_ListMultiError) Error() string {
+func (m AdminWithdrawListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5330,12 +12284,12 @@ func (m RecommendRewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendRewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawListReply_ListMultiError) AllErrors() []error { return m }
 
-// RecommendRewardListReply_ListValidationError is the validation error
-// returned by RecommendRewardListReply_List.Validate if the designated
-// constraints aren't met.
-type RecommendRewardListReply_ListValidationError struct {
+// AdminWithdrawListReply_ListValidationError is the validation error returned
+// by AdminWithdrawListReply_List.Validate if the designated constraints
+// aren't met.
+type AdminWithdrawListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5343,24 +12297,24 @@ type RecommendRewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendRewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminWithdrawListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendRewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendRewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendRewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminWithdrawListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendRewardListReply_ListValidationError) ErrorName() string {
-	return "RecommendRewardListReply_ListValidationError"
+func (e AdminWithdrawListReply_ListValidationError) ErrorName() string {
+	return "AdminWithdrawListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendRewardListReply_ListValidationError) Error() string {
+func (e AdminWithdrawListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5372,14 +12326,14 @@ func (e RecommendRewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendRewardListReply_List.%s: %s%s",
+		"invalid %sAdminWithdrawListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendRewardListReply_ListValidationError{}
+var _ error = AdminWithdrawListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -5387,48 +12341,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendRewardListReply_ListValidationError{}
+} = AdminWithdrawListReply_ListValidationError{}
 
-// Validate checks the field values on FeeRewardListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *FeeRewardListReply_List) Validate() error {
+// Validate checks the field values on AdminWithdrawUpdateRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminWithdrawUpdateRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FeeRewardListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// FeeRewardListReply_ListMultiError, or nil if none found.
-func (m *FeeRewardListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminWithdrawUpdateRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawUpdateRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminWithdrawUpdateRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FeeRewardListReply_List) validate(all bool) error {
+func (m *AdminWithdrawUpdateRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for Id
 
-	// no validation rules for Amount
+	// no validation rules for Action
+
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return FeeRewardListReply_ListMultiError(errors)
+		return AdminWithdrawUpdateRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// FeeRewardListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by FeeRewardListReply_List.ValidateAll() if the designated
+// AdminWithdrawUpdateRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminWithdrawUpdateRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type FeeRewardListReply_ListMultiError []error
+type AdminWithdrawUpdateRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FeeRewardListReply_ListMultiError) Error() string {
+func (m AdminWithdrawUpdateRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5437,11 +12395,12 @@ func (m FeeRewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FeeRewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// FeeRewardListReply_ListValidationError is the validation error returned by
-// FeeRewardListReply_List.Validate if the designated constraints aren't met.
-type FeeRewardListReply_ListValidationError struct {
+// AdminWithdrawUpdateRequest_SendBodyValidationError is the validation error
+// returned by AdminWithdrawUpdateRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawUpdateRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5449,24 +12408,24 @@ type FeeRewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e FeeRewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminWithdrawUpdateRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FeeRewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FeeRewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FeeRewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminWithdrawUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FeeRewardListReply_ListValidationError) ErrorName() string {
-	return "FeeRewardListReply_ListValidationError"
+func (e AdminWithdrawUpdateRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminWithdrawUpdateRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e FeeRewardListReply_ListValidationError) Error() string {
+func (e AdminWithdrawUpdateRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5478,14 +12437,14 @@ func (e FeeRewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFeeRewardListReply_List.%s: %s%s",
+		"invalid %sAdminWithdrawUpdateRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FeeRewardListReply_ListValidationError{}
+var _ error = AdminWithdrawUpdateRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -5493,52 +12452,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FeeRewardListReply_ListValidationError{}
+} = AdminWithdrawUpdateRequest_SendBodyValidationError{}
 
-// Validate checks the field values on WithdrawListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawListReply_List) Validate() error {
+// Validate checks the field values on AdminWithdrawEventListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminWithdrawEventListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// WithdrawListReply_ListMultiError, or nil if none found.
-func (m *WithdrawListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminWithdrawEventListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawEventListReply_ListMultiError, or nil if none found.
+func (m *AdminWithdrawEventListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawListReply_List) validate(all bool) error {
+func (m *AdminWithdrawEventListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for Id
 
-	// no validation rules for Amount
+	// no validation rules for FromStatus
 
-	// no validation rules for Type
+	// no validation rules for ToStatus
 
-	// no validation rules for Status
+	// no validation rules for Actor
+
+	// no validation rules for Reason
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return WithdrawListReply_ListMultiError(errors)
+		return AdminWithdrawEventListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by WithdrawListReply_List.ValidateAll() if the designated
+// AdminWithdrawEventListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminWithdrawEventListReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type WithdrawListReply_ListMultiError []error
+type AdminWithdrawEventListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawListReply_ListMultiError) Error() string {
+func (m AdminWithdrawEventListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5547,11 +12512,12 @@ func (m WithdrawListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawEventListReply_ListMultiError) AllErrors() []error { return m }
 
-// WithdrawListReply_ListValidationError is the validation error returned by
-// WithdrawListReply_List.Validate if the designated constraints aren't met.
-type WithdrawListReply_ListValidationError struct {
+// AdminWithdrawEventListReply_ListValidationError is the validation error
+// returned by AdminWithdrawEventListReply_List.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawEventListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5559,24 +12525,24 @@ type WithdrawListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawListReply_ListValidationError) Field() string { return e.field }
+func (e AdminWithdrawEventListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawEventListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawEventListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminWithdrawEventListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawListReply_ListValidationError) ErrorName() string {
-	return "WithdrawListReply_ListValidationError"
+func (e AdminWithdrawEventListReply_ListValidationError) ErrorName() string {
+	return "AdminWithdrawEventListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WithdrawListReply_ListValidationError) Error() string {
+func (e AdminWithdrawEventListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5588,14 +12554,14 @@ func (e WithdrawListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawListReply_List.%s: %s%s",
+		"invalid %sAdminWithdrawEventListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawListReply_ListValidationError{}
+var _ error = AdminWithdrawEventListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -5603,48 +12569,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawListReply_ListValidationError{}
+} = AdminWithdrawEventListReply_ListValidationError{}
 
-// Validate checks the field values on RecommendListReply_List with the rules
+// Validate checks the field values on AdminJobRunListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendListReply_List) Validate() error {
+func (m *AdminJobRunListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendListReply_List with the
+// ValidateAll checks the field values on AdminJobRunListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RecommendListReply_ListMultiError, or nil if none found.
-func (m *RecommendListReply_List) ValidateAll() error {
+// AdminJobRunListReply_ListMultiError, or nil if none found.
+func (m *AdminJobRunListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendListReply_List) validate(all bool) error {
+func (m *AdminJobRunListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Amount
+	// no validation rules for Id
+
+	// no validation rules for Name
 
 	// no validation rules for Status
 
+	// no validation rules for StartedAt
+
+	// no validation rules for EndedAt
+
+	// no validation rules for Items
+
+	// no validation rules for Error
+
 	if len(errors) > 0 {
-		return RecommendListReply_ListMultiError(errors)
+		return AdminJobRunListReply_ListMultiError(errors)
 	}
 
-	return nil
-}
-
-// RecommendListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by RecommendListReply_List.ValidateAll() if the designated
-// constraints aren't met.
-type RecommendListReply_ListMultiError []error
+	return nil
+}
+
+// AdminJobRunListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by AdminJobRunListReply_List.ValidateAll() if the
+// designated constraints aren't met.
+type AdminJobRunListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendListReply_ListMultiError) Error() string {
+func (m AdminJobRunListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5653,11 +12629,11 @@ func (m RecommendListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminJobRunListReply_ListMultiError) AllErrors() []error { return m }
 
-// RecommendListReply_ListValidationError is the validation error returned by
-// RecommendListReply_List.Validate if the designated constraints aren't met.
-type RecommendListReply_ListValidationError struct {
+// AdminJobRunListReply_ListValidationError is the validation error returned by
+// AdminJobRunListReply_List.Validate if the designated constraints aren't met.
+type AdminJobRunListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5665,24 +12641,24 @@ type RecommendListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendListReply_ListValidationError) Field() string { return e.field }
+func (e AdminJobRunListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminJobRunListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminJobRunListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminJobRunListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendListReply_ListValidationError) ErrorName() string {
-	return "RecommendListReply_ListValidationError"
+func (e AdminJobRunListReply_ListValidationError) ErrorName() string {
+	return "AdminJobRunListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendListReply_ListValidationError) Error() string {
+func (e AdminJobRunListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5694,14 +12670,14 @@ func (e RecommendListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendListReply_List.%s: %s%s",
+		"invalid %sAdminJobRunListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendListReply_ListValidationError{}
+var _ error = AdminJobRunListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -5709,48 +12685,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendListReply_ListValidationError{}
+} = AdminJobRunListReply_ListValidationError{}
 
-// Validate checks the field values on WithdrawRequest_SendBody with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminDepositReorgListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawRequest_SendBody) Validate() error {
+func (m *AdminDepositReorgListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawRequest_SendBody with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// WithdrawRequest_SendBodyMultiError, or nil if none found.
-func (m *WithdrawRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminDepositReorgListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminDepositReorgListReply_ListMultiError, or nil if none found.
+func (m *AdminDepositReorgListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawRequest_SendBody) validate(all bool) error {
+func (m *AdminDepositReorgListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Type
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for Hash
 
 	// no validation rules for Amount
 
+	// no validation rules for BlockNumber
+
+	// no validation rules for LocationId
+
+	// no validation rules for CreatedAt
+
 	if len(errors) > 0 {
-		return WithdrawRequest_SendBodyMultiError(errors)
+		return AdminDepositReorgListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawRequest_SendBodyMultiError is an error wrapping multiple validation
-// errors returned by WithdrawRequest_SendBody.ValidateAll() if the designated
-// constraints aren't met.
-type WithdrawRequest_SendBodyMultiError []error
+// AdminDepositReorgListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminDepositReorgListReply_List.ValidateAll()
+// if the designated constraints aren't met.
+type AdminDepositReorgListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawRequest_SendBodyMultiError) Error() string {
+func (m AdminDepositReorgListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5759,11 +12745,12 @@ func (m WithdrawRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminDepositReorgListReply_ListMultiError) AllErrors() []error { return m }
 
-// WithdrawRequest_SendBodyValidationError is the validation error returned by
-// WithdrawRequest_SendBody.Validate if the designated constraints aren't met.
-type WithdrawRequest_SendBodyValidationError struct {
+// AdminDepositReorgListReply_ListValidationError is the validation error
+// returned by AdminDepositReorgListReply_List.Validate if the designated
+// constraints aren't met.
+type AdminDepositReorgListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5771,24 +12758,24 @@ type WithdrawRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminDepositReorgListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminDepositReorgListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminDepositReorgListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminDepositReorgListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawRequest_SendBodyValidationError) ErrorName() string {
-	return "WithdrawRequest_SendBodyValidationError"
+func (e AdminDepositReorgListReply_ListValidationError) ErrorName() string {
+	return "AdminDepositReorgListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WithdrawRequest_SendBodyValidationError) Error() string {
+func (e AdminDepositReorgListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5800,14 +12787,14 @@ func (e WithdrawRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawRequest_SendBody.%s: %s%s",
+		"invalid %sAdminDepositReorgListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawRequest_SendBodyValidationError{}
+var _ error = AdminDepositReorgListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -5815,54 +12802,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawRequest_SendBodyValidationError{}
+} = AdminDepositReorgListReply_ListValidationError{}
 
-// Validate checks the field values on AdminRewardListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminRewardListReply_List) Validate() error {
+// Validate checks the field values on AdminDepositUnmatchedListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminDepositUnmatchedListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminRewardListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminRewardListReply_ListMultiError, or nil if none found.
-func (m *AdminRewardListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminDepositUnmatchedListReply_List
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminDepositUnmatchedListReply_ListMultiError, or nil if none found.
+func (m *AdminDepositUnmatchedListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminRewardListReply_List) validate(all bool) error {
+func (m *AdminDepositUnmatchedListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for Hash
 
 	// no validation rules for Amount
 
-	// no validation rules for Type
+	// no validation rules for BlockNumber
+
+	// no validation rules for Status
+
+	// no validation rules for CreatedAt
 
 	// no validation rules for Address
 
-	// no validation rules for Reason
+	// no validation rules for CoinType
 
 	if len(errors) > 0 {
-		return AdminRewardListReply_ListMultiError(errors)
+		return AdminDepositUnmatchedListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminRewardListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by AdminRewardListReply_List.ValidateAll() if the
-// designated constraints aren't met.
-type AdminRewardListReply_ListMultiError []error
+// AdminDepositUnmatchedListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminDepositUnmatchedListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type AdminDepositUnmatchedListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminRewardListReply_ListMultiError) Error() string {
+func (m AdminDepositUnmatchedListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5871,11 +12868,12 @@ func (m AdminRewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminRewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminDepositUnmatchedListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminRewardListReply_ListValidationError is the validation error returned by
-// AdminRewardListReply_List.Validate if the designated constraints aren't met.
-type AdminRewardListReply_ListValidationError struct {
+// AdminDepositUnmatchedListReply_ListValidationError is the validation error
+// returned by AdminDepositUnmatchedListReply_List.Validate if the designated
+// constraints aren't met.
+type AdminDepositUnmatchedListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5883,24 +12881,24 @@ type AdminRewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminRewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminDepositUnmatchedListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminRewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminDepositUnmatchedListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminRewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminDepositUnmatchedListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminRewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminDepositUnmatchedListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminRewardListReply_ListValidationError) ErrorName() string {
-	return "AdminRewardListReply_ListValidationError"
+func (e AdminDepositUnmatchedListReply_ListValidationError) ErrorName() string {
+	return "AdminDepositUnmatchedListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminRewardListReply_ListValidationError) Error() string {
+func (e AdminDepositUnmatchedListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5912,14 +12910,14 @@ func (e AdminRewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminRewardListReply_List.%s: %s%s",
+		"invalid %sAdminDepositUnmatchedListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminRewardListReply_ListValidationError{}
+var _ error = AdminDepositUnmatchedListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -5927,60 +12925,51 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminRewardListReply_ListValidationError{}
+} = AdminDepositUnmatchedListReply_ListValidationError{}
 
-// Validate checks the field values on AdminUserListReply_UserList with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserListReply_UserList) Validate() error {
+// Validate checks the field values on
+// AdminDepositUnmatchedHandleRequest_SendBody with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositUnmatchedHandleRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserListReply_UserList with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminUserListReply_UserListMultiError, or nil if none found.
-func (m *AdminUserListReply_UserList) ValidateAll() error {
+// ValidateAll checks the field values on
+// AdminDepositUnmatchedHandleRequest_SendBody with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AdminDepositUnmatchedHandleRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminDepositUnmatchedHandleRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserListReply_UserList) validate(all bool) error {
+func (m *AdminDepositUnmatchedHandleRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
-	// no validation rules for CreatedAt
-
-	// no validation rules for Address
-
-	// no validation rules for BalanceUsdt
-
-	// no validation rules for BalanceDhb
-
-	// no validation rules for Vip
-
-	// no validation rules for MonthRecommend
+	// no validation rules for Id
 
-	// no validation rules for HistoryRecommend
+	// no validation rules for Action
 
 	if len(errors) > 0 {
-		return AdminUserListReply_UserListMultiError(errors)
+		return AdminDepositUnmatchedHandleRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserListReply_UserListMultiError is an error wrapping multiple
-// validation errors returned by AdminUserListReply_UserList.ValidateAll() if
-// the designated constraints aren't met.
-type AdminUserListReply_UserListMultiError []error
+// AdminDepositUnmatchedHandleRequest_SendBodyMultiError is an error wrapping
+// multiple validation errors returned by
+// AdminDepositUnmatchedHandleRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminDepositUnmatchedHandleRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserListReply_UserListMultiError) Error() string {
+func (m AdminDepositUnmatchedHandleRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5989,12 +12978,12 @@ func (m AdminUserListReply_UserListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserListReply_UserListMultiError) AllErrors() []error { return m }
+func (m AdminDepositUnmatchedHandleRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminUserListReply_UserListValidationError is the validation error returned
-// by AdminUserListReply_UserList.Validate if the designated constraints
-// aren't met.
-type AdminUserListReply_UserListValidationError struct {
+// AdminDepositUnmatchedHandleRequest_SendBodyValidationError is the validation
+// error returned by AdminDepositUnmatchedHandleRequest_SendBody.Validate if
+// the designated constraints aren't met.
+type AdminDepositUnmatchedHandleRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6002,24 +12991,24 @@ type AdminUserListReply_UserListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserListReply_UserListValidationError) Field() string { return e.field }
+func (e AdminDepositUnmatchedHandleRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserListReply_UserListValidationError) Reason() string { return e.reason }
+func (e AdminDepositUnmatchedHandleRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserListReply_UserListValidationError) Cause() error { return e.cause }
+func (e AdminDepositUnmatchedHandleRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserListReply_UserListValidationError) Key() bool { return e.key }
+func (e AdminDepositUnmatchedHandleRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserListReply_UserListValidationError) ErrorName() string {
-	return "AdminUserListReply_UserListValidationError"
+func (e AdminDepositUnmatchedHandleRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminDepositUnmatchedHandleRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserListReply_UserListValidationError) Error() string {
+func (e AdminDepositUnmatchedHandleRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6031,14 +13020,14 @@ func (e AdminUserListReply_UserListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserListReply_UserList.%s: %s%s",
+		"invalid %sAdminDepositUnmatchedHandleRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserListReply_UserListValidationError{}
+var _ error = AdminDepositUnmatchedHandleRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -6046,62 +13035,60 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserListReply_UserListValidationError{}
+} = AdminDepositUnmatchedHandleRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminLocationListReply_LocationList with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AdminLocationListReply_LocationList) Validate() error {
+// Validate checks the field values on AdminDepositTierListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositTierListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationListReply_LocationList
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// AdminLocationListReply_LocationListMultiError, or nil if none found.
-func (m *AdminLocationListReply_LocationList) ValidateAll() error {
+// ValidateAll checks the field values on AdminDepositTierListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminDepositTierListReply_ListMultiError, or nil if none found.
+func (m *AdminDepositTierListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationListReply_LocationList) validate(all bool) error {
+func (m *AdminDepositTierListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for Id
 
-	// no validation rules for Address
+	// no validation rules for Token
 
-	// no validation rules for Row
+	// no validation rules for Amount
 
-	// no validation rules for Col
+	// no validation rules for Level
 
-	// no validation rules for Status
+	// no validation rules for Multiplier
 
-	// no validation rules for CurrentLevel
+	// no validation rules for Enabled
 
-	// no validation rules for Current
+	// no validation rules for PairToken
 
-	// no validation rules for CurrentMax
+	// no validation rules for PairAmount
 
 	if len(errors) > 0 {
-		return AdminLocationListReply_LocationListMultiError(errors)
+		return AdminDepositTierListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationListReply_LocationListMultiError is an error wrapping multiple
-// validation errors returned by
-// AdminLocationListReply_LocationList.ValidateAll() if the designated
-// constraints aren't met.
-type AdminLocationListReply_LocationListMultiError []error
+// AdminDepositTierListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminDepositTierListReply_List.ValidateAll()
+// if the designated constraints aren't met.
+type AdminDepositTierListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationListReply_LocationListMultiError) Error() string {
+func (m AdminDepositTierListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6110,12 +13097,12 @@ func (m AdminLocationListReply_LocationListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationListReply_LocationListMultiError) AllErrors() []error { return m }
+func (m AdminDepositTierListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminLocationListReply_LocationListValidationError is the validation error
-// returned by AdminLocationListReply_LocationList.Validate if the designated
+// AdminDepositTierListReply_ListValidationError is the validation error
+// returned by AdminDepositTierListReply_List.Validate if the designated
 // constraints aren't met.
-type AdminLocationListReply_LocationListValidationError struct {
+type AdminDepositTierListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6123,24 +13110,24 @@ type AdminLocationListReply_LocationListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationListReply_LocationListValidationError) Field() string { return e.field }
+func (e AdminDepositTierListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationListReply_LocationListValidationError) Reason() string { return e.reason }
+func (e AdminDepositTierListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationListReply_LocationListValidationError) Cause() error { return e.cause }
+func (e AdminDepositTierListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationListReply_LocationListValidationError) Key() bool { return e.key }
+func (e AdminDepositTierListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationListReply_LocationListValidationError) ErrorName() string {
-	return "AdminLocationListReply_LocationListValidationError"
+func (e AdminDepositTierListReply_ListValidationError) ErrorName() string {
+	return "AdminDepositTierListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationListReply_LocationListValidationError) Error() string {
+func (e AdminDepositTierListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6152,14 +13139,14 @@ func (e AdminLocationListReply_LocationListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationListReply_LocationList.%s: %s%s",
+		"invalid %sAdminDepositTierListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationListReply_LocationListValidationError{}
+var _ error = AdminDepositTierListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -6167,58 +13154,62 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationListReply_LocationListValidationError{}
+} = AdminDepositTierListReply_ListValidationError{}
 
-// Validate checks the field values on AdminWithdrawListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawListReply_List) Validate() error {
+// Validate checks the field values on AdminDepositTierSaveRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AdminDepositTierSaveRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminWithdrawListReply_ListMultiError, or nil if none found.
-func (m *AdminWithdrawListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminDepositTierSaveRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminDepositTierSaveRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminDepositTierSaveRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawListReply_List) validate(all bool) error {
+func (m *AdminDepositTierSaveRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
-
 	// no validation rules for Id
 
-	// no validation rules for CreatedAt
+	// no validation rules for Token
 
 	// no validation rules for Amount
 
-	// no validation rules for RelAmount
+	// no validation rules for Level
 
-	// no validation rules for Type
+	// no validation rules for Multiplier
 
-	// no validation rules for Status
+	// no validation rules for Enabled
+
+	// no validation rules for PairToken
+
+	// no validation rules for PairAmount
 
 	if len(errors) > 0 {
-		return AdminWithdrawListReply_ListMultiError(errors)
+		return AdminDepositTierSaveRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawListReply_ListMultiError is an error wrapping multiple
-// validation errors returned by AdminWithdrawListReply_List.ValidateAll() if
-// the designated constraints aren't met.
-type AdminWithdrawListReply_ListMultiError []error
+// AdminDepositTierSaveRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminDepositTierSaveRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminDepositTierSaveRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawListReply_ListMultiError) Error() string {
+func (m AdminDepositTierSaveRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6227,12 +13218,12 @@ func (m AdminWithdrawListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminDepositTierSaveRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawListReply_ListValidationError is the validation error returned
-// by AdminWithdrawListReply_List.Validate if the designated constraints
-// aren't met.
-type AdminWithdrawListReply_ListValidationError struct {
+// AdminDepositTierSaveRequest_SendBodyValidationError is the validation error
+// returned by AdminDepositTierSaveRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminDepositTierSaveRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6240,24 +13231,24 @@ type AdminWithdrawListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawListReply_ListValidationError) Field() string { return e.field }
+func (e AdminDepositTierSaveRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminDepositTierSaveRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminDepositTierSaveRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminDepositTierSaveRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawListReply_ListValidationError) ErrorName() string {
-	return "AdminWithdrawListReply_ListValidationError"
+func (e AdminDepositTierSaveRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminDepositTierSaveRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawListReply_ListValidationError) Error() string {
+func (e AdminDepositTierSaveRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6269,14 +13260,14 @@ func (e AdminWithdrawListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawListReply_List.%s: %s%s",
+		"invalid %sAdminDepositTierSaveRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawListReply_ListValidationError{}
+var _ error = AdminDepositTierSaveRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -6284,7 +13275,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawListReply_ListValidationError{}
+} = AdminDepositTierSaveRequest_SendBodyValidationError{}
 
 // Validate checks the field values on AdminUserRecommendReply_List with the
 // rules defined in the proto definition for this message. If any rules are
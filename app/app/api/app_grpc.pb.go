@@ -22,14 +22,25 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AppClient interface {
+	GetAuthNonce(ctx context.Context, in *GetAuthNonceRequest, opts ...grpc.CallOption) (*GetAuthNonceReply, error)
 	EthAuthorize(ctx context.Context, in *EthAuthorizeRequest, opts ...grpc.CallOption) (*EthAuthorizeReply, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenReply, error)
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutReply, error)
 	UserInfo(ctx context.Context, in *UserInfoRequest, opts ...grpc.CallOption) (*UserInfoReply, error)
 	RewardList(ctx context.Context, in *RewardListRequest, opts ...grpc.CallOption) (*RewardListReply, error)
 	RecommendRewardList(ctx context.Context, in *RecommendRewardListRequest, opts ...grpc.CallOption) (*RecommendRewardListReply, error)
 	FeeRewardList(ctx context.Context, in *FeeRewardListRequest, opts ...grpc.CallOption) (*FeeRewardListReply, error)
 	WithdrawList(ctx context.Context, in *WithdrawListRequest, opts ...grpc.CallOption) (*WithdrawListReply, error)
 	RecommendList(ctx context.Context, in *RecommendListRequest, opts ...grpc.CallOption) (*RecommendListReply, error)
+	DepositPendingList(ctx context.Context, in *DepositPendingListRequest, opts ...grpc.CallOption) (*DepositPendingListReply, error)
+	DepositUnmatchedList(ctx context.Context, in *DepositUnmatchedListRequest, opts ...grpc.CallOption) (*DepositUnmatchedListReply, error)
+	ChainList(ctx context.Context, in *ChainListRequest, opts ...grpc.CallOption) (*ChainListReply, error)
+	DepositAddress(ctx context.Context, in *DepositAddressRequest, opts ...grpc.CallOption) (*DepositAddressReply, error)
+	SubmitDepositTx(ctx context.Context, in *SubmitDepositTxRequest, opts ...grpc.CallOption) (*SubmitDepositTxReply, error)
+	DepositWebhook(ctx context.Context, in *DepositWebhookRequest, opts ...grpc.CallOption) (*DepositWebhookReply, error)
 	Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawReply, error)
+	WithdrawCancel(ctx context.Context, in *WithdrawCancelRequest, opts ...grpc.CallOption) (*WithdrawCancelReply, error)
+	AdminLogin(ctx context.Context, in *AdminLoginRequest, opts ...grpc.CallOption) (*AdminLoginReply, error)
 	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositReply, error)
 	//
 	//	rpc AdminRewardList (AdminRewardListRequest) returns (AdminRewardListReply) {
@@ -58,7 +69,17 @@ type AppClient interface {
 	//
 	AdminWithdraw(ctx context.Context, in *AdminWithdrawRequest, opts ...grpc.CallOption) (*AdminWithdrawReply, error)
 	AdminWithdrawEth(ctx context.Context, in *AdminWithdrawEthRequest, opts ...grpc.CallOption) (*AdminWithdrawEthReply, error)
+	AdminWithdrawReceipt(ctx context.Context, in *AdminWithdrawReceiptRequest, opts ...grpc.CallOption) (*AdminWithdrawReceiptReply, error)
+	AdminWithdrawUpdate(ctx context.Context, in *AdminWithdrawUpdateRequest, opts ...grpc.CallOption) (*AdminWithdrawUpdateReply, error)
+	AdminWithdrawEventList(ctx context.Context, in *AdminWithdrawEventListRequest, opts ...grpc.CallOption) (*AdminWithdrawEventListReply, error)
 	AdminFee(ctx context.Context, in *AdminFeeRequest, opts ...grpc.CallOption) (*AdminFeeReply, error)
+	AdminJobRunList(ctx context.Context, in *AdminJobRunListRequest, opts ...grpc.CallOption) (*AdminJobRunListReply, error)
+	AdminDepositReorgList(ctx context.Context, in *AdminDepositReorgListRequest, opts ...grpc.CallOption) (*AdminDepositReorgListReply, error)
+	AdminDepositUnmatchedList(ctx context.Context, in *AdminDepositUnmatchedListRequest, opts ...grpc.CallOption) (*AdminDepositUnmatchedListReply, error)
+	AdminDepositUnmatchedHandle(ctx context.Context, in *AdminDepositUnmatchedHandleRequest, opts ...grpc.CallOption) (*AdminDepositUnmatchedHandleReply, error)
+	AdminDepositTierList(ctx context.Context, in *AdminDepositTierListRequest, opts ...grpc.CallOption) (*AdminDepositTierListReply, error)
+	AdminDepositTierSave(ctx context.Context, in *AdminDepositTierSaveRequest, opts ...grpc.CallOption) (*AdminDepositTierSaveReply, error)
+	AdminDepositSweep(ctx context.Context, in *AdminDepositSweepRequest, opts ...grpc.CallOption) (*AdminDepositSweepReply, error)
 }
 
 type appClient struct {
@@ -69,6 +90,15 @@ func NewAppClient(cc grpc.ClientConnInterface) AppClient {
 	return &appClient{cc}
 }
 
+func (c *appClient) GetAuthNonce(ctx context.Context, in *GetAuthNonceRequest, opts ...grpc.CallOption) (*GetAuthNonceReply, error) {
+	out := new(GetAuthNonceReply)
+	err := c.cc.Invoke(ctx, "/api.App/GetAuthNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appClient) EthAuthorize(ctx context.Context, in *EthAuthorizeRequest, opts ...grpc.CallOption) (*EthAuthorizeReply, error) {
 	out := new(EthAuthorizeReply)
 	err := c.cc.Invoke(ctx, "/api.App/EthAuthorize", in, out, opts...)
@@ -78,6 +108,24 @@ func (c *appClient) EthAuthorize(ctx context.Context, in *EthAuthorizeRequest, o
 	return out, nil
 }
 
+func (c *appClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenReply, error) {
+	out := new(RefreshTokenReply)
+	err := c.cc.Invoke(ctx, "/api.App/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutReply, error) {
+	out := new(LogoutReply)
+	err := c.cc.Invoke(ctx, "/api.App/Logout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appClient) UserInfo(ctx context.Context, in *UserInfoRequest, opts ...grpc.CallOption) (*UserInfoReply, error) {
 	out := new(UserInfoReply)
 	err := c.cc.Invoke(ctx, "/api.App/UserInfo", in, out, opts...)
@@ -132,6 +180,60 @@ func (c *appClient) RecommendList(ctx context.Context, in *RecommendListRequest,
 	return out, nil
 }
 
+func (c *appClient) DepositPendingList(ctx context.Context, in *DepositPendingListRequest, opts ...grpc.CallOption) (*DepositPendingListReply, error) {
+	out := new(DepositPendingListReply)
+	err := c.cc.Invoke(ctx, "/api.App/DepositPendingList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) DepositUnmatchedList(ctx context.Context, in *DepositUnmatchedListRequest, opts ...grpc.CallOption) (*DepositUnmatchedListReply, error) {
+	out := new(DepositUnmatchedListReply)
+	err := c.cc.Invoke(ctx, "/api.App/DepositUnmatchedList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) ChainList(ctx context.Context, in *ChainListRequest, opts ...grpc.CallOption) (*ChainListReply, error) {
+	out := new(ChainListReply)
+	err := c.cc.Invoke(ctx, "/api.App/ChainList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) DepositAddress(ctx context.Context, in *DepositAddressRequest, opts ...grpc.CallOption) (*DepositAddressReply, error) {
+	out := new(DepositAddressReply)
+	err := c.cc.Invoke(ctx, "/api.App/DepositAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) SubmitDepositTx(ctx context.Context, in *SubmitDepositTxRequest, opts ...grpc.CallOption) (*SubmitDepositTxReply, error) {
+	out := new(SubmitDepositTxReply)
+	err := c.cc.Invoke(ctx, "/api.App/SubmitDepositTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) DepositWebhook(ctx context.Context, in *DepositWebhookRequest, opts ...grpc.CallOption) (*DepositWebhookReply, error) {
+	out := new(DepositWebhookReply)
+	err := c.cc.Invoke(ctx, "/api.App/DepositWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appClient) Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawReply, error) {
 	out := new(WithdrawReply)
 	err := c.cc.Invoke(ctx, "/api.App/Withdraw", in, out, opts...)
@@ -141,6 +243,24 @@ func (c *appClient) Withdraw(ctx context.Context, in *WithdrawRequest, opts ...g
 	return out, nil
 }
 
+func (c *appClient) WithdrawCancel(ctx context.Context, in *WithdrawCancelRequest, opts ...grpc.CallOption) (*WithdrawCancelReply, error) {
+	out := new(WithdrawCancelReply)
+	err := c.cc.Invoke(ctx, "/api.App/WithdrawCancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminLogin(ctx context.Context, in *AdminLoginRequest, opts ...grpc.CallOption) (*AdminLoginReply, error) {
+	out := new(AdminLoginReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appClient) Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositReply, error) {
 	out := new(DepositReply)
 	err := c.cc.Invoke(ctx, "/api.App/Deposit", in, out, opts...)
@@ -168,6 +288,33 @@ func (c *appClient) AdminWithdrawEth(ctx context.Context, in *AdminWithdrawEthRe
 	return out, nil
 }
 
+func (c *appClient) AdminWithdrawReceipt(ctx context.Context, in *AdminWithdrawReceiptRequest, opts ...grpc.CallOption) (*AdminWithdrawReceiptReply, error) {
+	out := new(AdminWithdrawReceiptReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminWithdrawReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWithdrawUpdate(ctx context.Context, in *AdminWithdrawUpdateRequest, opts ...grpc.CallOption) (*AdminWithdrawUpdateReply, error) {
+	out := new(AdminWithdrawUpdateReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminWithdrawUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWithdrawEventList(ctx context.Context, in *AdminWithdrawEventListRequest, opts ...grpc.CallOption) (*AdminWithdrawEventListReply, error) {
+	out := new(AdminWithdrawEventListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminWithdrawEventList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appClient) AdminFee(ctx context.Context, in *AdminFeeRequest, opts ...grpc.CallOption) (*AdminFeeReply, error) {
 	out := new(AdminFeeReply)
 	err := c.cc.Invoke(ctx, "/api.App/AdminFee", in, out, opts...)
@@ -177,18 +324,92 @@ func (c *appClient) AdminFee(ctx context.Context, in *AdminFeeRequest, opts ...g
 	return out, nil
 }
 
+func (c *appClient) AdminJobRunList(ctx context.Context, in *AdminJobRunListRequest, opts ...grpc.CallOption) (*AdminJobRunListReply, error) {
+	out := new(AdminJobRunListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminJobRunList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminDepositReorgList(ctx context.Context, in *AdminDepositReorgListRequest, opts ...grpc.CallOption) (*AdminDepositReorgListReply, error) {
+	out := new(AdminDepositReorgListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminDepositReorgList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminDepositUnmatchedList(ctx context.Context, in *AdminDepositUnmatchedListRequest, opts ...grpc.CallOption) (*AdminDepositUnmatchedListReply, error) {
+	out := new(AdminDepositUnmatchedListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminDepositUnmatchedList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminDepositUnmatchedHandle(ctx context.Context, in *AdminDepositUnmatchedHandleRequest, opts ...grpc.CallOption) (*AdminDepositUnmatchedHandleReply, error) {
+	out := new(AdminDepositUnmatchedHandleReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminDepositUnmatchedHandle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminDepositTierList(ctx context.Context, in *AdminDepositTierListRequest, opts ...grpc.CallOption) (*AdminDepositTierListReply, error) {
+	out := new(AdminDepositTierListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminDepositTierList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminDepositTierSave(ctx context.Context, in *AdminDepositTierSaveRequest, opts ...grpc.CallOption) (*AdminDepositTierSaveReply, error) {
+	out := new(AdminDepositTierSaveReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminDepositTierSave", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminDepositSweep(ctx context.Context, in *AdminDepositSweepRequest, opts ...grpc.CallOption) (*AdminDepositSweepReply, error) {
+	out := new(AdminDepositSweepReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminDepositSweep", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AppServer is the server API for App service.
 // All implementations must embed UnimplementedAppServer
 // for forward compatibility
 type AppServer interface {
+	GetAuthNonce(context.Context, *GetAuthNonceRequest) (*GetAuthNonceReply, error)
 	EthAuthorize(context.Context, *EthAuthorizeRequest) (*EthAuthorizeReply, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenReply, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutReply, error)
 	UserInfo(context.Context, *UserInfoRequest) (*UserInfoReply, error)
 	RewardList(context.Context, *RewardListRequest) (*RewardListReply, error)
 	RecommendRewardList(context.Context, *RecommendRewardListRequest) (*RecommendRewardListReply, error)
 	FeeRewardList(context.Context, *FeeRewardListRequest) (*FeeRewardListReply, error)
 	WithdrawList(context.Context, *WithdrawListRequest) (*WithdrawListReply, error)
 	RecommendList(context.Context, *RecommendListRequest) (*RecommendListReply, error)
+	DepositPendingList(context.Context, *DepositPendingListRequest) (*DepositPendingListReply, error)
+	DepositUnmatchedList(context.Context, *DepositUnmatchedListRequest) (*DepositUnmatchedListReply, error)
+	ChainList(context.Context, *ChainListRequest) (*ChainListReply, error)
+	DepositAddress(context.Context, *DepositAddressRequest) (*DepositAddressReply, error)
+	SubmitDepositTx(context.Context, *SubmitDepositTxRequest) (*SubmitDepositTxReply, error)
+	DepositWebhook(context.Context, *DepositWebhookRequest) (*DepositWebhookReply, error)
 	Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error)
+	WithdrawCancel(context.Context, *WithdrawCancelRequest) (*WithdrawCancelReply, error)
+	AdminLogin(context.Context, *AdminLoginRequest) (*AdminLoginReply, error)
 	Deposit(context.Context, *DepositRequest) (*DepositReply, error)
 	//
 	//	rpc AdminRewardList (AdminRewardListRequest) returns (AdminRewardListReply) {
@@ -217,7 +438,17 @@ type AppServer interface {
 	//
 	AdminWithdraw(context.Context, *AdminWithdrawRequest) (*AdminWithdrawReply, error)
 	AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error)
+	AdminWithdrawReceipt(context.Context, *AdminWithdrawReceiptRequest) (*AdminWithdrawReceiptReply, error)
+	AdminWithdrawUpdate(context.Context, *AdminWithdrawUpdateRequest) (*AdminWithdrawUpdateReply, error)
+	AdminWithdrawEventList(context.Context, *AdminWithdrawEventListRequest) (*AdminWithdrawEventListReply, error)
 	AdminFee(context.Context, *AdminFeeRequest) (*AdminFeeReply, error)
+	AdminJobRunList(context.Context, *AdminJobRunListRequest) (*AdminJobRunListReply, error)
+	AdminDepositReorgList(context.Context, *AdminDepositReorgListRequest) (*AdminDepositReorgListReply, error)
+	AdminDepositUnmatchedList(context.Context, *AdminDepositUnmatchedListRequest) (*AdminDepositUnmatchedListReply, error)
+	AdminDepositUnmatchedHandle(context.Context, *AdminDepositUnmatchedHandleRequest) (*AdminDepositUnmatchedHandleReply, error)
+	AdminDepositTierList(context.Context, *AdminDepositTierListRequest) (*AdminDepositTierListReply, error)
+	AdminDepositTierSave(context.Context, *AdminDepositTierSaveRequest) (*AdminDepositTierSaveReply, error)
+	AdminDepositSweep(context.Context, *AdminDepositSweepRequest) (*AdminDepositSweepReply, error)
 	mustEmbedUnimplementedAppServer()
 }
 
@@ -225,9 +456,18 @@ type AppServer interface {
 type UnimplementedAppServer struct {
 }
 
+func (UnimplementedAppServer) GetAuthNonce(context.Context, *GetAuthNonceRequest) (*GetAuthNonceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthNonce not implemented")
+}
 func (UnimplementedAppServer) EthAuthorize(context.Context, *EthAuthorizeRequest) (*EthAuthorizeReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EthAuthorize not implemented")
 }
+func (UnimplementedAppServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedAppServer) Logout(context.Context, *LogoutRequest) (*LogoutReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
 func (UnimplementedAppServer) UserInfo(context.Context, *UserInfoRequest) (*UserInfoReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserInfo not implemented")
 }
@@ -246,9 +486,33 @@ func (UnimplementedAppServer) WithdrawList(context.Context, *WithdrawListRequest
 func (UnimplementedAppServer) RecommendList(context.Context, *RecommendListRequest) (*RecommendListReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecommendList not implemented")
 }
+func (UnimplementedAppServer) DepositPendingList(context.Context, *DepositPendingListRequest) (*DepositPendingListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositPendingList not implemented")
+}
+func (UnimplementedAppServer) DepositUnmatchedList(context.Context, *DepositUnmatchedListRequest) (*DepositUnmatchedListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositUnmatchedList not implemented")
+}
+func (UnimplementedAppServer) ChainList(context.Context, *ChainListRequest) (*ChainListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainList not implemented")
+}
+func (UnimplementedAppServer) DepositAddress(context.Context, *DepositAddressRequest) (*DepositAddressReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositAddress not implemented")
+}
+func (UnimplementedAppServer) SubmitDepositTx(context.Context, *SubmitDepositTxRequest) (*SubmitDepositTxReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDepositTx not implemented")
+}
+func (UnimplementedAppServer) DepositWebhook(context.Context, *DepositWebhookRequest) (*DepositWebhookReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositWebhook not implemented")
+}
 func (UnimplementedAppServer) Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
 }
+func (UnimplementedAppServer) WithdrawCancel(context.Context, *WithdrawCancelRequest) (*WithdrawCancelReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCancel not implemented")
+}
+func (UnimplementedAppServer) AdminLogin(context.Context, *AdminLoginRequest) (*AdminLoginReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminLogin not implemented")
+}
 func (UnimplementedAppServer) Deposit(context.Context, *DepositRequest) (*DepositReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
@@ -258,9 +522,39 @@ func (UnimplementedAppServer) AdminWithdraw(context.Context, *AdminWithdrawReque
 func (UnimplementedAppServer) AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawEth not implemented")
 }
+func (UnimplementedAppServer) AdminWithdrawReceipt(context.Context, *AdminWithdrawReceiptRequest) (*AdminWithdrawReceiptReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawReceipt not implemented")
+}
+func (UnimplementedAppServer) AdminWithdrawUpdate(context.Context, *AdminWithdrawUpdateRequest) (*AdminWithdrawUpdateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawUpdate not implemented")
+}
+func (UnimplementedAppServer) AdminWithdrawEventList(context.Context, *AdminWithdrawEventListRequest) (*AdminWithdrawEventListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawEventList not implemented")
+}
 func (UnimplementedAppServer) AdminFee(context.Context, *AdminFeeRequest) (*AdminFeeReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminFee not implemented")
 }
+func (UnimplementedAppServer) AdminJobRunList(context.Context, *AdminJobRunListRequest) (*AdminJobRunListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminJobRunList not implemented")
+}
+func (UnimplementedAppServer) AdminDepositReorgList(context.Context, *AdminDepositReorgListRequest) (*AdminDepositReorgListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDepositReorgList not implemented")
+}
+func (UnimplementedAppServer) AdminDepositUnmatchedList(context.Context, *AdminDepositUnmatchedListRequest) (*AdminDepositUnmatchedListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDepositUnmatchedList not implemented")
+}
+func (UnimplementedAppServer) AdminDepositUnmatchedHandle(context.Context, *AdminDepositUnmatchedHandleRequest) (*AdminDepositUnmatchedHandleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDepositUnmatchedHandle not implemented")
+}
+func (UnimplementedAppServer) AdminDepositTierList(context.Context, *AdminDepositTierListRequest) (*AdminDepositTierListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDepositTierList not implemented")
+}
+func (UnimplementedAppServer) AdminDepositTierSave(context.Context, *AdminDepositTierSaveRequest) (*AdminDepositTierSaveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDepositTierSave not implemented")
+}
+func (UnimplementedAppServer) AdminDepositSweep(context.Context, *AdminDepositSweepRequest) (*AdminDepositSweepReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDepositSweep not implemented")
+}
 func (UnimplementedAppServer) mustEmbedUnimplementedAppServer() {}
 
 // UnsafeAppServer may be embedded to opt out of forward compatibility for this service.
@@ -274,6 +568,24 @@ func RegisterAppServer(s grpc.ServiceRegistrar, srv AppServer) {
 	s.RegisterService(&App_ServiceDesc, srv)
 }
 
+func _App_GetAuthNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).GetAuthNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/GetAuthNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).GetAuthNonce(ctx, req.(*GetAuthNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _App_EthAuthorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EthAuthorizeRequest)
 	if err := dec(in); err != nil {
@@ -292,6 +604,42 @@ func _App_EthAuthorize_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _App_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _App_UserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserInfoRequest)
 	if err := dec(in); err != nil {
@@ -400,6 +748,114 @@ func _App_RecommendList_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _App_DepositPendingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositPendingListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).DepositPendingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/DepositPendingList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).DepositPendingList(ctx, req.(*DepositPendingListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_DepositUnmatchedList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositUnmatchedListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).DepositUnmatchedList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/DepositUnmatchedList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).DepositUnmatchedList(ctx, req.(*DepositUnmatchedListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_ChainList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).ChainList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/ChainList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).ChainList(ctx, req.(*ChainListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_DepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).DepositAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/DepositAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).DepositAddress(ctx, req.(*DepositAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_SubmitDepositTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitDepositTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).SubmitDepositTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/SubmitDepositTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).SubmitDepositTx(ctx, req.(*SubmitDepositTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_DepositWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).DepositWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/DepositWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).DepositWebhook(ctx, req.(*DepositWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _App_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WithdrawRequest)
 	if err := dec(in); err != nil {
@@ -418,6 +874,42 @@ func _App_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _App_WithdrawCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).WithdrawCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/WithdrawCancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).WithdrawCancel(ctx, req.(*WithdrawCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminLogin(ctx, req.(*AdminLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _App_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DepositRequest)
 	if err := dec(in); err != nil {
@@ -472,6 +964,60 @@ func _App_AdminWithdrawEth_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _App_AdminWithdrawReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWithdrawReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminWithdrawReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWithdrawReceipt(ctx, req.(*AdminWithdrawReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWithdrawUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWithdrawUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminWithdrawUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWithdrawUpdate(ctx, req.(*AdminWithdrawUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWithdrawEventList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawEventListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWithdrawEventList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminWithdrawEventList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWithdrawEventList(ctx, req.(*AdminWithdrawEventListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _App_AdminFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AdminFeeRequest)
 	if err := dec(in); err != nil {
@@ -490,6 +1036,132 @@ func _App_AdminFee_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _App_AdminJobRunList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminJobRunListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminJobRunList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminJobRunList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminJobRunList(ctx, req.(*AdminJobRunListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminDepositReorgList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDepositReorgListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminDepositReorgList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminDepositReorgList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminDepositReorgList(ctx, req.(*AdminDepositReorgListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminDepositUnmatchedList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDepositUnmatchedListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminDepositUnmatchedList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminDepositUnmatchedList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminDepositUnmatchedList(ctx, req.(*AdminDepositUnmatchedListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminDepositUnmatchedHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDepositUnmatchedHandleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminDepositUnmatchedHandle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminDepositUnmatchedHandle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminDepositUnmatchedHandle(ctx, req.(*AdminDepositUnmatchedHandleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminDepositTierList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDepositTierListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminDepositTierList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminDepositTierList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminDepositTierList(ctx, req.(*AdminDepositTierListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminDepositTierSave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDepositTierSaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminDepositTierSave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminDepositTierSave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminDepositTierSave(ctx, req.(*AdminDepositTierSaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminDepositSweep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDepositSweepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminDepositSweep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminDepositSweep",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminDepositSweep(ctx, req.(*AdminDepositSweepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // App_ServiceDesc is the grpc.ServiceDesc for App service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -497,10 +1169,22 @@ var App_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "api.App",
 	HandlerType: (*AppServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAuthNonce",
+			Handler:    _App_GetAuthNonce_Handler,
+		},
 		{
 			MethodName: "EthAuthorize",
 			Handler:    _App_EthAuthorize_Handler,
 		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _App_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _App_Logout_Handler,
+		},
 		{
 			MethodName: "UserInfo",
 			Handler:    _App_UserInfo_Handler,
@@ -525,10 +1209,42 @@ var App_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RecommendList",
 			Handler:    _App_RecommendList_Handler,
 		},
+		{
+			MethodName: "DepositPendingList",
+			Handler:    _App_DepositPendingList_Handler,
+		},
+		{
+			MethodName: "DepositUnmatchedList",
+			Handler:    _App_DepositUnmatchedList_Handler,
+		},
+		{
+			MethodName: "ChainList",
+			Handler:    _App_ChainList_Handler,
+		},
+		{
+			MethodName: "DepositAddress",
+			Handler:    _App_DepositAddress_Handler,
+		},
+		{
+			MethodName: "SubmitDepositTx",
+			Handler:    _App_SubmitDepositTx_Handler,
+		},
+		{
+			MethodName: "DepositWebhook",
+			Handler:    _App_DepositWebhook_Handler,
+		},
 		{
 			MethodName: "Withdraw",
 			Handler:    _App_Withdraw_Handler,
 		},
+		{
+			MethodName: "WithdrawCancel",
+			Handler:    _App_WithdrawCancel_Handler,
+		},
+		{
+			MethodName: "AdminLogin",
+			Handler:    _App_AdminLogin_Handler,
+		},
 		{
 			MethodName: "Deposit",
 			Handler:    _App_Deposit_Handler,
@@ -541,10 +1257,50 @@ var App_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AdminWithdrawEth",
 			Handler:    _App_AdminWithdrawEth_Handler,
 		},
+		{
+			MethodName: "AdminWithdrawReceipt",
+			Handler:    _App_AdminWithdrawReceipt_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawUpdate",
+			Handler:    _App_AdminWithdrawUpdate_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawEventList",
+			Handler:    _App_AdminWithdrawEventList_Handler,
+		},
 		{
 			MethodName: "AdminFee",
 			Handler:    _App_AdminFee_Handler,
 		},
+		{
+			MethodName: "AdminJobRunList",
+			Handler:    _App_AdminJobRunList_Handler,
+		},
+		{
+			MethodName: "AdminDepositReorgList",
+			Handler:    _App_AdminDepositReorgList_Handler,
+		},
+		{
+			MethodName: "AdminDepositUnmatchedList",
+			Handler:    _App_AdminDepositUnmatchedList_Handler,
+		},
+		{
+			MethodName: "AdminDepositUnmatchedHandle",
+			Handler:    _App_AdminDepositUnmatchedHandle_Handler,
+		},
+		{
+			MethodName: "AdminDepositTierList",
+			Handler:    _App_AdminDepositTierList_Handler,
+		},
+		{
+			MethodName: "AdminDepositTierSave",
+			Handler:    _App_AdminDepositTierSave_Handler,
+		},
+		{
+			MethodName: "AdminDepositSweep",
+			Handler:    _App_AdminDepositSweep_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/app.proto",
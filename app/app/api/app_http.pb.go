@@ -19,48 +19,130 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationAppAdminDepositReorgList = "/api.App/AdminDepositReorgList"
+const OperationAppAdminDepositSweep = "/api.App/AdminDepositSweep"
+const OperationAppAdminDepositTierList = "/api.App/AdminDepositTierList"
+const OperationAppAdminDepositTierSave = "/api.App/AdminDepositTierSave"
+const OperationAppAdminDepositUnmatchedHandle = "/api.App/AdminDepositUnmatchedHandle"
+const OperationAppAdminDepositUnmatchedList = "/api.App/AdminDepositUnmatchedList"
 const OperationAppAdminFee = "/api.App/AdminFee"
+const OperationAppAdminJobRunList = "/api.App/AdminJobRunList"
+const OperationAppAdminLogin = "/api.App/AdminLogin"
 const OperationAppAdminWithdraw = "/api.App/AdminWithdraw"
 const OperationAppAdminWithdrawEth = "/api.App/AdminWithdrawEth"
+const OperationAppAdminWithdrawEventList = "/api.App/AdminWithdrawEventList"
+const OperationAppAdminWithdrawReceipt = "/api.App/AdminWithdrawReceipt"
+const OperationAppAdminWithdrawUpdate = "/api.App/AdminWithdrawUpdate"
+const OperationAppChainList = "/api.App/ChainList"
 const OperationAppDeposit = "/api.App/Deposit"
+const OperationAppDepositAddress = "/api.App/DepositAddress"
+const OperationAppDepositPendingList = "/api.App/DepositPendingList"
+const OperationAppDepositUnmatchedList = "/api.App/DepositUnmatchedList"
+const OperationAppDepositWebhook = "/api.App/DepositWebhook"
 const OperationAppEthAuthorize = "/api.App/EthAuthorize"
 const OperationAppFeeRewardList = "/api.App/FeeRewardList"
+const OperationAppGetAuthNonce = "/api.App/GetAuthNonce"
+const OperationAppLogout = "/api.App/Logout"
 const OperationAppRecommendList = "/api.App/RecommendList"
 const OperationAppRecommendRewardList = "/api.App/RecommendRewardList"
+const OperationAppRefreshToken = "/api.App/RefreshToken"
 const OperationAppRewardList = "/api.App/RewardList"
+const OperationAppSubmitDepositTx = "/api.App/SubmitDepositTx"
 const OperationAppUserInfo = "/api.App/UserInfo"
 const OperationAppWithdraw = "/api.App/Withdraw"
+const OperationAppWithdrawCancel = "/api.App/WithdrawCancel"
 const OperationAppWithdrawList = "/api.App/WithdrawList"
 
 type AppHTTPServer interface {
+	AdminDepositReorgList(context.Context, *AdminDepositReorgListRequest) (*AdminDepositReorgListReply, error)
+	AdminDepositSweep(context.Context, *AdminDepositSweepRequest) (*AdminDepositSweepReply, error)
+	AdminDepositTierList(context.Context, *AdminDepositTierListRequest) (*AdminDepositTierListReply, error)
+	AdminDepositTierSave(context.Context, *AdminDepositTierSaveRequest) (*AdminDepositTierSaveReply, error)
+	AdminDepositUnmatchedHandle(context.Context, *AdminDepositUnmatchedHandleRequest) (*AdminDepositUnmatchedHandleReply, error)
+	AdminDepositUnmatchedList(context.Context, *AdminDepositUnmatchedListRequest) (*AdminDepositUnmatchedListReply, error)
 	AdminFee(context.Context, *AdminFeeRequest) (*AdminFeeReply, error)
+	AdminJobRunList(context.Context, *AdminJobRunListRequest) (*AdminJobRunListReply, error)
+	AdminLogin(context.Context, *AdminLoginRequest) (*AdminLoginReply, error)
 	AdminWithdraw(context.Context, *AdminWithdrawRequest) (*AdminWithdrawReply, error)
 	AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error)
+	AdminWithdrawEventList(context.Context, *AdminWithdrawEventListRequest) (*AdminWithdrawEventListReply, error)
+	AdminWithdrawReceipt(context.Context, *AdminWithdrawReceiptRequest) (*AdminWithdrawReceiptReply, error)
+	AdminWithdrawUpdate(context.Context, *AdminWithdrawUpdateRequest) (*AdminWithdrawUpdateReply, error)
+	ChainList(context.Context, *ChainListRequest) (*ChainListReply, error)
 	Deposit(context.Context, *DepositRequest) (*DepositReply, error)
+	DepositAddress(context.Context, *DepositAddressRequest) (*DepositAddressReply, error)
+	DepositPendingList(context.Context, *DepositPendingListRequest) (*DepositPendingListReply, error)
+	DepositUnmatchedList(context.Context, *DepositUnmatchedListRequest) (*DepositUnmatchedListReply, error)
+	DepositWebhook(context.Context, *DepositWebhookRequest) (*DepositWebhookReply, error)
 	EthAuthorize(context.Context, *EthAuthorizeRequest) (*EthAuthorizeReply, error)
 	FeeRewardList(context.Context, *FeeRewardListRequest) (*FeeRewardListReply, error)
+	GetAuthNonce(context.Context, *GetAuthNonceRequest) (*GetAuthNonceReply, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutReply, error)
 	RecommendList(context.Context, *RecommendListRequest) (*RecommendListReply, error)
 	RecommendRewardList(context.Context, *RecommendRewardListRequest) (*RecommendRewardListReply, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenReply, error)
 	RewardList(context.Context, *RewardListRequest) (*RewardListReply, error)
+	SubmitDepositTx(context.Context, *SubmitDepositTxRequest) (*SubmitDepositTxReply, error)
 	UserInfo(context.Context, *UserInfoRequest) (*UserInfoReply, error)
 	Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error)
+	WithdrawCancel(context.Context, *WithdrawCancelRequest) (*WithdrawCancelReply, error)
 	WithdrawList(context.Context, *WithdrawListRequest) (*WithdrawListReply, error)
 }
 
 func RegisterAppHTTPServer(s *http.Server, srv AppHTTPServer) {
 	r := s.Route("/")
+	r.GET("/api/app_server/auth_nonce", _App_GetAuthNonce0_HTTP_Handler(srv))
 	r.POST("/api/app_server/eth_authorize", _App_EthAuthorize0_HTTP_Handler(srv))
+	r.POST("/api/app_server/refresh_token", _App_RefreshToken0_HTTP_Handler(srv))
+	r.POST("/api/app_server/logout", _App_Logout0_HTTP_Handler(srv))
 	r.GET("/api/app_server/user_info", _App_UserInfo0_HTTP_Handler(srv))
 	r.GET("/api/app_server/reward_list", _App_RewardList0_HTTP_Handler(srv))
 	r.GET("/api/app_server/recommend_reward_list", _App_RecommendRewardList0_HTTP_Handler(srv))
 	r.GET("/api/app_server/fee_reward_list", _App_FeeRewardList0_HTTP_Handler(srv))
 	r.GET("/api/app_server/withdraw_list", _App_WithdrawList0_HTTP_Handler(srv))
 	r.GET("/api/app_server/recommend_list", _App_RecommendList0_HTTP_Handler(srv))
+	r.GET("/api/app_server/deposit_pending_list", _App_DepositPendingList0_HTTP_Handler(srv))
+	r.GET("/api/app_server/deposit_unmatched_list", _App_DepositUnmatchedList0_HTTP_Handler(srv))
+	r.GET("/api/app_server/chains", _App_ChainList0_HTTP_Handler(srv))
+	r.GET("/api/app_server/deposit_address", _App_DepositAddress0_HTTP_Handler(srv))
+	r.POST("/api/app_server/submit_deposit_tx", _App_SubmitDepositTx0_HTTP_Handler(srv))
+	r.POST("/api/deposit_webhook", _App_DepositWebhook0_HTTP_Handler(srv))
 	r.POST("/api/app_server/withdraw", _App_Withdraw0_HTTP_Handler(srv))
+	r.POST("/api/app_server/withdraw_cancel", _App_WithdrawCancel0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/login", _App_AdminLogin0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/deposit", _App_Deposit0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/withdraw", _App_AdminWithdraw0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/withdraw_eth", _App_AdminWithdrawEth0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/withdraw_receipt", _App_AdminWithdrawReceipt0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/withdraw_update", _App_AdminWithdrawUpdate0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/withdraw_event_list", _App_AdminWithdrawEventList0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/fee", _App_AdminFee0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/job_run_list", _App_AdminJobRunList0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/deposit_reorg_list", _App_AdminDepositReorgList0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/deposit_unmatched_list", _App_AdminDepositUnmatchedList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/deposit_unmatched_handle", _App_AdminDepositUnmatchedHandle0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/deposit_tier_list", _App_AdminDepositTierList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/deposit_tier_save", _App_AdminDepositTierSave0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/deposit_sweep", _App_AdminDepositSweep0_HTTP_Handler(srv))
+}
+
+func _App_GetAuthNonce0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetAuthNonceRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppGetAuthNonce)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetAuthNonce(ctx, req.(*GetAuthNonceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetAuthNonceReply)
+		return ctx.Result(200, reply)
+	}
 }
 
 func _App_EthAuthorize0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
@@ -85,6 +167,47 @@ func _App_EthAuthorize0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) e
 	}
 }
 
+func _App_RefreshToken0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RefreshTokenRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppRefreshToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RefreshToken(ctx, req.(*RefreshTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RefreshTokenReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_Logout0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppLogout)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Logout(ctx, req.(*LogoutRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LogoutReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _App_UserInfo0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in UserInfoRequest
@@ -199,6 +322,126 @@ func _App_RecommendList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context)
 	}
 }
 
+func _App_DepositPendingList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DepositPendingListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppDepositPendingList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DepositPendingList(ctx, req.(*DepositPendingListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DepositPendingListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_DepositUnmatchedList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DepositUnmatchedListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppDepositUnmatchedList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DepositUnmatchedList(ctx, req.(*DepositUnmatchedListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DepositUnmatchedListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_ChainList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ChainListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppChainList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ChainList(ctx, req.(*ChainListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ChainListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_DepositAddress0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DepositAddressRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppDepositAddress)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DepositAddress(ctx, req.(*DepositAddressRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DepositAddressReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_SubmitDepositTx0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SubmitDepositTxRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppSubmitDepositTx)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SubmitDepositTx(ctx, req.(*SubmitDepositTxRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SubmitDepositTxReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_DepositWebhook0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DepositWebhookRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppDepositWebhook)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DepositWebhook(ctx, req.(*DepositWebhookRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DepositWebhookReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _App_Withdraw0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in WithdrawRequest
@@ -221,6 +464,50 @@ func _App_Withdraw0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error
 	}
 }
 
+func _App_WithdrawCancel0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in WithdrawCancelRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppWithdrawCancel)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.WithdrawCancel(ctx, req.(*WithdrawCancelRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*WithdrawCancelReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminLogin0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminLoginRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminLogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminLogin(ctx, req.(*AdminLoginRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminLoginReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _App_Deposit0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in DepositRequest
@@ -278,6 +565,66 @@ func _App_AdminWithdrawEth0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Contex
 	}
 }
 
+func _App_AdminWithdrawReceipt0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawReceiptRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWithdrawReceipt)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawReceipt(ctx, req.(*AdminWithdrawReceiptRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawReceiptReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminWithdrawUpdate0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawUpdateRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWithdrawUpdate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawUpdate(ctx, req.(*AdminWithdrawUpdateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawUpdateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminWithdrawEventList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawEventListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWithdrawEventList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawEventList(ctx, req.(*AdminWithdrawEventListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawEventListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _App_AdminFee0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in AdminFeeRequest
@@ -297,18 +644,178 @@ func _App_AdminFee0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error
 	}
 }
 
+func _App_AdminJobRunList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminJobRunListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminJobRunList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminJobRunList(ctx, req.(*AdminJobRunListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminJobRunListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminDepositReorgList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDepositReorgListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminDepositReorgList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDepositReorgList(ctx, req.(*AdminDepositReorgListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDepositReorgListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminDepositUnmatchedList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDepositUnmatchedListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminDepositUnmatchedList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDepositUnmatchedList(ctx, req.(*AdminDepositUnmatchedListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDepositUnmatchedListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminDepositUnmatchedHandle0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDepositUnmatchedHandleRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminDepositUnmatchedHandle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDepositUnmatchedHandle(ctx, req.(*AdminDepositUnmatchedHandleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDepositUnmatchedHandleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminDepositTierList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDepositTierListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminDepositTierList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDepositTierList(ctx, req.(*AdminDepositTierListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDepositTierListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminDepositTierSave0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDepositTierSaveRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminDepositTierSave)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDepositTierSave(ctx, req.(*AdminDepositTierSaveRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDepositTierSaveReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminDepositSweep0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDepositSweepRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminDepositSweep)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDepositSweep(ctx, req.(*AdminDepositSweepRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDepositSweepReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type AppHTTPClient interface {
+	AdminDepositReorgList(ctx context.Context, req *AdminDepositReorgListRequest, opts ...http.CallOption) (rsp *AdminDepositReorgListReply, err error)
+	AdminDepositSweep(ctx context.Context, req *AdminDepositSweepRequest, opts ...http.CallOption) (rsp *AdminDepositSweepReply, err error)
+	AdminDepositTierList(ctx context.Context, req *AdminDepositTierListRequest, opts ...http.CallOption) (rsp *AdminDepositTierListReply, err error)
+	AdminDepositTierSave(ctx context.Context, req *AdminDepositTierSaveRequest, opts ...http.CallOption) (rsp *AdminDepositTierSaveReply, err error)
+	AdminDepositUnmatchedHandle(ctx context.Context, req *AdminDepositUnmatchedHandleRequest, opts ...http.CallOption) (rsp *AdminDepositUnmatchedHandleReply, err error)
+	AdminDepositUnmatchedList(ctx context.Context, req *AdminDepositUnmatchedListRequest, opts ...http.CallOption) (rsp *AdminDepositUnmatchedListReply, err error)
 	AdminFee(ctx context.Context, req *AdminFeeRequest, opts ...http.CallOption) (rsp *AdminFeeReply, err error)
+	AdminJobRunList(ctx context.Context, req *AdminJobRunListRequest, opts ...http.CallOption) (rsp *AdminJobRunListReply, err error)
+	AdminLogin(ctx context.Context, req *AdminLoginRequest, opts ...http.CallOption) (rsp *AdminLoginReply, err error)
 	AdminWithdraw(ctx context.Context, req *AdminWithdrawRequest, opts ...http.CallOption) (rsp *AdminWithdrawReply, err error)
 	AdminWithdrawEth(ctx context.Context, req *AdminWithdrawEthRequest, opts ...http.CallOption) (rsp *AdminWithdrawEthReply, err error)
+	AdminWithdrawEventList(ctx context.Context, req *AdminWithdrawEventListRequest, opts ...http.CallOption) (rsp *AdminWithdrawEventListReply, err error)
+	AdminWithdrawReceipt(ctx context.Context, req *AdminWithdrawReceiptRequest, opts ...http.CallOption) (rsp *AdminWithdrawReceiptReply, err error)
+	AdminWithdrawUpdate(ctx context.Context, req *AdminWithdrawUpdateRequest, opts ...http.CallOption) (rsp *AdminWithdrawUpdateReply, err error)
+	ChainList(ctx context.Context, req *ChainListRequest, opts ...http.CallOption) (rsp *ChainListReply, err error)
 	Deposit(ctx context.Context, req *DepositRequest, opts ...http.CallOption) (rsp *DepositReply, err error)
+	DepositAddress(ctx context.Context, req *DepositAddressRequest, opts ...http.CallOption) (rsp *DepositAddressReply, err error)
+	DepositPendingList(ctx context.Context, req *DepositPendingListRequest, opts ...http.CallOption) (rsp *DepositPendingListReply, err error)
+	DepositUnmatchedList(ctx context.Context, req *DepositUnmatchedListRequest, opts ...http.CallOption) (rsp *DepositUnmatchedListReply, err error)
+	DepositWebhook(ctx context.Context, req *DepositWebhookRequest, opts ...http.CallOption) (rsp *DepositWebhookReply, err error)
 	EthAuthorize(ctx context.Context, req *EthAuthorizeRequest, opts ...http.CallOption) (rsp *EthAuthorizeReply, err error)
 	FeeRewardList(ctx context.Context, req *FeeRewardListRequest, opts ...http.CallOption) (rsp *FeeRewardListReply, err error)
+	GetAuthNonce(ctx context.Context, req *GetAuthNonceRequest, opts ...http.CallOption) (rsp *GetAuthNonceReply, err error)
+	Logout(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *LogoutReply, err error)
 	RecommendList(ctx context.Context, req *RecommendListRequest, opts ...http.CallOption) (rsp *RecommendListReply, err error)
 	RecommendRewardList(ctx context.Context, req *RecommendRewardListRequest, opts ...http.CallOption) (rsp *RecommendRewardListReply, err error)
+	RefreshToken(ctx context.Context, req *RefreshTokenRequest, opts ...http.CallOption) (rsp *RefreshTokenReply, err error)
 	RewardList(ctx context.Context, req *RewardListRequest, opts ...http.CallOption) (rsp *RewardListReply, err error)
+	SubmitDepositTx(ctx context.Context, req *SubmitDepositTxRequest, opts ...http.CallOption) (rsp *SubmitDepositTxReply, err error)
 	UserInfo(ctx context.Context, req *UserInfoRequest, opts ...http.CallOption) (rsp *UserInfoReply, err error)
 	Withdraw(ctx context.Context, req *WithdrawRequest, opts ...http.CallOption) (rsp *WithdrawReply, err error)
+	WithdrawCancel(ctx context.Context, req *WithdrawCancelRequest, opts ...http.CallOption) (rsp *WithdrawCancelReply, err error)
 	WithdrawList(ctx context.Context, req *WithdrawListRequest, opts ...http.CallOption) (rsp *WithdrawListReply, err error)
 }
 
@@ -320,6 +827,84 @@ func NewAppHTTPClient(client *http.Client) AppHTTPClient {
 	return &AppHTTPClientImpl{client}
 }
 
+func (c *AppHTTPClientImpl) AdminDepositReorgList(ctx context.Context, in *AdminDepositReorgListRequest, opts ...http.CallOption) (*AdminDepositReorgListReply, error) {
+	var out AdminDepositReorgListReply
+	pattern := "/api/admin_dhb/deposit_reorg_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminDepositReorgList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminDepositSweep(ctx context.Context, in *AdminDepositSweepRequest, opts ...http.CallOption) (*AdminDepositSweepReply, error) {
+	var out AdminDepositSweepReply
+	pattern := "/api/admin_dhb/deposit_sweep"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminDepositSweep))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminDepositTierList(ctx context.Context, in *AdminDepositTierListRequest, opts ...http.CallOption) (*AdminDepositTierListReply, error) {
+	var out AdminDepositTierListReply
+	pattern := "/api/admin_dhb/deposit_tier_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminDepositTierList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminDepositTierSave(ctx context.Context, in *AdminDepositTierSaveRequest, opts ...http.CallOption) (*AdminDepositTierSaveReply, error) {
+	var out AdminDepositTierSaveReply
+	pattern := "/api/admin_dhb/deposit_tier_save"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminDepositTierSave))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminDepositUnmatchedHandle(ctx context.Context, in *AdminDepositUnmatchedHandleRequest, opts ...http.CallOption) (*AdminDepositUnmatchedHandleReply, error) {
+	var out AdminDepositUnmatchedHandleReply
+	pattern := "/api/admin_dhb/deposit_unmatched_handle"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminDepositUnmatchedHandle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminDepositUnmatchedList(ctx context.Context, in *AdminDepositUnmatchedListRequest, opts ...http.CallOption) (*AdminDepositUnmatchedListReply, error) {
+	var out AdminDepositUnmatchedListReply
+	pattern := "/api/admin_dhb/deposit_unmatched_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminDepositUnmatchedList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminFee(ctx context.Context, in *AdminFeeRequest, opts ...http.CallOption) (*AdminFeeReply, error) {
 	var out AdminFeeReply
 	pattern := "/api/admin_dhb/fee"
@@ -333,6 +918,32 @@ func (c *AppHTTPClientImpl) AdminFee(ctx context.Context, in *AdminFeeRequest, o
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminJobRunList(ctx context.Context, in *AdminJobRunListRequest, opts ...http.CallOption) (*AdminJobRunListReply, error) {
+	var out AdminJobRunListReply
+	pattern := "/api/admin_dhb/job_run_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminJobRunList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminLogin(ctx context.Context, in *AdminLoginRequest, opts ...http.CallOption) (*AdminLoginReply, error) {
+	var out AdminLoginReply
+	pattern := "/api/admin_dhb/login"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminWithdraw(ctx context.Context, in *AdminWithdrawRequest, opts ...http.CallOption) (*AdminWithdrawReply, error) {
 	var out AdminWithdrawReply
 	pattern := "/api/admin_dhb/withdraw"
@@ -359,6 +970,58 @@ func (c *AppHTTPClientImpl) AdminWithdrawEth(ctx context.Context, in *AdminWithd
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminWithdrawEventList(ctx context.Context, in *AdminWithdrawEventListRequest, opts ...http.CallOption) (*AdminWithdrawEventListReply, error) {
+	var out AdminWithdrawEventListReply
+	pattern := "/api/admin_dhb/withdraw_event_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminWithdrawEventList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminWithdrawReceipt(ctx context.Context, in *AdminWithdrawReceiptRequest, opts ...http.CallOption) (*AdminWithdrawReceiptReply, error) {
+	var out AdminWithdrawReceiptReply
+	pattern := "/api/admin_dhb/withdraw_receipt"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminWithdrawReceipt))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminWithdrawUpdate(ctx context.Context, in *AdminWithdrawUpdateRequest, opts ...http.CallOption) (*AdminWithdrawUpdateReply, error) {
+	var out AdminWithdrawUpdateReply
+	pattern := "/api/admin_dhb/withdraw_update"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminWithdrawUpdate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) ChainList(ctx context.Context, in *ChainListRequest, opts ...http.CallOption) (*ChainListReply, error) {
+	var out ChainListReply
+	pattern := "/api/app_server/chains"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppChainList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) Deposit(ctx context.Context, in *DepositRequest, opts ...http.CallOption) (*DepositReply, error) {
 	var out DepositReply
 	pattern := "/api/admin_dhb/deposit"
@@ -372,6 +1035,58 @@ func (c *AppHTTPClientImpl) Deposit(ctx context.Context, in *DepositRequest, opt
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) DepositAddress(ctx context.Context, in *DepositAddressRequest, opts ...http.CallOption) (*DepositAddressReply, error) {
+	var out DepositAddressReply
+	pattern := "/api/app_server/deposit_address"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppDepositAddress))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) DepositPendingList(ctx context.Context, in *DepositPendingListRequest, opts ...http.CallOption) (*DepositPendingListReply, error) {
+	var out DepositPendingListReply
+	pattern := "/api/app_server/deposit_pending_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppDepositPendingList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) DepositUnmatchedList(ctx context.Context, in *DepositUnmatchedListRequest, opts ...http.CallOption) (*DepositUnmatchedListReply, error) {
+	var out DepositUnmatchedListReply
+	pattern := "/api/app_server/deposit_unmatched_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppDepositUnmatchedList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) DepositWebhook(ctx context.Context, in *DepositWebhookRequest, opts ...http.CallOption) (*DepositWebhookReply, error) {
+	var out DepositWebhookReply
+	pattern := "/api/deposit_webhook"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppDepositWebhook))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) EthAuthorize(ctx context.Context, in *EthAuthorizeRequest, opts ...http.CallOption) (*EthAuthorizeReply, error) {
 	var out EthAuthorizeReply
 	pattern := "/api/app_server/eth_authorize"
@@ -398,6 +1113,32 @@ func (c *AppHTTPClientImpl) FeeRewardList(ctx context.Context, in *FeeRewardList
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) GetAuthNonce(ctx context.Context, in *GetAuthNonceRequest, opts ...http.CallOption) (*GetAuthNonceReply, error) {
+	var out GetAuthNonceReply
+	pattern := "/api/app_server/auth_nonce"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppGetAuthNonce))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) Logout(ctx context.Context, in *LogoutRequest, opts ...http.CallOption) (*LogoutReply, error) {
+	var out LogoutReply
+	pattern := "/api/app_server/logout"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppLogout))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) RecommendList(ctx context.Context, in *RecommendListRequest, opts ...http.CallOption) (*RecommendListReply, error) {
 	var out RecommendListReply
 	pattern := "/api/app_server/recommend_list"
@@ -424,6 +1165,19 @@ func (c *AppHTTPClientImpl) RecommendRewardList(ctx context.Context, in *Recomme
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...http.CallOption) (*RefreshTokenReply, error) {
+	var out RefreshTokenReply
+	pattern := "/api/app_server/refresh_token"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppRefreshToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) RewardList(ctx context.Context, in *RewardListRequest, opts ...http.CallOption) (*RewardListReply, error) {
 	var out RewardListReply
 	pattern := "/api/app_server/reward_list"
@@ -437,6 +1191,19 @@ func (c *AppHTTPClientImpl) RewardList(ctx context.Context, in *RewardListReques
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) SubmitDepositTx(ctx context.Context, in *SubmitDepositTxRequest, opts ...http.CallOption) (*SubmitDepositTxReply, error) {
+	var out SubmitDepositTxReply
+	pattern := "/api/app_server/submit_deposit_tx"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppSubmitDepositTx))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) UserInfo(ctx context.Context, in *UserInfoRequest, opts ...http.CallOption) (*UserInfoReply, error) {
 	var out UserInfoReply
 	pattern := "/api/app_server/user_info"
@@ -463,6 +1230,19 @@ func (c *AppHTTPClientImpl) Withdraw(ctx context.Context, in *WithdrawRequest, o
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) WithdrawCancel(ctx context.Context, in *WithdrawCancelRequest, opts ...http.CallOption) (*WithdrawCancelReply, error) {
+	var out WithdrawCancelReply
+	pattern := "/api/app_server/withdraw_cancel"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppWithdrawCancel))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) WithdrawList(ctx context.Context, in *WithdrawListRequest, opts ...http.CallOption) (*WithdrawListReply, error) {
 	var out WithdrawListReply
 	pattern := "/api/app_server/withdraw_list"
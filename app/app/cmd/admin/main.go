@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/data"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 创建后台账号，新部署时用来创建第一个账号：
+//
+//	ADMIN_PASSWORD=xxx ./admin -conf /data/conf -account ops -role finance
+//
+// 未设置 ADMIN_PASSWORD 时从标准输入读取一行作为密码
+var (
+	flagconf string
+	account  string
+	role     string
+)
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.StringVar(&account, "account", "", "admin account")
+	flag.StringVar(&role, "role", "", "admin role: operator, finance, viewer")
+}
+
+func main() {
+	flag.Parse()
+	logger := log.NewStdLogger(os.Stdout)
+
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+
+	if err := createAdmin(&bc, logger); nil != err {
+		fmt.Fprintln(os.Stderr, "create admin:", err)
+		os.Exit(1)
+	}
+}
+
+func createAdmin(bc *conf.Bootstrap, logger log.Logger) error {
+	password := os.Getenv("ADMIN_PASSWORD")
+	if "" == password {
+		fmt.Print("password: ")
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if nil != err && "" == line {
+			return err
+		}
+		password = strings.TrimRight(line, "\r\n")
+	}
+
+	d, cleanup, err := data.NewData(bc.Data, logger, data.NewDB(bc.Data), data.NewRedis(bc.Data))
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	adc := biz.NewAdminUseCase(data.NewAdminRepo(d, logger), nil, logger)
+	admin, err := adc.CreateAdmin(context.Background(), account, password, role)
+	if nil != err {
+		return err
+	}
+
+	fmt.Printf("admin created: id=%d account=%s role=%s\n", admin.ID, admin.Account, admin.Role)
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/server"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -32,7 +33,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, hs *http.Server, js *server.Scheduler) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -41,6 +42,7 @@ func newApp(logger log.Logger, hs *http.Server) *kratos.App {
 		kratos.Logger(logger),
 		kratos.Server(
 			hs,
+			js,
 		),
 	)
 }
@@ -72,7 +74,13 @@ func main() {
 		panic(err)
 	}
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Auth, logger)
+	// 未配置 chains 时使用旧的单链配置 chain
+	chains := bc.Chains
+	if 0 >= len(chains) && nil != bc.Chain {
+		chains = []*conf.Chain{bc.Chain}
+	}
+
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Auth, bc.Scheduler, chains, logger)
 	if err != nil {
 		panic(err)
 	}
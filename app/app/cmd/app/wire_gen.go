@@ -10,6 +10,7 @@ import (
 	"dhb/app/app/internal/biz"
 	"dhb/app/app/internal/conf"
 	"dhb/app/app/internal/data"
+	"dhb/app/app/internal/pkg/middleware/auth"
 	"dhb/app/app/internal/server"
 	"dhb/app/app/internal/service"
 	"github.com/go-kratos/kratos/v2"
@@ -23,7 +24,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, confAuth *conf.Auth, scheduler *conf.Scheduler, arg []*conf.Chain, logger log.Logger) (*kratos.App, func(), error) {
 	db := data.NewDB(confData)
 	client := data.NewRedis(confData)
 	dataData, cleanup, err := data.NewData(confData, logger, db, client)
@@ -38,12 +39,40 @@ func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, logg
 	locationRepo := data.NewLocationRepo(dataData, logger)
 	userCurrentMonthRecommendRepo := data.NewUserCurrentMonthRecommendRepo(dataData, logger)
 	userBalanceRepo := data.NewUserBalanceRepo(dataData, logger)
-	userUseCase := biz.NewUserUseCase(userRepo, transaction, configRepo, userInfoRepo, userRecommendRepo, locationRepo, userCurrentMonthRecommendRepo, userBalanceRepo, logger)
 	ethUserRecordRepo := data.NewEthUserRecordRepo(dataData, logger)
-	recordUseCase := biz.NewRecordUseCase(ethUserRecordRepo, locationRepo, userBalanceRepo, userRecommendRepo, userInfoRepo, configRepo, userCurrentMonthRecommendRepo, transaction, logger)
-	appService := service.NewAppService(userUseCase, recordUseCase, logger, auth)
-	httpServer := server.NewHTTPServer(confServer, appService, logger)
-	app := newApp(logger, httpServer)
+	depositTierRepo := data.NewDepositTierRepo(dataData, logger)
+	recordUseCase := biz.NewRecordUseCase(ethUserRecordRepo, locationRepo, userBalanceRepo, userRecommendRepo, userInfoRepo, configRepo, userCurrentMonthRecommendRepo, depositTierRepo, transaction, logger)
+	withdrawEventRepo := data.NewWithdrawEventRepo(dataData, logger)
+	chains, err := data.NewChains(arg, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	withdrawUseCase := biz.NewWithdrawUseCase(userBalanceRepo, withdrawEventRepo, chains, transaction, logger)
+	userUseCase := biz.NewUserUseCase(userRepo, transaction, configRepo, userInfoRepo, userRecommendRepo, locationRepo, userCurrentMonthRecommendRepo, userBalanceRepo, recordUseCase, withdrawUseCase, chains, logger)
+	authRepo := data.NewAuthRepo(dataData, logger)
+	keyRing, err := auth.NewKeyRing(confAuth)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	authUseCase, err := biz.NewAuthUseCase(authRepo, confAuth, keyRing, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	adminRepo := data.NewAdminRepo(dataData, logger)
+	adminUseCase := biz.NewAdminUseCase(adminRepo, authUseCase, logger)
+	jobRepo := data.NewJobRepo(dataData, logger)
+	jobUseCase := biz.NewJobUseCase(jobRepo, scheduler, logger)
+	depositCursorRepo := data.NewDepositCursorRepo(dataData, logger)
+	depositPendingRepo := data.NewDepositPendingRepo(dataData, logger)
+	depositAddressRepo := data.NewDepositAddressRepo(dataData, logger)
+	depositUseCase := biz.NewDepositUseCase(chains, depositCursorRepo, depositPendingRepo, depositTierRepo, depositAddressRepo, userRepo, userBalanceRepo, ethUserRecordRepo, recordUseCase, withdrawUseCase, transaction, logger)
+	appService := service.NewAppService(userUseCase, recordUseCase, authUseCase, adminUseCase, jobUseCase, depositUseCase, withdrawUseCase, logger, confAuth, chains)
+	httpServer := server.NewHTTPServer(confServer, appService, authUseCase, adminUseCase, keyRing, logger)
+	serverScheduler := server.NewScheduler(scheduler, appService, logger)
+	app := newApp(logger, httpServer, serverScheduler)
 	return app, func() {
 		cleanup()
 	}, nil
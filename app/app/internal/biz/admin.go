@@ -0,0 +1,177 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/crypto/pbkdf2"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	AdminRoleOperator = "operator" // 运营：充值同步、配置
+	AdminRoleFinance  = "finance"  // 财务：提现打款、手续费分红、异常充值处理
+	AdminRoleViewer   = "viewer"   // 只读
+
+	AdminStatusNormal  = "normal"
+	AdminStatusDisable = "disable"
+
+	PermissionAdminRead   = "admin:read"
+	PermissionAdminConfig = "admin:config"
+	PermissionDepositSync = "deposit:sync"
+	PermissionWithdrawPay = "withdraw:pay"
+	PermissionFeePay      = "fee:pay"
+	PermissionDepositFix  = "deposit:fix"
+
+	adminPasswordIter   = 10000
+	adminPasswordMinLen = 8
+)
+
+// 角色拥有的权限
+var adminRolePermissions = map[string]map[string]bool{
+	AdminRoleOperator: {PermissionAdminRead: true, PermissionAdminConfig: true, PermissionDepositSync: true},
+	AdminRoleFinance:  {PermissionAdminRead: true, PermissionWithdrawPay: true, PermissionFeePay: true, PermissionDepositFix: true},
+	AdminRoleViewer:   {PermissionAdminRead: true},
+}
+
+type Admin struct {
+	ID        int64
+	Account   string
+	Password  string
+	Role      string
+	Status    string
+	CreatedAt time.Time
+}
+
+type AdminRepo interface {
+	GetAdminByAccount(ctx context.Context, account string) (*Admin, error)
+	GetAdminById(ctx context.Context, id int64) (*Admin, error)
+	CreateAdmin(ctx context.Context, a *Admin) (*Admin, error)
+}
+
+type AdminUseCase struct {
+	repo AdminRepo
+	auc  *AuthUseCase
+	log  *log.Helper
+}
+
+func NewAdminUseCase(repo AdminRepo, auc *AuthUseCase, logger log.Logger) *AdminUseCase {
+	return &AdminUseCase{
+		repo: repo,
+		auc:  auc,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// AdminLogin 后台账号密码登录，签发 UserType 为 admin 的 token
+func (adc *AdminUseCase) AdminLogin(ctx context.Context, account string, password string) (*AuthToken, error) {
+	if "" == account || "" == password {
+		return nil, errors.New(401, "ADMIN_LOGIN_ERROR", "账号或密码错误")
+	}
+
+	admin, err := adc.repo.GetAdminByAccount(ctx, account)
+	if nil != err {
+		if errors.IsNotFound(err) {
+			return nil, errors.New(401, "ADMIN_LOGIN_ERROR", "账号或密码错误")
+		}
+		return nil, err
+	}
+
+	if !VerifyAdminPassword(admin.Password, password) {
+		adc.log.Warnf("admin login failed: account=%s", account)
+		return nil, errors.New(401, "ADMIN_LOGIN_ERROR", "账号或密码错误")
+	}
+	if AdminStatusNormal != admin.Status {
+		return nil, errors.New(403, "ADMIN_DISABLED", "账号已禁用")
+	}
+
+	return adc.auc.IssueAdminToken(ctx, admin.ID)
+}
+
+// CheckPermission 每次请求都查库，角色和状态变更立即生效
+func (adc *AdminUseCase) CheckPermission(ctx context.Context, adminId int64, permission string) error {
+	admin, err := adc.repo.GetAdminById(ctx, adminId)
+	if nil != err {
+		if errors.IsNotFound(err) {
+			return errors.New(403, "ADMIN_FORBIDDEN", "无权限")
+		}
+		return err
+	}
+
+	if AdminStatusNormal != admin.Status {
+		return errors.New(403, "ADMIN_DISABLED", "账号已禁用")
+	}
+	if !adminRolePermissions[admin.Role][permission] {
+		return errors.New(403, "ADMIN_FORBIDDEN", "无权限")
+	}
+
+	return nil
+}
+
+// CreateAdmin 创建后台账号，由 cmd/admin 调用
+func (adc *AdminUseCase) CreateAdmin(ctx context.Context, account string, password string, role string) (*Admin, error) {
+	account = strings.TrimSpace(account)
+	if "" == account {
+		return nil, errors.New(500, "ADMIN_ACCOUNT_ERROR", "账号不能为空")
+	}
+	if _, ok := adminRolePermissions[role]; !ok {
+		return nil, errors.New(500, "ADMIN_ROLE_ERROR", "角色不存在: "+role)
+	}
+	if adminPasswordMinLen > len(password) {
+		return nil, errors.New(500, "ADMIN_PASSWORD_ERROR", "密码至少 "+strconv.Itoa(adminPasswordMinLen)+" 位")
+	}
+
+	_, err := adc.repo.GetAdminByAccount(ctx, account)
+	if nil == err {
+		return nil, errors.New(500, "ADMIN_EXISTS", "账号已存在")
+	}
+	if !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	hashed, err := HashAdminPassword(password)
+	if nil != err {
+		return nil, err
+	}
+
+	return adc.repo.CreateAdmin(ctx, &Admin{
+		Account:  account,
+		Password: hashed,
+		Role:     role,
+		Status:   AdminStatusNormal,
+	})
+}
+
+// HashAdminPassword 生成存库的密码：pbkdf2_sha256$迭代次数$salt$hash
+func HashAdminPassword(password string) (string, error) {
+	salt, err := randomHex(16)
+	if nil != err {
+		return "", err
+	}
+
+	return "pbkdf2_sha256$" + strconv.Itoa(adminPasswordIter) + "$" + salt + "$" +
+		hex.EncodeToString(pbkdf2.Key([]byte(password), []byte(salt), adminPasswordIter, sha256.Size, sha256.New)), nil
+}
+
+// VerifyAdminPassword .
+func VerifyAdminPassword(hashed string, password string) bool {
+	parts := strings.Split(hashed, "$")
+	if 4 != len(parts) || "pbkdf2_sha256" != parts[0] {
+		return false
+	}
+	iter, err := strconv.Atoi(parts[1])
+	if nil != err || 0 >= iter {
+		return false
+	}
+	want, err := hex.DecodeString(parts[3])
+	if nil != err {
+		return false
+	}
+
+	return 1 == subtle.ConstantTimeCompare(want, pbkdf2.Key([]byte(password), []byte(parts[2]), iter, sha256.Size, sha256.New))
+}
@@ -0,0 +1,410 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/pkg/middleware/auth"
+	"encoding/hex"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	jwt2 "github.com/golang-jwt/jwt/v4"
+	"strings"
+	"time"
+)
+
+const (
+	authNonceExpireDefault = 5 * time.Minute
+	authNonceUsedExpire    = 24 * time.Hour
+	siweIssuedAtSkew       = time.Minute
+	accessExpireDefault    = 15 * time.Minute
+	refreshExpireDefault   = 7 * 24 * time.Hour
+	adminExpireDefault     = 2 * time.Hour
+	authTokenIssuer        = "DHB"
+
+	AuthUserTypeUser  = "user"
+	AuthUserTypeAdmin = "admin"
+)
+
+type AuthNonce struct {
+	Address   string
+	Nonce     string
+	Message   string
+	ExpiresAt time.Time
+}
+
+type AuthRecord struct {
+	ID        int64
+	UserId    int64
+	Address   string
+	Message   string
+	TokenHash string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+type AuthToken struct {
+	Token        string
+	RefreshToken string
+	ExpiresAt    time.Time
+}
+
+// RefreshToken 刷新令牌，同一次登录轮换出的令牌属于同一个 session
+type RefreshToken struct {
+	UserId    int64
+	SessionId string
+}
+
+type AuthRepo interface {
+	CreateAuthNonce(ctx context.Context, address string, nonce string, expire time.Duration) error
+	GetAuthNonce(ctx context.Context, address string) (string, error)
+	UseAuthNonce(ctx context.Context, address string, nonce string, usedExpire time.Duration) (bool, error)
+	IsAuthNonceUsed(ctx context.Context, address string, nonce string) (bool, error)
+	CreateAuthRecord(ctx context.Context, r *AuthRecord) (*AuthRecord, error)
+	CreateRefreshToken(ctx context.Context, tokenHash string, rt *RefreshToken, expire time.Duration) error
+	GetRefreshToken(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, oldTokenHash string, newTokenHash string, rt *RefreshToken, expire time.Duration) (bool, error)
+	RevokeSession(ctx context.Context, sessionId string) error
+	RevokeToken(ctx context.Context, jti string, expire time.Duration) error
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
+	UseMachineSignature(ctx context.Context, keyId string, signature string, expire time.Duration) (bool, error)
+}
+
+type AuthUseCase struct {
+	repo           AuthRepo
+	ca             *conf.Auth
+	keyRing        *auth.KeyRing
+	machineSecrets map[string][]byte // key_id 对应的服务密钥，启动时读取
+	log            *log.Helper
+}
+
+func NewAuthUseCase(repo AuthRepo, ca *conf.Auth, keyRing *auth.KeyRing, logger log.Logger) (*AuthUseCase, error) {
+	secrets, err := machineSecrets(ca.GetMachineKeys())
+	if nil != err {
+		return nil, err
+	}
+
+	return &AuthUseCase{
+		repo:           repo,
+		ca:             ca,
+		keyRing:        keyRing,
+		machineSecrets: secrets,
+		log:            log.NewHelper(logger),
+	}, nil
+}
+
+// AuthMessage 钱包需要签名的登录内容
+func AuthMessage(address string, nonce string) string {
+	return "Welcome to DHB!\n\nSign this message to log in, it will not cost any gas.\n\nWallet address:\n" + address + "\n\nNonce:\n" + nonce
+}
+
+// GetAuthNonce 生成一次性登录随机数，覆盖该地址之前未使用的随机数
+func (auc *AuthUseCase) GetAuthNonce(ctx context.Context, address string) (*AuthNonce, error) {
+	if !common.IsHexAddress(address) {
+		return nil, errors.New(500, "AUTHORIZE_ERROR", "账户地址参数错误")
+	}
+
+	nonce, err := randomHex(16)
+	if nil != err {
+		return nil, err
+	}
+
+	expire := authNonceExpireDefault
+	if nil != auc.ca.NonceExpire && 0 < auc.ca.NonceExpire.AsDuration() {
+		expire = auc.ca.NonceExpire.AsDuration()
+	}
+
+	if err = auc.repo.CreateAuthNonce(ctx, address, nonce, expire); nil != err {
+		return nil, err
+	}
+
+	return &AuthNonce{
+		Address:   address,
+		Nonce:     nonce,
+		Message:   AuthMessage(address, nonce),
+		ExpiresAt: time.Now().Add(expire),
+	}, nil
+}
+
+// VerifyAuthSign 校验 personal_sign(EIP-191) 签名，成功后随机数作废
+func (auc *AuthUseCase) VerifyAuthSign(ctx context.Context, address string, nonce string, sign string) error {
+	var err error
+
+	if "" == nonce || "" == sign {
+		return errors.New(401, "SIGN_REQUIRED", "缺少签名参数")
+	}
+
+	if err = auc.checkAuthNonce(ctx, address, nonce); nil != err {
+		return err
+	}
+
+	if err = VerifyPersonalSign(address, AuthMessage(address, nonce), sign); nil != err {
+		return err
+	}
+
+	return auc.useAuthNonce(ctx, address, nonce)
+}
+
+// VerifySiwe 校验 Sign-In with Ethereum(EIP-4361) 消息及其签名，成功后随机数作废
+func (auc *AuthUseCase) VerifySiwe(ctx context.Context, address string, message string, sign string) (*SiweMessage, error) {
+	var (
+		siwe *SiweMessage
+		err  error
+	)
+
+	if "" == message || "" == sign {
+		return nil, errors.New(401, "SIGN_REQUIRED", "缺少签名参数")
+	}
+
+	siwe, err = ParseSiweMessage(message)
+	if nil != err {
+		return nil, err
+	}
+
+	if "" != auc.ca.Domain && !strings.EqualFold(auc.ca.Domain, siwe.Domain) {
+		return nil, errors.New(401, "SIWE_DOMAIN_MISMATCH", "签名消息域名不匹配")
+	}
+	if 0 != auc.ca.ChainId && auc.ca.ChainId != siwe.ChainId {
+		return nil, errors.New(401, "SIWE_CHAIN_MISMATCH", "签名消息链ID不匹配")
+	}
+	if !strings.EqualFold(address, siwe.Address) {
+		return nil, errors.New(401, "SIWE_ADDRESS_MISMATCH", "签名消息账户地址不匹配")
+	}
+
+	now := time.Now()
+	if !siwe.ExpirationTime.IsZero() && !now.Before(siwe.ExpirationTime) {
+		return nil, errors.New(401, "SIWE_EXPIRED", "签名消息已过期")
+	}
+	if !siwe.NotBefore.IsZero() && now.Before(siwe.NotBefore) {
+		return nil, errors.New(401, "SIWE_NOT_YET_VALID", "签名消息尚未生效")
+	}
+	if now.Add(siweIssuedAtSkew).Before(siwe.IssuedAt) {
+		return nil, errors.New(401, "SIWE_NOT_YET_VALID", "签名消息签发时间无效")
+	}
+
+	if err = auc.checkAuthNonce(ctx, address, siwe.Nonce); nil != err {
+		return nil, err
+	}
+
+	if err = VerifyPersonalSign(address, message, sign); nil != err {
+		return nil, err
+	}
+
+	if err = auc.useAuthNonce(ctx, address, siwe.Nonce); nil != err {
+		return nil, err
+	}
+
+	return siwe, nil
+}
+
+// CreateAuthRecord 记录登录时接受的签名消息和签发的token
+func (auc *AuthUseCase) CreateAuthRecord(ctx context.Context, r *AuthRecord) (*AuthRecord, error) {
+	return auc.repo.CreateAuthRecord(ctx, r)
+}
+
+// IssueToken 登录成功后签发访问令牌和刷新令牌，开启一个新的 session
+func (auc *AuthUseCase) IssueToken(ctx context.Context, userId int64) (*AuthToken, error) {
+	sessionId, err := randomHex(16)
+	if nil != err {
+		return nil, err
+	}
+
+	refreshToken, err := randomHex(32)
+	if nil != err {
+		return nil, err
+	}
+
+	rt := &RefreshToken{UserId: userId, SessionId: sessionId}
+	if err = auc.repo.CreateRefreshToken(ctx, TokenHash(refreshToken), rt, auc.refreshExpire()); nil != err {
+		return nil, err
+	}
+
+	return auc.createAccessToken(rt, refreshToken, AuthUserTypeUser, auc.accessExpire())
+}
+
+// RefreshToken 用刷新令牌换取新的访问令牌，刷新令牌同时轮换；
+// 已轮换过的刷新令牌再次使用视为泄露，整个 session 作废
+func (auc *AuthUseCase) RefreshToken(ctx context.Context, refreshToken string) (*AuthToken, error) {
+	if "" == refreshToken {
+		return nil, errors.New(401, "REFRESH_TOKEN_INVALID", "刷新令牌无效")
+	}
+
+	oldTokenHash := TokenHash(refreshToken)
+	rt, err := auc.repo.GetRefreshToken(ctx, oldTokenHash)
+	if nil != err {
+		return nil, err
+	}
+	if nil == rt {
+		return nil, errors.New(401, "REFRESH_TOKEN_INVALID", "刷新令牌无效")
+	}
+
+	newRefreshToken, err := randomHex(32)
+	if nil != err {
+		return nil, err
+	}
+
+	ok, err := auc.repo.RotateRefreshToken(ctx, oldTokenHash, TokenHash(newRefreshToken), rt, auc.refreshExpire())
+	if nil != err {
+		return nil, err
+	}
+	if !ok {
+		auc.log.Warnf("refresh token reused, session revoked: user_id=%d session_id=%s", rt.UserId, rt.SessionId)
+		return nil, errors.New(401, "REFRESH_TOKEN_REVOKED", "登录已失效，请重新登录")
+	}
+
+	return auc.createAccessToken(rt, newRefreshToken, AuthUserTypeUser, auc.accessExpire())
+}
+
+// IssueAdminToken 后台 token 不带刷新令牌，过期后重新登录
+func (auc *AuthUseCase) IssueAdminToken(ctx context.Context, adminId int64) (*AuthToken, error) {
+	expire := adminExpireDefault
+	if nil != auc.ca.AdminExpire && 0 < auc.ca.AdminExpire.AsDuration() {
+		expire = auc.ca.AdminExpire.AsDuration()
+	}
+
+	return auc.createAccessToken(&RefreshToken{UserId: adminId}, "", AuthUserTypeAdmin, expire)
+}
+
+// Logout 注销当前访问令牌，并作废所在 session 的刷新令牌
+func (auc *AuthUseCase) Logout(ctx context.Context, jti string, sessionId string, expiresAt time.Time) error {
+	if "" == jti {
+		return errors.New(401, "ERROR_TOKEN", "无效TOKEN")
+	}
+
+	if expire := time.Until(expiresAt); 0 < expire {
+		if err := auc.repo.RevokeToken(ctx, jti, expire); nil != err {
+			return err
+		}
+	}
+
+	if "" != sessionId {
+		return auc.repo.RevokeSession(ctx, sessionId)
+	}
+
+	return nil
+}
+
+// IsTokenRevoked 访问令牌是否已注销
+func (auc *AuthUseCase) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	return auc.repo.IsTokenRevoked(ctx, jti)
+}
+
+func (auc *AuthUseCase) createAccessToken(rt *RefreshToken, refreshToken string, userType string, expire time.Duration) (*AuthToken, error) {
+	jti, err := randomHex(16)
+	if nil != err {
+		return nil, err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(expire)
+	token, err := auc.keyRing.CreateToken(auth.CustomClaims{
+		UserId:    rt.UserId,
+		UserType:  userType,
+		SessionId: rt.SessionId,
+		StandardClaims: jwt2.StandardClaims{
+			Id:        jti,
+			NotBefore: now.Unix(),       // 签名的生效时间
+			ExpiresAt: expiresAt.Unix(), // 过期时间
+			Issuer:    authTokenIssuer,
+		},
+	})
+	if nil != err {
+		return nil, errors.New(500, "AUTHORIZE_ERROR", "生成token失败")
+	}
+
+	return &AuthToken{
+		Token:        token,
+		RefreshToken: refreshToken,
+		ExpiresAt:    time.Unix(expiresAt.Unix(), 0),
+	}, nil
+}
+
+func (auc *AuthUseCase) accessExpire() time.Duration {
+	if nil != auc.ca.AccessExpire && 0 < auc.ca.AccessExpire.AsDuration() {
+		return auc.ca.AccessExpire.AsDuration()
+	}
+	return accessExpireDefault
+}
+
+func (auc *AuthUseCase) refreshExpire() time.Duration {
+	if nil != auc.ca.RefreshExpire && 0 < auc.ca.RefreshExpire.AsDuration() {
+		return auc.ca.RefreshExpire.AsDuration()
+	}
+	return refreshExpireDefault
+}
+
+// checkAuthNonce 随机数必须是该地址当前有效的随机数
+func (auc *AuthUseCase) checkAuthNonce(ctx context.Context, address string, nonce string) error {
+	storeNonce, err := auc.repo.GetAuthNonce(ctx, address)
+	if nil != err {
+		return err
+	}
+
+	if storeNonce != nonce {
+		used, err := auc.repo.IsAuthNonceUsed(ctx, address, nonce)
+		if nil != err {
+			return err
+		}
+		if used {
+			return errors.New(401, "NONCE_REPLAYED", "签名已被使用")
+		}
+		return errors.New(401, "NONCE_EXPIRED", "签名随机数已过期，请重新获取")
+	}
+
+	return nil
+}
+
+// useAuthNonce 原子地作废随机数，并发时只有一个请求能通过
+func (auc *AuthUseCase) useAuthNonce(ctx context.Context, address string, nonce string) error {
+	ok, err := auc.repo.UseAuthNonce(ctx, address, nonce, authNonceUsedExpire)
+	if nil != err {
+		return err
+	}
+	if !ok {
+		return errors.New(401, "NONCE_REPLAYED", "签名已被使用")
+	}
+
+	return nil
+}
+
+// VerifyPersonalSign 恢复 personal_sign 签名的地址并与 address 比较
+func VerifyPersonalSign(address string, message string, sign string) error {
+	sig, err := hexutil.Decode(sign)
+	if nil != err || 65 != len(sig) {
+		return errors.New(401, "SIGN_INVALID", "签名格式错误")
+	}
+	if 27 <= sig[64] { // 钱包返回的 v 为 27/28
+		sig[64] -= 27
+	}
+
+	hash := crypto.Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(message), message)))
+	pubKey, err := crypto.SigToPub(hash, sig)
+	if nil != err {
+		return errors.New(401, "SIGN_INVALID", "签名格式错误")
+	}
+
+	if !strings.EqualFold(crypto.PubkeyToAddress(*pubKey).Hex(), address) {
+		return errors.New(401, "SIGN_MISMATCH", "签名与账户地址不匹配")
+	}
+
+	return nil
+}
+
+// TokenHash 令牌只以 sha256 的形式落库和存入缓存
+func TokenHash(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); nil != err {
+		return "", errors.New(500, "RANDOM_ERROR", "生成随机数失败")
+	}
+	return hex.EncodeToString(b), nil
+}
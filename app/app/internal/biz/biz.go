@@ -6,7 +6,7 @@ import (
 )
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewUserUseCase, NewRecordUseCase)
+var ProviderSet = wire.NewSet(NewUserUseCase, NewRecordUseCase, NewAuthUseCase, NewAdminUseCase, NewJobUseCase, NewDepositUseCase, NewWithdrawUseCase)
 
 // Transaction 新增事务接口方法
 type Transaction interface {
@@ -0,0 +1,147 @@
+package biz
+
+import (
+	"dhb/app/app/internal/conf"
+	"github.com/go-kratos/kratos/v2/errors"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+const (
+	chainIdDefault = 56 // 未配置 chain_id 时为 BSC 主网
+	tokenDecimals  = 18 // 系统内代币金额统一按 18 位小数记录，入账和打款时按代币精度换算
+)
+
+// ErrChainNotFound 请求的 chain ID 未配置
+var ErrChainNotFound = errors.New(500, "CHAIN_NOT_FOUND", "不支持的链")
+
+// Chain 一条链的配置、充值数据来源、专属充值地址钱包和打款账户
+type Chain struct {
+	Conf      *conf.Chain
+	Source    DepositSource
+	Wallet    DepositWallet
+	Signer    Signer // 打款地址，未配置时为 nil
+	GasSigner Signer // 为打款地址补充手续费，未配置时为 nil
+	Payer     Payer  // 用 Signer 打款，未配置 Signer 时为 nil
+	GasPayer  Payer  // 用 GasSigner 补充手续费，未配置 GasSigner 时为 nil
+}
+
+// Chains 配置的所有链，第一条为默认链
+type Chains []*Chain
+
+// Get 按 chain ID 查找，chainId 为 0 时返回默认链
+func (cs Chains) Get(chainId int64) (*Chain, error) {
+	if 0 >= len(cs) {
+		return nil, ErrChainNotFound
+	}
+	if 0 == chainId {
+		return cs[0], nil
+	}
+	for _, v := range cs {
+		if chainId == v.ChainId() {
+			return v, nil
+		}
+	}
+
+	return nil, ErrChainNotFound
+}
+
+// hasToken 任一条链配置了该代币
+func (cs Chains) hasToken(symbol string) bool {
+	for _, v := range cs {
+		if nil != v.Token(symbol) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ChainId 与交易 hash、日志序号一起唯一确定一条入账记录
+func (c *Chain) ChainId() int64 {
+	if 0 < c.Conf.GetChainId() {
+		return c.Conf.GetChainId()
+	}
+	return chainIdDefault
+}
+
+// Name 配置的名称，未配置时为 chain ID
+func (c *Chain) Name() string {
+	if "" != c.Conf.GetName() {
+		return c.Conf.GetName()
+	}
+	return strconv.FormatInt(c.ChainId(), 10)
+}
+
+// Confirmations 入账需要的确认数
+func (c *Chain) Confirmations() int64 {
+	return c.Conf.GetConfirmations()
+}
+
+// Tokens 配置的充值代币，未配置时只有 usdt_contract
+func (c *Chain) Tokens() []*DepositToken {
+	res := make([]*DepositToken, 0, len(c.Conf.GetTokens()))
+	for _, v := range c.Conf.GetTokens() {
+		decimals := v.GetDecimals()
+		if 0 >= decimals {
+			decimals = tokenDecimals
+		}
+		res = append(res, &DepositToken{Symbol: strings.ToUpper(v.GetSymbol()), Contract: v.GetContract(), Decimals: decimals})
+	}
+	if 0 >= len(res) {
+		res = append(res, &DepositToken{Symbol: "USDT", Contract: c.Conf.GetUsdtContract(), Decimals: tokenDecimals})
+	}
+
+	return res
+}
+
+// Token 按代币名称查找
+func (c *Chain) Token(symbol string) *DepositToken {
+	for _, v := range c.Tokens() {
+		if strings.EqualFold(symbol, v.Symbol) {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// TokenByContract 按合约地址查找
+func (c *Chain) TokenByContract(contract string) *DepositToken {
+	for _, v := range c.Tokens() {
+		if strings.EqualFold(contract, v.Contract) {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// normalize 链上最小单位的金额换算为 18 位小数
+func (t *DepositToken) normalize(value string) string {
+	if tokenDecimals == t.Decimals {
+		return value
+	}
+	amount, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return value
+	}
+
+	return scaleDecimals(amount, t.Decimals, tokenDecimals).String()
+}
+
+// ChainValue 18 位小数的金额换算为链上最小单位，用于打款
+func (t *DepositToken) ChainValue(value *big.Int) *big.Int {
+	return scaleDecimals(value, tokenDecimals, t.Decimals)
+}
+
+func scaleDecimals(value *big.Int, from int64, to int64) *big.Int {
+	if from == to {
+		return new(big.Int).Set(value)
+	}
+	if from < to {
+		return new(big.Int).Mul(value, new(big.Int).Exp(big.NewInt(10), big.NewInt(to-from), nil))
+	}
+	return new(big.Int).Quo(value, new(big.Int).Exp(big.NewInt(10), big.NewInt(from-to), nil))
+}
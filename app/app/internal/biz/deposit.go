@@ -0,0 +1,970 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	depositScanRangeDefault   = 2000
+	depositScanStepMax        = 50  // 每次任务最多推进的区间数，避免单次任务过长
+	depositReorgWindowDefault = 200 // 入账后复查的区块数
+	depositPairWindowDefault  = 200 // 组合档位两笔转账的最大区块间隔
+
+	DepositPendingStatusPending   = "pending"   // 未达到确认数
+	DepositPendingStatusConfirmed = "confirmed" // 已确认并入账
+	DepositPendingStatusDropped   = "dropped"   // 确认前已从链上消失
+
+	EthUserRecordStatusReorg           = "reorg"            // 入账后交易被回滚，待管理员审核
+	EthUserRecordStatusUnmatched       = "unmatched"        // 金额不匹配任何档位，待管理员处理
+	EthUserRecordStatusUnmatchedCredit = "unmatched_credit" // 未匹配充值已入余额
+	EthUserRecordStatusUnmatchedRefund = "unmatched_refund" // 未匹配充值已排队退款
+	EthUserRecordStatusOrphan          = "orphan"           // 发送地址还未注册，登录时认领
+	EthUserRecordStatusClaimed         = "claimed"          // 注册后已认领，由充值任务入账
+	EthUserRecordStatusUnpaired        = "unpaired"         // 组合档位等待另一种代币的转账
+	EthUserRecordStatusRetry           = "retry"            // 已有运行中的占位或入账失败，扫描任务重试
+	RewardReviewReorg                  = "reorg"
+
+	UnmatchedActionCredit = "credit" // 入余额
+	UnmatchedActionPlace  = "place"  // 放入不高于金额的最大档位，差额入余额
+	UnmatchedActionRefund = "refund" // 链上退回
+)
+
+// ErrDepositRangeTooLarge 区间内转账超过数据来源单次可返回的数量，需要缩小区间
+var ErrDepositRangeTooLarge = errors.New(500, "DEPOSIT_RANGE_TOO_LARGE", "查询区块范围过大")
+
+var (
+	ErrDepositTxNotFound = errors.NotFound("DEPOSIT_TX_NOT_FOUND", "交易未上链，请稍后再试")
+	ErrDepositTxFailed   = errors.New(500, "DEPOSIT_TX_FAILED", "交易执行失败")
+)
+
+// DepositTransfer 转入收款地址的链上代币转账
+type DepositTransfer struct {
+	Hash        string
+	From        string
+	To          string
+	Value       string // 代币最小单位的十进制字符串，按配置的代币补全后换算为 18 位小数
+	TokenSymbol string
+	Contract    string
+	BlockNumber uint64
+	TxIndex     int64 // 交易在区块内的序号
+	LogIndex    int64 // 日志在区块内的序号
+	UserId      int64 // 转入专属充值地址时为地址所属用户，转入公共收款地址时为 0
+}
+
+// DepositCursor 每条链的扫描游标，链上所有充值代币在同一区间一起扫描，(BlockNumber, LogIndex) 及之前的转账都已处理。
+// LogIndex 在区块内唯一，不区分代币合约
+type DepositCursor struct {
+	ID          int64
+	ChainId     int64
+	BlockNumber uint64
+	LogIndex    int64
+	UpdatedAt   time.Time
+}
+
+// DepositSource 充值数据来源，每条链按 conf.Chain.source 选择实现
+type DepositSource interface {
+	// LatestBlock 当前最新区块高度
+	LatestBlock(ctx context.Context) (uint64, error)
+	// ListTransfers 查询 [fromBlock, toBlock] 区间内转入 addresses 中任一地址的 contract 代币转账
+	ListTransfers(ctx context.Context, contract string, addresses []string, fromBlock uint64, toBlock uint64) ([]*DepositTransfer, error)
+	// TransactionTransfers 交易回执中的全部 ERC20 转账，未上链返回 ErrDepositTxNotFound，执行失败返回 ErrDepositTxFailed
+	TransactionTransfers(ctx context.Context, hash string) ([]*DepositTransfer, error)
+}
+
+// DepositToken 扫描的充值代币
+type DepositToken struct {
+	Symbol   string
+	Contract string
+	Decimals int64
+}
+
+// DepositPending 已上链但未达到确认数的充值，用户可见
+type DepositPending struct {
+	ID            int64
+	ChainId       int64
+	UserId        int64
+	Hash          string
+	Contract      string
+	CoinType      string
+	Amount        string
+	BlockNumber   uint64
+	LogIndex      int64
+	Confirmations int64
+	Status        string
+	CreatedAt     time.Time
+}
+
+type DepositCursorRepo interface {
+	GetDepositCursor(ctx context.Context, chainId int64) (*DepositCursor, error)
+	SaveDepositCursor(ctx context.Context, c *DepositCursor) error
+}
+
+type DepositPendingRepo interface {
+	SaveDepositPending(ctx context.Context, p *DepositPending) error
+	UpdateDepositPendingStatus(ctx context.Context, chainId int64, status string, hash ...string) error
+	GetDepositPendingByBlock(ctx context.Context, chainId int64, contract string, toBlock uint64) ([]*DepositPending, error)
+	DropDepositPending(ctx context.Context, id ...int64) (int64, error)
+	GetDepositPendingByUserId(ctx context.Context, userId int64) ([]*DepositPending, error)
+}
+
+type DepositUseCase struct {
+	chains             Chains
+	cursorRepo         DepositCursorRepo
+	pendingRepo        DepositPendingRepo
+	tierRepo           DepositTierRepo
+	depositAddressRepo DepositAddressRepo
+	userRepo           UserRepo
+	ubRepo             UserBalanceRepo
+	ethUserRecordRepo  EthUserRecordRepo
+	ruc                *RecordUseCase
+	wuc                *WithdrawUseCase
+	tx                 Transaction
+	log                *log.Helper
+}
+
+func NewDepositUseCase(chains Chains, cursorRepo DepositCursorRepo, pendingRepo DepositPendingRepo, tierRepo DepositTierRepo, depositAddressRepo DepositAddressRepo, userRepo UserRepo, ubRepo UserBalanceRepo, ethUserRecordRepo EthUserRecordRepo, ruc *RecordUseCase, wuc *WithdrawUseCase, tx Transaction, logger log.Logger) *DepositUseCase {
+	return &DepositUseCase{
+		chains:             chains,
+		cursorRepo:         cursorRepo,
+		pendingRepo:        pendingRepo,
+		tierRepo:           tierRepo,
+		depositAddressRepo: depositAddressRepo,
+		userRepo:           userRepo,
+		ubRepo:             ubRepo,
+		ethUserRecordRepo:  ethUserRecordRepo,
+		ruc:                ruc,
+		wuc:                wuc,
+		tx:                 tx,
+		log:                log.NewHelper(logger),
+	}
+}
+
+// Scan 依次扫描每条链，返回入账条数；一条链出错时继续扫描其他链，返回第一个错误
+func (duc *DepositUseCase) Scan(ctx context.Context) (int64, error) {
+	var (
+		count    int64
+		firstErr error
+	)
+	for _, c := range duc.chains {
+		handled, err := duc.scanChain(ctx, c)
+		count += handled
+		if nil != err {
+			duc.log.Errorf("deposit scan: chain %s, %v", c.Name(), err)
+			if nil == firstErr {
+				firstErr = err
+			}
+		}
+	}
+
+	return count, firstErr
+}
+
+// scanChain 从游标处按区间向前扫描链上所有充值代币，只入账达到确认数的区块，每个区间入账成功后才提交游标，返回入账条数。
+// 未达到确认数的转账记为 pending，入账后在 reorg_window 内复查是否被回滚
+func (duc *DepositUseCase) scanChain(ctx context.Context, c *Chain) (int64, error) {
+	var (
+		tokens = c.Tokens()
+		count  int64
+	)
+
+	watch, err := duc.depositWatch(ctx, c)
+	if nil != err {
+		return 0, err
+	}
+	if 0 >= len(watch.addresses) {
+		return 0, nil
+	}
+
+	latest, err := c.Source.LatestBlock(ctx)
+	if nil != err {
+		return 0, err
+	}
+
+	var safe uint64 // 已达到确认数的最高区块
+	if confirmations := uint64(c.Confirmations()); latest > confirmations {
+		safe = latest - confirmations
+	}
+
+	scanRange := uint64(depositScanRangeDefault)
+	if 0 < c.Conf.GetScanRange() {
+		scanRange = uint64(c.Conf.GetScanRange())
+	}
+
+	cursor, err := duc.cursorRepo.GetDepositCursor(ctx, c.ChainId())
+	if nil != err {
+		return 0, err
+	}
+	if nil == cursor {
+		cursor = &DepositCursor{ChainId: c.ChainId(), LogIndex: -1}
+		if 0 < c.Conf.GetStartBlock() {
+			cursor.BlockNumber = uint64(c.Conf.GetStartBlock())
+		} else if safe > scanRange {
+			cursor.BlockNumber = safe - scanRange
+		}
+	}
+
+	// 之前未能入账的先按链上顺序重试，再处理用户注册后认领的
+	retried, err := duc.retryDeposits(ctx, c)
+	if nil != err {
+		return 0, err
+	}
+	count += retried
+
+	claimed, err := duc.claimedDeposits(ctx, c)
+	if nil != err {
+		return count, err
+	}
+	count += claimed
+
+	step := scanRange
+	for i := 0; i < depositScanStepMax && cursor.BlockNumber < safe; i++ {
+		// 游标所在区块重新查询，已处理的按 LogIndex 跳过
+		fromBlock := cursor.BlockNumber
+		toBlock := fromBlock + step
+		if toBlock > safe {
+			toBlock = safe
+		}
+
+		transfers, err := duc.listTransfers(ctx, c, watch, fromBlock, toBlock)
+		if nil != err {
+			if errors.Is(err, ErrDepositRangeTooLarge) && 1 < step {
+				step /= 2
+				i--
+				continue
+			}
+			return count, err
+		}
+
+		transfers = afterCursor(transfers, cursor)
+		handled, err := duc.handleTransfers(ctx, c, transfers)
+		if nil != err {
+			return count, err
+		}
+		count += handled
+
+		paired, err := duc.pairDeposits(ctx, c, toBlock)
+		if nil != err {
+			return count, err
+		}
+		count += paired
+
+		cursor.BlockNumber = toBlock
+		cursor.LogIndex = -1
+		for _, v := range transfers {
+			if toBlock == v.BlockNumber && v.LogIndex > cursor.LogIndex {
+				cursor.LogIndex = v.LogIndex
+			}
+		}
+		if err = duc.cursorRepo.SaveDepositCursor(ctx, cursor); nil != err {
+			return count, err
+		}
+
+		step = scanRange
+	}
+
+	// 游标之前的区块都已确认，仍是 pending 的转账逐条核对是否还在链上
+	for _, v := range tokens {
+		if err = duc.dropPending(ctx, c, v, watch, cursor.BlockNumber, scanRange); nil != err {
+			return count, err
+		}
+	}
+
+	if cursor.BlockNumber >= safe && safe < latest {
+		if err = duc.savePending(ctx, c, watch, safe+1, latest); nil != err {
+			return count, err
+		}
+	}
+
+	for _, v := range tokens {
+		if err = duc.checkReorg(ctx, c, v, watch, cursor.BlockNumber); nil != err {
+			return count, err
+		}
+	}
+
+	return count, nil
+}
+
+// listTransfers 查询区间内转入收款地址的所有充值代币转账，金额换算为 18 位小数
+func (duc *DepositUseCase) listTransfers(ctx context.Context, c *Chain, watch *depositWatch, fromBlock uint64, toBlock uint64) ([]*DepositTransfer, error) {
+	res := make([]*DepositTransfer, 0)
+	for _, token := range c.Tokens() {
+		transfers, err := c.Source.ListTransfers(ctx, token.Contract, watch.addresses, fromBlock, toBlock)
+		if nil != err {
+			return nil, err
+		}
+		for _, v := range transfers {
+			depositTransferToken(v, token, watch)
+		}
+		res = append(res, transfers...)
+	}
+
+	return res, nil
+}
+
+// depositTransferToken 按配置的代币和收款地址补全转账信息
+func depositTransferToken(v *DepositTransfer, token *DepositToken, watch *depositWatch) {
+	v.TokenSymbol = token.Symbol // 以配置的名称为准
+	v.Contract = token.Contract
+	v.Value = token.normalize(v.Value)
+	v.UserId = watch.owners[strings.ToLower(v.To)]
+}
+
+// Confirmations 链上入账需要的确认数，链未配置时为 0
+func (duc *DepositUseCase) Confirmations(chainId int64) int64 {
+	c, err := duc.chains.Get(chainId)
+	if nil != err {
+		return 0
+	}
+	return c.Confirmations()
+}
+
+// GetDepositPendingList 用户未确认的充值
+func (duc *DepositUseCase) GetDepositPendingList(ctx context.Context, userId int64) ([]*DepositPending, error) {
+	return duc.pendingRepo.GetDepositPendingByUserId(ctx, userId)
+}
+
+// GetReorgRecords 被回滚待审核的入账记录
+func (duc *DepositUseCase) GetReorgRecords(ctx context.Context, page int64) ([]*EthUserRecord, error, int64) {
+	return duc.ethUserRecordRepo.GetEthUserRecordListByStatus(ctx, &Pagination{
+		PageNum:  int(page),
+		PageSize: 10,
+	}, EthUserRecordStatusReorg)
+}
+
+// GetUnmatchedList 用户未匹配档位的充值及处理结果
+func (duc *DepositUseCase) GetUnmatchedList(ctx context.Context, userId int64) ([]*EthUserRecord, error) {
+	return duc.ethUserRecordRepo.GetEthUserRecordListByUserId(ctx, userId,
+		EthUserRecordStatusUnmatched, EthUserRecordStatusUnmatchedCredit, EthUserRecordStatusUnmatchedRefund, EthUserRecordStatusClaimed, EthUserRecordStatusUnpaired, EthUserRecordStatusRetry)
+}
+
+// GetUnmatchedRecords 未匹配档位和未认领的充值，status 为空时返回全部
+func (duc *DepositUseCase) GetUnmatchedRecords(ctx context.Context, page int64, status string) ([]*EthUserRecord, error, int64) {
+	statuses := []string{EthUserRecordStatusUnmatched, EthUserRecordStatusUnmatchedCredit, EthUserRecordStatusUnmatchedRefund, EthUserRecordStatusOrphan, EthUserRecordStatusClaimed, EthUserRecordStatusUnpaired, EthUserRecordStatusRetry}
+	if "" != status {
+		statuses = []string{status}
+	}
+
+	return duc.ethUserRecordRepo.GetEthUserRecordListByStatus(ctx, &Pagination{
+		PageNum:  int(page),
+		PageSize: 10,
+	}, statuses...)
+}
+
+// HandleUnmatched 管理员处理未匹配档位的充值，actor 为操作的管理员
+func (duc *DepositUseCase) HandleUnmatched(ctx context.Context, id int64, action string, actor string) error {
+	record, err := duc.ethUserRecordRepo.GetEthUserRecordById(ctx, id)
+	if nil != err {
+		return err
+	}
+	if EthUserRecordStatusUnmatched != record.Status {
+		return errors.New(500, "ETH_USER_RECORD_STATUS_ERROR", "充值记录已处理")
+	}
+	value := depositValue(record.Amount)
+	if 0 >= value {
+		return errors.New(500, "DEPOSIT_AMOUNT_ERROR", "充值金额过小")
+	}
+
+	switch action {
+	case UnmatchedActionCredit:
+		return duc.tx.ExecTx(ctx, func(ctx context.Context) error {
+			if err = duc.ethUserRecordRepo.UpdateEthUserRecordStatus(ctx, record.ID, EthUserRecordStatusUnmatched, EthUserRecordStatusUnmatchedCredit); nil != err {
+				return err
+			}
+			_, err = depositBalance(ctx, duc.ubRepo, record.CoinType, record.UserId, value)
+			return err
+		})
+	case UnmatchedActionRefund:
+		return duc.tx.ExecTx(ctx, func(ctx context.Context) error {
+			if err = duc.ethUserRecordRepo.UpdateEthUserRecordStatus(ctx, record.ID, EthUserRecordStatusUnmatched, EthUserRecordStatusUnmatchedRefund); nil != err {
+				return err
+			}
+			// 从充值所在链退回转账的发送地址
+			_, err = duc.wuc.Refund(ctx, &Withdraw{
+				UserId:  record.UserId,
+				Amount:  value,
+				Type:    strings.ToLower(record.CoinType),
+				ChainId: record.ChainId,
+				Address: record.Address,
+			}, actor, fmt.Sprintf("unmatched deposit %d refund", record.ID))
+			return err
+		})
+	case UnmatchedActionPlace:
+		return duc.placeUnmatched(ctx, record, value)
+	default:
+		return errors.New(500, "UNMATCHED_ACTION_ERROR", "未知的处理方式")
+	}
+}
+
+// placeUnmatched 放入不高于充值金额的最大档位，差额入余额，占位和差额在同一事务中
+func (duc *DepositUseCase) placeUnmatched(ctx context.Context, record *EthUserRecord, value int64) error {
+	tiers, err := getDepositTiers(ctx, duc.tierRepo)
+	if nil != err {
+		return err
+	}
+
+	var tier *DepositTier
+	for _, v := range tiers {
+		if !v.Enabled || "" != v.PairToken || !strings.EqualFold(record.CoinType, v.Token) || v.Value() > value {
+			continue
+		}
+		if nil == tier || v.Value() > tier.Value() {
+			tier = v
+		}
+	}
+	if nil == tier {
+		return errors.New(500, "DEPOSIT_TIER_NOT_FOUND", "充值金额低于最小档位")
+	}
+
+	placed := *record
+	placed.Status = "success"
+	placed.Amount = tier.Amount // 按档位金额占位，记录中保留实际金额
+	return duc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		skipped, err := duc.ruc.EthUserRecordHandle(ctx, &placed)
+		if nil != err {
+			return err
+		}
+		if 0 < len(skipped) {
+			if EthUserRecordSkipRunning == skipped[0].Reason {
+				return errors.New(500, "DEPOSIT_PLACE_ERROR", "占位失败，用户已有运行中的占位")
+			}
+			return errors.New(500, "DEPOSIT_PLACE_ERROR", "占位失败")
+		}
+
+		if remain := value - tier.Value(); 0 < remain {
+			if _, err = depositBalance(ctx, duc.ubRepo, record.CoinType, record.UserId, remain); nil != err {
+				duc.log.Errorf("deposit place: record %d remain %d not credited: %v", record.ID, remain, err)
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// savePending 记录 [fromBlock, latest] 内未达到确认数的充值
+func (duc *DepositUseCase) savePending(ctx context.Context, c *Chain, watch *depositWatch, fromBlock uint64, latest uint64) error {
+	transfers, err := duc.listTransfers(ctx, c, watch, fromBlock, latest)
+	if nil != err {
+		return err
+	}
+
+	return duc.savePendingTransfers(ctx, c, transfers, latest)
+}
+
+// savePendingTransfers 按 latest 计算确认数记录未确认的转账
+func (duc *DepositUseCase) savePendingTransfers(ctx context.Context, c *Chain, transfers []*DepositTransfer, latest uint64) error {
+	records, unmatched, err := duc.matchTransfers(ctx, c, transfers)
+	if nil != err {
+		return err
+	}
+
+	for _, v := range append(records, unmatched...) {
+		if 0 >= v.UserId { // 未注册的地址没有用户可展示
+			continue
+		}
+		token := c.Token(v.CoinType)
+		if nil == token {
+			continue
+		}
+		if err = duc.pendingRepo.SaveDepositPending(ctx, &DepositPending{
+			ChainId:       c.ChainId(),
+			UserId:        v.UserId,
+			Hash:          v.Hash,
+			Contract:      token.Contract,
+			CoinType:      token.Symbol,
+			Amount:        v.Amount,
+			BlockNumber:   v.BlockNumber,
+			LogIndex:      v.LogIndex,
+			Confirmations: int64(latest - v.BlockNumber + 1),
+			Status:        DepositPendingStatusPending,
+		}); nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dropPending 核对 toBlock 及之前仍是 pending 的转账，按 (hash, logIndex) 不在链上的标记为 dropped，
+// 在链上且已有入账记录的标记为 confirmed，在链上但没有入账记录的保持 pending
+func (duc *DepositUseCase) dropPending(ctx context.Context, c *Chain, token *DepositToken, watch *depositWatch, toBlock uint64, scanRange uint64) error {
+	pending, err := duc.pendingRepo.GetDepositPendingByBlock(ctx, c.ChainId(), token.Contract, toBlock)
+	if nil != err {
+		return err
+	}
+
+	// 按 scanRange 分段查询链上转账
+	for start := 0; start < len(pending); {
+		end := start
+		for end < len(pending) && pending[end].BlockNumber <= pending[start].BlockNumber+scanRange {
+			end++
+		}
+		group := pending[start:end]
+		start = end
+
+		transfers, err := c.Source.ListTransfers(ctx, token.Contract, watch.addresses, group[0].BlockNumber, group[len(group)-1].BlockNumber)
+		if nil != err {
+			return err
+		}
+		onChain := make(map[EthUserRecordKey]bool, len(transfers))
+		for _, v := range transfers {
+			onChain[EthUserRecordKey{ChainId: c.ChainId(), Hash: v.Hash, LogIndex: v.LogIndex}] = true
+		}
+
+		var (
+			dropped = make([]int64, 0)
+			keys    = make([]EthUserRecordKey, 0, len(group))
+		)
+		for _, v := range group {
+			key := EthUserRecordKey{ChainId: c.ChainId(), Hash: v.Hash, LogIndex: v.LogIndex}
+			if !onChain[key] {
+				dropped = append(dropped, v.ID)
+				continue
+			}
+			keys = append(keys, key)
+		}
+
+		if _, err = duc.pendingRepo.DropDepositPending(ctx, dropped...); nil != err {
+			return err
+		}
+
+		records, err := duc.ethUserRecordRepo.GetEthUserRecordListByKey(ctx, keys...)
+		if nil != err {
+			return err
+		}
+		confirmed := make([]string, 0, len(keys))
+		for _, v := range keys {
+			if _, ok := records[v]; !ok {
+				duc.log.Warnf("deposit pending: %s log %d on chain but not recorded", v.Hash, v.LogIndex)
+				continue
+			}
+			confirmed = append(confirmed, v.Hash)
+		}
+		if err = duc.pendingRepo.UpdateDepositPendingStatus(ctx, c.ChainId(), DepositPendingStatusConfirmed, confirmed...); nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkReorg 复查游标前 reorg_window 个区块内的入账和等待重试的记录，交易已不在链上的标记入账记录和相关分红待审核
+func (duc *DepositUseCase) checkReorg(ctx context.Context, c *Chain, token *DepositToken, watch *depositWatch, toBlock uint64) error {
+	reorgWindow := uint64(depositReorgWindowDefault)
+	if 0 < c.Conf.GetReorgWindow() {
+		reorgWindow = uint64(c.Conf.GetReorgWindow())
+	}
+
+	var fromBlock uint64
+	if toBlock > reorgWindow {
+		fromBlock = toBlock - reorgWindow
+	}
+
+	records, err := duc.ethUserRecordRepo.GetEthUserRecordListByBlock(ctx, c.ChainId(), token.Symbol, fromBlock, toBlock)
+	if nil != err {
+		return err
+	}
+	if 0 >= len(records) {
+		return nil
+	}
+
+	// 只查询有入账记录的区块范围
+	fromBlock, toBlock = records[0].BlockNumber, records[0].BlockNumber
+	for _, v := range records {
+		if v.BlockNumber < fromBlock {
+			fromBlock = v.BlockNumber
+		}
+		if v.BlockNumber > toBlock {
+			toBlock = v.BlockNumber
+		}
+	}
+
+	transfers, err := c.Source.ListTransfers(ctx, token.Contract, watch.addresses, fromBlock, toBlock)
+	if nil != err {
+		return err
+	}
+	// 一笔交易中的多个转账分别核对
+	onChain := make(map[EthUserRecordKey]bool, len(transfers))
+	for _, v := range transfers {
+		onChain[EthUserRecordKey{ChainId: c.ChainId(), Hash: v.Hash, LogIndex: v.LogIndex}] = true
+	}
+
+	for _, v := range records {
+		if onChain[v.Key()] {
+			continue
+		}
+
+		var flagged int64
+		if err = duc.tx.ExecTx(ctx, func(ctx context.Context) error {
+			if err = duc.ethUserRecordRepo.UpdateEthUserRecordStatus(ctx, v.ID, v.Status, EthUserRecordStatusReorg); nil != err {
+				return err
+			}
+			if 0 < v.LocationId {
+				flagged, err = duc.ubRepo.FlagLocationRewards(ctx, v.LocationId, RewardReviewReorg)
+				if nil != err {
+					return err
+				}
+			}
+			return nil
+		}); nil != err {
+			return err
+		}
+
+		duc.log.Warnf("deposit reorg: record %d hash %s block %d, %d rewards flagged", v.ID, v.Hash, v.BlockNumber, flagged)
+	}
+
+	return nil
+}
+
+// handleTransfers 匹配用户并入账，已存在的记录按 hash 跳过，未匹配档位的记为 unmatched，未注册的记为 orphan，
+// 组合档位的记为 unpaired 等待 pairDeposits 配对，暂时无法入账的记为 retry；每条转账都有记录后才返回，调用方才能提交游标
+func (duc *DepositUseCase) handleTransfers(ctx context.Context, c *Chain, transfers []*DepositTransfer) (int64, error) {
+	records, unmatched, err := duc.matchTransfers(ctx, c, transfers)
+	if nil != err {
+		return 0, err
+	}
+	if 0 >= len(records) && 0 >= len(unmatched) {
+		return 0, nil
+	}
+
+	var count int64
+	if 0 < len(records) {
+		skipped, err := duc.ruc.EthUserRecordHandle(ctx, records...)
+		if nil != err {
+			return 0, err
+		}
+		if err = duc.saveSkipped(ctx, skipped); nil != err {
+			return 0, err
+		}
+		count = int64(len(records) - len(skipped))
+	}
+
+	for _, v := range unmatched {
+		if _, err = duc.ethUserRecordRepo.CreateEthUserRecordListByHash(ctx, v); nil != err {
+			return 0, err
+		}
+	}
+
+	hashKeys := make([]string, 0, len(records)+len(unmatched))
+	for _, v := range append(records, unmatched...) {
+		hashKeys = append(hashKeys, v.Hash)
+	}
+	if err = duc.pendingRepo.UpdateDepositPendingStatus(ctx, c.ChainId(), DepositPendingStatusConfirmed, hashKeys...); nil != err {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// saveSkipped 保存 EthUserRecordHandle 未能入账的新记录，不匹配档位的记为 unmatched，其余记为 retry；已有记录保持原状态
+func (duc *DepositUseCase) saveSkipped(ctx context.Context, skipped []*EthUserRecordSkip) error {
+	for _, v := range skipped {
+		if 0 < v.Record.ID {
+			continue
+		}
+
+		record := *v.Record
+		record.Status = EthUserRecordStatusRetry
+		if EthUserRecordSkipTier == v.Reason {
+			record.Status = EthUserRecordStatusUnmatched
+		}
+		if _, err := duc.ethUserRecordRepo.CreateEthUserRecordListByHash(ctx, &record); nil != err {
+			return err
+		}
+
+		duc.log.Warnf("deposit: %s log %d skipped (%s), saved as %s", record.Hash, record.LogIndex, v.Reason, record.Status)
+	}
+
+	return nil
+}
+
+// retryDeposits 重试链上 retry 状态的记录，不匹配档位的转为 unmatched，其余仍为 retry 等待下次重试
+func (duc *DepositUseCase) retryDeposits(ctx context.Context, c *Chain) (int64, error) {
+	retry, err := duc.ethUserRecordRepo.GetEthUserRecordsByStatus(ctx, c.ChainId(), EthUserRecordStatusRetry)
+	if nil != err {
+		return 0, err
+	}
+	if 0 >= len(retry) {
+		return 0, nil
+	}
+
+	records := make([]*EthUserRecord, 0, len(retry))
+	for _, v := range retry {
+		tmp := *v
+		tmp.Status = "success"
+		records = append(records, &tmp)
+	}
+
+	skipped, err := duc.ruc.EthUserRecordHandle(ctx, records...)
+	if nil != err {
+		return 0, err
+	}
+	for _, v := range skipped {
+		if EthUserRecordSkipTier != v.Reason {
+			continue
+		}
+		if err = duc.ethUserRecordRepo.UpdateEthUserRecordStatus(ctx, v.Record.ID, EthUserRecordStatusRetry, EthUserRecordStatusUnmatched); nil != err {
+			return 0, err
+		}
+	}
+
+	return int64(len(records) - len(skipped)), nil
+}
+
+// claimedDeposits 处理登录时认领的充值：匹配单币档位的入账占位，组合档位的等待配对，暂时无法入账的转为 retry，
+// 其余转为 unmatched 由管理员处理
+func (duc *DepositUseCase) claimedDeposits(ctx context.Context, c *Chain) (int64, error) {
+	claimed, err := duc.ethUserRecordRepo.GetEthUserRecordsByStatus(ctx, c.ChainId(), EthUserRecordStatusClaimed)
+	if nil != err {
+		return 0, err
+	}
+	if 0 >= len(claimed) {
+		return 0, nil
+	}
+
+	tiers, err := getDepositTiers(ctx, duc.tierRepo)
+	if nil != err {
+		return 0, err
+	}
+
+	var (
+		records   = make([]*EthUserRecord, 0, len(claimed))
+		unmatched = make([]*EthUserRecord, 0)
+	)
+	for _, v := range claimed {
+		tier := matchDepositTier(tiers, v.CoinType, v.Amount)
+		if (nil != tier && "" != tier.PairToken) || (nil == tier && isPairToken(tiers, v.CoinType)) {
+			if err = duc.ethUserRecordRepo.UpdateEthUserRecordStatus(ctx, v.ID, EthUserRecordStatusClaimed, EthUserRecordStatusUnpaired); nil != err {
+				return 0, err
+			}
+			continue
+		}
+		if nil == tier {
+			unmatched = append(unmatched, v)
+			continue
+		}
+		tmp := *v
+		tmp.Status = "success"
+		records = append(records, &tmp)
+	}
+
+	var count int64
+	if 0 < len(records) {
+		skipped, err := duc.ruc.EthUserRecordHandle(ctx, records...)
+		if nil != err {
+			return 0, err
+		}
+		for _, v := range skipped {
+			if EthUserRecordSkipTier == v.Reason {
+				unmatched = append(unmatched, v.Record)
+				continue
+			}
+			// 金额匹配但暂时无法入账的（已有运行中的占位、查询或事务失败）下次重试
+			if err = duc.ethUserRecordRepo.UpdateEthUserRecordStatus(ctx, v.Record.ID, EthUserRecordStatusClaimed, EthUserRecordStatusRetry); nil != err {
+				return 0, err
+			}
+		}
+		count = int64(len(records) - len(skipped))
+	}
+
+	for _, v := range unmatched {
+		if err = duc.ethUserRecordRepo.UpdateEthUserRecordStatus(ctx, v.ID, EthUserRecordStatusClaimed, EthUserRecordStatusUnmatched); nil != err {
+			return count, err
+		}
+	}
+
+	return count, nil
+}
+
+// matchTransfers 筛选出未入账的转账，返回可直接入账的和其余（未匹配档位、发送地址未注册、等待配对）的
+func (duc *DepositUseCase) matchTransfers(ctx context.Context, c *Chain, transfers []*DepositTransfer) ([]*EthUserRecord, []*EthUserRecord, error) {
+	var (
+		recordKeys            []EthUserRecordKey
+		fromAccount           []string
+		depositUsers          map[string]*User
+		existEthUserRecords   map[EthUserRecordKey]*EthUserRecord
+		tiers                 []*DepositTier
+		notExistDepositResult []*EthUserRecord
+		unmatchedResult       []*EthUserRecord
+		err                   error
+	)
+
+	notExistDepositResult = make([]*EthUserRecord, 0)
+	unmatchedResult = make([]*EthUserRecord, 0)
+	if 0 >= len(transfers) {
+		return notExistDepositResult, unmatchedResult, nil
+	}
+
+	chainId := c.ChainId()
+	for _, v := range transfers {
+		recordKeys = append(recordKeys, EthUserRecordKey{ChainId: chainId, Hash: v.Hash, LogIndex: v.LogIndex})
+		fromAccount = append(fromAccount, v.From)
+	}
+
+	depositUsers, err = duc.userRepo.GetUserByAddresses(ctx, fromAccount...)
+	if nil != err {
+		return nil, nil, err
+	}
+	existEthUserRecords, err = duc.ethUserRecordRepo.GetEthUserRecordListByKey(ctx, recordKeys...)
+	if nil != err {
+		return nil, nil, err
+	}
+	tiers, err = getDepositTiers(ctx, duc.tierRepo)
+	if nil != err {
+		return nil, nil, err
+	}
+
+	for _, v := range transfers {
+		key := EthUserRecordKey{ChainId: chainId, Hash: v.Hash, LogIndex: v.LogIndex}
+		if _, ok := existEthUserRecords[key]; ok { // 记录已存在
+			continue
+		}
+		existEthUserRecords[key] = nil // 同一批次内去重
+		record := &EthUserRecord{
+			ChainId:     chainId,
+			Hash:        v.Hash,
+			Address:     strings.ToLower(v.From),
+			Status:      "success",
+			Type:        "deposit",
+			Amount:      v.Value,
+			CoinType:    v.TokenSymbol,
+			BlockNumber: v.BlockNumber,
+			TxIndex:     v.TxIndex,
+			LogIndex:    v.LogIndex,
+		}
+		if 0 < v.UserId { // 转入专属充值地址，按地址所属用户入账
+			record.UserId = v.UserId
+		} else if _, ok := depositUsers[v.From]; ok {
+			record.UserId = depositUsers[v.From].ID
+		} else { // 用户不存在，按发送地址保存，注册后认领
+			record.Status = EthUserRecordStatusOrphan
+			unmatchedResult = append(unmatchedResult, record)
+			continue
+		}
+
+		tier := matchDepositTier(tiers, v.TokenSymbol, v.Value)
+		if nil != tier && "" == tier.PairToken {
+			notExistDepositResult = append(notExistDepositResult, record)
+			continue
+		}
+
+		if nil != tier || isPairToken(tiers, v.TokenSymbol) { // 组合档位，等待另一种代币
+			record.Status = EthUserRecordStatusUnpaired
+		} else { // 不是有效的充值档位
+			record.Status = EthUserRecordStatusUnmatched
+		}
+		unmatchedResult = append(unmatchedResult, record)
+	}
+
+	return notExistDepositResult, unmatchedResult, nil
+}
+
+// pairDeposits 为组合档位配对同一用户在同一条链 pair_window 内的两笔转账并入账，超出窗口仍未配对的转为 unmatched
+func (duc *DepositUseCase) pairDeposits(ctx context.Context, c *Chain, toBlock uint64) (int64, error) {
+	unpaired, err := duc.ethUserRecordRepo.GetEthUserRecordsByStatus(ctx, c.ChainId(), EthUserRecordStatusUnpaired)
+	if nil != err {
+		return 0, err
+	}
+	if 0 >= len(unpaired) {
+		return 0, nil
+	}
+
+	tiers, err := getDepositTiers(ctx, duc.tierRepo)
+	if nil != err {
+		return 0, err
+	}
+
+	pairWindow := uint64(depositPairWindowDefault)
+	if 0 < c.Conf.GetPairWindow() {
+		pairWindow = uint64(c.Conf.GetPairWindow())
+	}
+
+	var (
+		records = make([]*EthUserRecord, 0)
+		used    = make(map[int64]bool, 0)
+	)
+	for _, v := range unpaired { // 已按链上顺序排列
+		tier := matchDepositTier(tiers, v.CoinType, v.Amount)
+		if nil == tier || "" == tier.PairToken || used[v.ID] {
+			continue
+		}
+
+		for _, vPair := range unpaired {
+			if used[vPair.ID] || vPair.UserId != v.UserId || !strings.EqualFold(vPair.CoinType, tier.PairToken) || vPair.Amount != tier.PairAmount {
+				continue
+			}
+			if v.BlockNumber > vPair.BlockNumber+pairWindow || vPair.BlockNumber > v.BlockNumber+pairWindow {
+				continue
+			}
+
+			used[v.ID], used[vPair.ID] = true, true
+			main, pair := *v, *vPair
+			main.Status, pair.Status = "success", "success"
+			main.Pair = &pair
+			records = append(records, &main)
+			break
+		}
+	}
+
+	var count int64
+	if 0 < len(records) {
+		skipped, err := duc.ruc.EthUserRecordHandle(ctx, records...)
+		if nil != err {
+			return 0, err
+		}
+		count = int64(len(records) - len(skipped)) // 未能入账的仍为 unpaired，下次继续配对
+	}
+
+	// 超出配对窗口的交给管理员处理
+	unpaired, err = duc.ethUserRecordRepo.GetEthUserRecordsByStatus(ctx, c.ChainId(), EthUserRecordStatusUnpaired)
+	if nil != err {
+		return 0, err
+	}
+	for _, v := range unpaired {
+		if v.BlockNumber+pairWindow >= toBlock {
+			continue
+		}
+		if err = duc.ethUserRecordRepo.UpdateEthUserRecordStatus(ctx, v.ID, EthUserRecordStatusUnpaired, EthUserRecordStatusUnmatched); nil != err {
+			return 0, err
+		}
+	}
+
+	return count, nil
+}
+
+// depositBalance 按代币入余额
+func depositBalance(ctx context.Context, ubRepo UserBalanceRepo, coinType string, userId int64, amount int64) (int64, error) {
+	switch strings.ToUpper(coinType) {
+	case "USDT":
+		return ubRepo.DepositUsdt(ctx, userId, amount)
+	case "DHB":
+		return ubRepo.DepositDhb(ctx, userId, amount)
+	default:
+		return 0, errors.New(500, "DEPOSIT_TOKEN_ERROR", "不支持入余额的代币: "+coinType)
+	}
+}
+
+// afterCursor 按 (区块, LogIndex) 即链上顺序排序并去掉游标及之前的转账
+func afterCursor(transfers []*DepositTransfer, cursor *DepositCursor) []*DepositTransfer {
+	sort.SliceStable(transfers, func(i, j int) bool {
+		if transfers[i].BlockNumber != transfers[j].BlockNumber {
+			return transfers[i].BlockNumber < transfers[j].BlockNumber
+		}
+		return transfers[i].LogIndex < transfers[j].LogIndex
+	})
+
+	res := make([]*DepositTransfer, 0, len(transfers))
+	for _, v := range transfers {
+		if v.BlockNumber < cursor.BlockNumber || (v.BlockNumber == cursor.BlockNumber && v.LogIndex <= cursor.LogIndex) {
+			continue
+		}
+		res = append(res, v)
+	}
+
+	return res
+}
@@ -0,0 +1,178 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// depositAddressIndexMax BIP-32 非强化派生的序号上限，扩展公钥只能派生非强化子地址
+const depositAddressIndexMax = 0x80000000
+
+// ErrSweepGasPending 充值地址手续费不足，已补充手续费，下次归集
+var ErrSweepGasPending = errors.New(500, "SWEEP_GAS_PENDING", "充值地址手续费不足，等待补充到账")
+
+// DepositAddress 用户在一条链上的专属充值地址，由该链的 hd_wallet.xpub 按 AddressIndex 派生
+type DepositAddress struct {
+	ID           int64
+	ChainId      int64
+	UserId       int64
+	AddressIndex uint32
+	Address      string
+	CreatedAt    time.Time
+}
+
+type DepositAddressRepo interface {
+	GetDepositAddressByUserId(ctx context.Context, chainId int64, userId int64) (*DepositAddress, error)
+	GetDepositAddresses(ctx context.Context, chainId int64) ([]*DepositAddress, error)
+	CreateDepositAddress(ctx context.Context, a *DepositAddress) (*DepositAddress, error)
+}
+
+// DepositWallet 充值地址的派生和归集，扩展私钥只在归集时从配置的文件读取，不入库
+type DepositWallet interface {
+	// Enabled 是否配置了 xpub
+	Enabled() bool
+	// DeriveAddress 派生第 index 个充值地址
+	DeriveAddress(index uint32) (string, error)
+	// TokenBalance 地址的代币余额，代币最小单位
+	TokenBalance(ctx context.Context, contract string, address string) (*big.Int, error)
+	// Sweep 将第 index 个充值地址的代币转入归集地址，手续费不足时补充手续费并返回 ErrSweepGasPending
+	Sweep(ctx context.Context, index uint32, contract string, amount *big.Int) (string, error)
+}
+
+// depositWatch 扫描的收款地址，专属充值地址记录所属用户
+type depositWatch struct {
+	addresses []string
+	owners    map[string]int64
+}
+
+// GetDepositAddress 用户在链上的充值地址，没有时按用户 ID 派生；该链未配置 xpub 时返回公共收款地址
+func (duc *DepositUseCase) GetDepositAddress(ctx context.Context, userId int64, chainId int64) (*DepositAddress, error) {
+	c, err := duc.chains.Get(chainId)
+	if nil != err {
+		return nil, err
+	}
+	if !c.Wallet.Enabled() {
+		return &DepositAddress{ChainId: c.ChainId(), Address: strings.ToLower(c.Conf.GetDepositAddress())}, nil
+	}
+
+	depositAddress, err := duc.depositAddressRepo.GetDepositAddressByUserId(ctx, c.ChainId(), userId)
+	if nil != err {
+		return nil, err
+	}
+	if nil != depositAddress {
+		return depositAddress, nil
+	}
+
+	if 0 >= userId || depositAddressIndexMax <= userId {
+		return nil, errors.New(500, "DEPOSIT_ADDRESS_ERROR", "无法为该用户派生充值地址")
+	}
+	index := uint32(userId) // 按用户 ID 派生，地址与用户一一对应
+	address, err := c.Wallet.DeriveAddress(index)
+	if nil != err {
+		return nil, err
+	}
+
+	depositAddress, err = duc.depositAddressRepo.CreateDepositAddress(ctx, &DepositAddress{
+		ChainId:      c.ChainId(),
+		UserId:       userId,
+		AddressIndex: index,
+		Address:      address,
+	})
+	if nil != err { // 并发创建时 (chain_id, user_id) 唯一，以已创建的为准
+		if exist, _ := duc.depositAddressRepo.GetDepositAddressByUserId(ctx, c.ChainId(), userId); nil != exist {
+			return exist, nil
+		}
+		return nil, err
+	}
+
+	return depositAddress, nil
+}
+
+// depositWatch 链上的公共收款地址和所有专属充值地址
+func (duc *DepositUseCase) depositWatch(ctx context.Context, c *Chain) (*depositWatch, error) {
+	watch := &depositWatch{
+		addresses: make([]string, 0),
+		owners:    make(map[string]int64, 0),
+	}
+	if "" != c.Conf.GetDepositAddress() {
+		watch.addresses = append(watch.addresses, strings.ToLower(c.Conf.GetDepositAddress()))
+	}
+	if !c.Wallet.Enabled() {
+		return watch, nil
+	}
+
+	depositAddresses, err := duc.depositAddressRepo.GetDepositAddresses(ctx, c.ChainId())
+	if nil != err {
+		return nil, err
+	}
+	for _, v := range depositAddresses {
+		address := strings.ToLower(v.Address)
+		watch.addresses = append(watch.addresses, address)
+		watch.owners[address] = v.UserId
+	}
+
+	return watch, nil
+}
+
+// Sweep 将每条链专属充值地址上的代币归集到该链的归集地址，返回发出的归集交易数。
+// 手续费不足的地址本次只补充手续费，下次任务再归集
+func (duc *DepositUseCase) Sweep(ctx context.Context) (int64, error) {
+	var count int64
+	for _, c := range duc.chains {
+		swept, err := duc.sweepChain(ctx, c)
+		count += swept
+		if nil != err {
+			return count, err
+		}
+	}
+
+	return count, nil
+}
+
+func (duc *DepositUseCase) sweepChain(ctx context.Context, c *Chain) (int64, error) {
+	if !c.Wallet.Enabled() {
+		return 0, nil
+	}
+
+	sweepMin := big.NewInt(1)
+	if "" != c.Conf.GetHdWallet().GetSweepMin() {
+		if _, ok := sweepMin.SetString(c.Conf.GetHdWallet().GetSweepMin(), 10); !ok {
+			return 0, errors.New(500, "CHAIN_CONFIG_ERROR", "chain.hd_wallet.sweep_min 配置错误")
+		}
+	}
+
+	depositAddresses, err := duc.depositAddressRepo.GetDepositAddresses(ctx, c.ChainId())
+	if nil != err {
+		return 0, err
+	}
+
+	var count int64
+	for _, v := range depositAddresses {
+		for _, token := range c.Tokens() {
+			balance, err := c.Wallet.TokenBalance(ctx, token.Contract, v.Address)
+			if nil != err {
+				return count, err
+			}
+			if 0 >= balance.Sign() || 0 > balance.Cmp(sweepMin) {
+				continue
+			}
+
+			hash, err := c.Wallet.Sweep(ctx, v.AddressIndex, token.Contract, balance)
+			if nil != err {
+				if errors.Is(err, ErrSweepGasPending) {
+					duc.log.Infof("deposit sweep: chain %s %s %s waiting for gas", c.Name(), v.Address, token.Symbol)
+					continue
+				}
+				return count, err
+			}
+
+			duc.log.Infof("deposit sweep: chain %s %s %s %s, hash %s", c.Name(), v.Address, token.Symbol, balance.String(), hash)
+			count++
+		}
+	}
+
+	return count, nil
+}
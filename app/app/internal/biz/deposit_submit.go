@@ -0,0 +1,142 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"regexp"
+	"strings"
+)
+
+var depositTxHashRegexp = regexp.MustCompile(`^0x[0-9a-f]{64}$`)
+
+// DepositSubmitResult 用户提交交易的处理结果
+type DepositSubmitResult struct {
+	Status        string // pending 未达到确认数，其余为入账记录的状态
+	CoinType      string
+	Amount        string
+	Confirmations int64
+	Required      int64
+}
+
+// SubmitDepositTx 用户提交充值交易 hash，查询回执校验发送地址、收款地址、代币后立即入账，不用等待扫描任务。
+// 未达到确认数的记为 pending，已入账的直接返回记录状态；调用方需与扫描任务互斥
+func (duc *DepositUseCase) SubmitDepositTx(ctx context.Context, userId int64, chainId int64, hash string) (*DepositSubmitResult, error) {
+	hash = strings.ToLower(strings.TrimSpace(hash))
+	if !depositTxHashRegexp.MatchString(hash) {
+		return nil, errors.New(500, "DEPOSIT_TX_HASH_ERROR", "交易 hash 格式错误")
+	}
+
+	c, err := duc.chains.Get(chainId)
+	if nil != err {
+		return nil, err
+	}
+
+	user, err := duc.userRepo.GetUserById(ctx, userId)
+	if nil != err {
+		return nil, err
+	}
+
+	transfers, err := c.Source.TransactionTransfers(ctx, hash)
+	if nil != err {
+		return nil, err
+	}
+	watch, err := duc.depositWatch(ctx, c)
+	if nil != err {
+		return nil, err
+	}
+
+	// 只保留当前用户转入收款地址的充值代币
+	receiving := make(map[string]bool, len(watch.addresses))
+	for _, v := range watch.addresses {
+		receiving[v] = true
+	}
+	mine := make([]*DepositTransfer, 0, len(transfers))
+	for _, v := range transfers {
+		token := c.TokenByContract(v.Contract)
+		to := strings.ToLower(v.To)
+		if nil == token || !receiving[to] {
+			continue
+		}
+		if owner := watch.owners[to]; (0 < owner && userId != owner) || (0 == owner && !strings.EqualFold(user.Address, v.From)) {
+			continue
+		}
+		depositTransferToken(v, token, watch)
+		mine = append(mine, v)
+	}
+	if 0 >= len(mine) {
+		return nil, errors.New(500, "DEPOSIT_TX_INVALID", "交易中没有当前用户转入收款地址的充值")
+	}
+
+	if res, err := duc.submitResult(ctx, c, userId, mine); nil != err || nil != res { // 已入账
+		return res, err
+	}
+
+	latest, err := c.Source.LatestBlock(ctx)
+	if nil != err {
+		return nil, err
+	}
+	blockNumber := mine[0].BlockNumber
+	var confirmations int64
+	if latest >= blockNumber {
+		confirmations = int64(latest - blockNumber + 1)
+	}
+	if confirmations < c.Confirmations() {
+		if err = duc.savePendingTransfers(ctx, c, mine, latest); nil != err {
+			return nil, err
+		}
+		return &DepositSubmitResult{
+			Status:        DepositPendingStatusPending,
+			CoinType:      mine[0].TokenSymbol,
+			Amount:        mine[0].Value,
+			Confirmations: confirmations,
+			Required:      c.Confirmations(),
+		}, nil
+	}
+
+	if _, err = duc.handleTransfers(ctx, c, mine); nil != err {
+		return nil, err
+	}
+	if _, err = duc.pairDeposits(ctx, c, blockNumber); nil != err {
+		return nil, err
+	}
+
+	res, err := duc.submitResult(ctx, c, userId, mine)
+	if nil != err {
+		return nil, err
+	}
+	if nil == res { // 转账未写入入账记录
+		return nil, errors.New(500, "DEPOSIT_SUBMIT_FAILED", "入账失败，请联系客服")
+	}
+	res.Confirmations = confirmations
+
+	return res, nil
+}
+
+// submitResult 交易中的转账都已有入账记录时返回第一条的状态，否则返回 nil
+func (duc *DepositUseCase) submitResult(ctx context.Context, c *Chain, userId int64, transfers []*DepositTransfer) (*DepositSubmitResult, error) {
+	keys := make([]EthUserRecordKey, 0, len(transfers))
+	for _, v := range transfers {
+		keys = append(keys, EthUserRecordKey{ChainId: c.ChainId(), Hash: v.Hash, LogIndex: v.LogIndex})
+	}
+	records, err := duc.ethUserRecordRepo.GetEthUserRecordListByKey(ctx, keys...)
+	if nil != err {
+		return nil, err
+	}
+	for _, v := range keys {
+		if _, ok := records[v]; !ok {
+			return nil, nil
+		}
+	}
+
+	record := records[keys[0]]
+	if userId != record.UserId {
+		return nil, errors.New(500, "DEPOSIT_TX_INVALID", "交易已由其他用户入账")
+	}
+
+	return &DepositSubmitResult{
+		Status:   record.Status,
+		CoinType: record.CoinType,
+		Amount:   record.Amount,
+		Required: c.Confirmations(),
+	}, nil
+}
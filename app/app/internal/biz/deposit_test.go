@@ -0,0 +1,297 @@
+package biz_test
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/data"
+	"github.com/go-kratos/kratos/v2/log"
+	"strings"
+	"testing"
+)
+
+const (
+	testDepositAddress = "0x00000000000000000000000000000000000000d0"
+	testUsdtContract   = "0x00000000000000000000000000000000000000c0"
+	testUserAddress    = "0x00000000000000000000000000000000000000a1"
+	testAmount         = "7000000000000000000" // 不匹配任何档位，记为 unmatched
+)
+
+type depositTest struct {
+	chain   *biz.Chain
+	source  *data.FakeDepositSource
+	cursor  *fakeCursorRepo
+	pending *fakePendingRepo
+	records *fakeRecordRepo
+	ub      *fakeBalanceRepo
+	duc     *biz.DepositUseCase
+}
+
+func newDepositTest() *depositTest {
+	t := &depositTest{
+		source:  data.NewFakeDepositSource(),
+		cursor:  newFakeCursorRepo(),
+		pending: &fakePendingRepo{},
+		records: &fakeRecordRepo{},
+		ub:      newFakeBalanceRepo(),
+	}
+	t.chain = &biz.Chain{
+		Conf: &conf.Chain{
+			ChainId:        56,
+			DepositAddress: testDepositAddress,
+			UsdtContract:   testUsdtContract,
+			Confirmations:  3,
+			ScanRange:      10,
+			StartBlock:     100,
+		},
+		Source: t.source,
+		Wallet: fakeWallet{},
+	}
+	tiers := &fakeTierRepo{tiers: []*biz.DepositTier{
+		{Token: "USDT", Amount: "100000000000000000000", Level: 1, Multiplier: 5, Enabled: true},
+	}}
+	users := &fakeUserRepo{users: map[string]*biz.User{testUserAddress: {ID: 1, Address: testUserAddress}}}
+
+	t.duc = biz.NewDepositUseCase(biz.Chains{t.chain}, t.cursor, t.pending, tiers, nil, users, t.ub, t.records, nil, nil, fakeTx{}, log.DefaultLogger)
+	return t
+}
+
+func (t *depositTest) transfer(hash string, blockNumber uint64, logIndex int64) *biz.DepositTransfer {
+	return &biz.DepositTransfer{
+		Hash:        hash,
+		From:        testUserAddress,
+		To:          testDepositAddress,
+		Value:       testAmount,
+		Contract:    testUsdtContract,
+		BlockNumber: blockNumber,
+		LogIndex:    logIndex,
+	}
+}
+
+func (t *depositTest) record(hash string, logIndex int64) *biz.EthUserRecord {
+	for _, v := range t.records.records {
+		if hash == v.Hash && logIndex == v.LogIndex {
+			return v
+		}
+	}
+	return nil
+}
+
+func (t *depositTest) pendingStatus(hash string) string {
+	for _, v := range t.pending.pending {
+		if hash == v.Hash {
+			return v.Status
+		}
+	}
+	return ""
+}
+
+func TestScanCursor(t *testing.T) {
+	var (
+		ctx = context.Background()
+		dt  = newDepositTest()
+	)
+
+	dt.source.AddTransfer(dt.transfer("0x01", 101, 0), dt.transfer("0x01", 101, 1), dt.transfer("0x02", 105, 3))
+	dt.source.SetLatestBlock(108) // 确认数 3，可入账到 105
+
+	if _, err := dt.duc.Scan(ctx); nil != err {
+		t.Fatalf("scan: %v", err)
+	}
+	if 3 != len(dt.records.records) {
+		t.Fatalf("records = %d, want 3", len(dt.records.records))
+	}
+	cursor, _ := dt.cursor.GetDepositCursor(ctx, 56)
+	if nil == cursor || 105 != cursor.BlockNumber || 3 != cursor.LogIndex {
+		t.Fatalf("cursor = %+v, want block 105 log 3", cursor)
+	}
+
+	// 游标所在区块重新查询，已处理的日志跳过
+	dt.source.AddTransfer(dt.transfer("0x03", 105, 7), dt.transfer("0x04", 107, 0))
+	dt.source.SetLatestBlock(112)
+
+	if _, err := dt.duc.Scan(ctx); nil != err {
+		t.Fatalf("scan: %v", err)
+	}
+	if 5 != len(dt.records.records) {
+		t.Fatalf("records = %d, want 5", len(dt.records.records))
+	}
+	for _, v := range []*biz.DepositTransfer{dt.transfer("0x03", 105, 7), dt.transfer("0x04", 107, 0)} {
+		if nil == dt.record(v.Hash, v.LogIndex) {
+			t.Errorf("%s log %d not recorded", v.Hash, v.LogIndex)
+		}
+	}
+	cursor, _ = dt.cursor.GetDepositCursor(ctx, 56)
+	if 109 != cursor.BlockNumber || -1 != cursor.LogIndex {
+		t.Fatalf("cursor = %+v, want block 109 log -1", cursor)
+	}
+
+	// 没有新区块时不重复记录
+	if _, err := dt.duc.Scan(ctx); nil != err {
+		t.Fatalf("scan: %v", err)
+	}
+	if 5 != len(dt.records.records) {
+		t.Fatalf("records = %d after rescan, want 5", len(dt.records.records))
+	}
+}
+
+func TestScanCursorKeepsAfterTokenChange(t *testing.T) {
+	var (
+		ctx         = context.Background()
+		dt          = newDepositTest()
+		dhbContract = "0x00000000000000000000000000000000000000e0"
+	)
+
+	dt.source.AddTransfer(dt.transfer("0x01", 101, 0))
+	dt.source.SetLatestBlock(108)
+	if _, err := dt.duc.Scan(ctx); nil != err {
+		t.Fatalf("scan: %v", err)
+	}
+
+	// 新增代币并排在 USDT 之前，游标按链记录，从上次的位置继续
+	dt.chain.Conf.Tokens = []*conf.Chain_Token{
+		{Symbol: "DHB", Contract: dhbContract},
+		{Symbol: "USDT", Contract: testUsdtContract},
+	}
+	dhb := dt.transfer("0x02", 107, 0)
+	dhb.Contract = dhbContract
+	dt.source.AddTransfer(dhb, dt.transfer("0x03", 107, 1))
+	dt.source.SetLatestBlock(112)
+
+	if _, err := dt.duc.Scan(ctx); nil != err {
+		t.Fatalf("scan: %v", err)
+	}
+	cursor, _ := dt.cursor.GetDepositCursor(ctx, 56)
+	if nil == cursor || 109 != cursor.BlockNumber {
+		t.Fatalf("cursor = %+v, want block 109", cursor)
+	}
+	if 1 != len(dt.cursor.cursors) {
+		t.Errorf("cursors = %d, want 1", len(dt.cursor.cursors))
+	}
+	if nil == dt.record("0x02", 0) || nil == dt.record("0x03", 1) {
+		t.Errorf("transfers after token change not recorded")
+	}
+}
+
+func TestScanDropsReorgedPending(t *testing.T) {
+	var (
+		ctx = context.Background()
+		dt  = newDepositTest()
+	)
+
+	dt.source.AddTransfer(dt.transfer("0x10", 110, 0), dt.transfer("0x11", 111, 0))
+	dt.source.SetLatestBlock(111) // 可入账到 108，110、111 未达到确认数
+
+	if _, err := dt.duc.Scan(ctx); nil != err {
+		t.Fatalf("scan: %v", err)
+	}
+	for _, hash := range []string{"0x10", "0x11"} {
+		if biz.DepositPendingStatusPending != dt.pendingStatus(hash) {
+			t.Fatalf("%s pending status = %q, want pending", hash, dt.pendingStatus(hash))
+		}
+	}
+
+	// 0x10 所在区块被回滚
+	dt.source.RemoveTransfer("0x10")
+	dt.source.SetLatestBlock(115)
+
+	if _, err := dt.duc.Scan(ctx); nil != err {
+		t.Fatalf("scan: %v", err)
+	}
+	if biz.DepositPendingStatusDropped != dt.pendingStatus("0x10") {
+		t.Errorf("0x10 pending status = %q, want dropped", dt.pendingStatus("0x10"))
+	}
+	if nil != dt.record("0x10", 0) {
+		t.Errorf("0x10 recorded after reorg")
+	}
+	if biz.DepositPendingStatusConfirmed != dt.pendingStatus("0x11") {
+		t.Errorf("0x11 pending status = %q, want confirmed", dt.pendingStatus("0x11"))
+	}
+	if nil == dt.record("0x11", 0) {
+		t.Errorf("0x11 not recorded")
+	}
+}
+
+func TestScanFlagsReorgedRecords(t *testing.T) {
+	var (
+		ctx = context.Background()
+		dt  = newDepositTest()
+	)
+
+	// 已入账的两笔，0x21 之后被回滚
+	dt.records.records = []*biz.EthUserRecord{
+		{ID: 1, ChainId: 56, Hash: "0x20", UserId: 1, Status: "success", Type: "deposit", CoinType: "USDT", BlockNumber: 105, LocationId: 8},
+		{ID: 2, ChainId: 56, Hash: "0x21", UserId: 1, Status: "success", Type: "deposit", CoinType: "USDT", BlockNumber: 106, LocationId: 9},
+	}
+	dt.source.AddTransfer(dt.transfer("0x20", 105, 0))
+	_ = dt.cursor.SaveDepositCursor(ctx, &biz.DepositCursor{ChainId: 56, BlockNumber: 110, LogIndex: -1})
+	dt.source.SetLatestBlock(113)
+
+	if _, err := dt.duc.Scan(ctx); nil != err {
+		t.Fatalf("scan: %v", err)
+	}
+	if biz.EthUserRecordStatusReorg != dt.record("0x21", 0).Status {
+		t.Errorf("0x21 status = %q, want reorg", dt.record("0x21", 0).Status)
+	}
+	if biz.RewardReviewReorg != dt.ub.flagged[9] {
+		t.Errorf("location 9 rewards not flagged")
+	}
+	if "success" != dt.record("0x20", 0).Status {
+		t.Errorf("0x20 status = %q, want success", dt.record("0x20", 0).Status)
+	}
+	if _, ok := dt.ub.flagged[8]; ok {
+		t.Errorf("location 8 rewards flagged")
+	}
+}
+
+func TestWebhookTransfersVerifiedByReceipt(t *testing.T) {
+	var (
+		ctx = context.Background()
+		dt  = newDepositTest()
+	)
+
+	dt.source.AddTransfer(dt.transfer("0x"+strings.Repeat("1", 64), 101, 0), dt.transfer("0x"+strings.Repeat("2", 64), 102, 0))
+	dt.source.SetLatestBlock(110)
+
+	forged := dt.transfer("0x"+strings.Repeat("2", 64), 102, 0)
+	forged.Value = "9000000000000000000" // 与链上回执金额不一致
+	res, err := dt.duc.HandleWebhookTransfers(ctx, 56, []*biz.DepositTransfer{
+		dt.transfer("0x"+strings.Repeat("1", 64), 101, 0),
+		dt.transfer("0x"+strings.Repeat("1", 64), 101, 0), // 批次内重复
+		forged,
+		dt.transfer("0x"+strings.Repeat("3", 64), 103, 0), // 链上不存在
+	})
+	if nil != err {
+		t.Fatalf("webhook: %v", err)
+	}
+	if 3 != res.Ignored {
+		t.Errorf("ignored = %d, want 3", res.Ignored)
+	}
+	if 1 != len(dt.records.records) || nil == dt.record("0x"+strings.Repeat("1", 64), 0) {
+		t.Errorf("records = %d, want only the verified transfer", len(dt.records.records))
+	}
+}
+
+func TestScanHandlesClaimedDeposits(t *testing.T) {
+	var (
+		ctx = context.Background()
+		dt  = newDepositTest()
+	)
+
+	// 登录时只认领，金额不匹配档位的由充值任务转为 unmatched
+	dt.records.records = []*biz.EthUserRecord{
+		{ID: 1, ChainId: 56, Hash: "0x30", UserId: 1, Status: biz.EthUserRecordStatusClaimed, Type: "deposit", CoinType: "USDT", Amount: testAmount, BlockNumber: 101},
+		{ID: 2, ChainId: 97, Hash: "0x31", UserId: 1, Status: biz.EthUserRecordStatusClaimed, Type: "deposit", CoinType: "USDT", Amount: testAmount, BlockNumber: 101},
+	}
+	dt.source.SetLatestBlock(108)
+
+	if _, err := dt.duc.Scan(ctx); nil != err {
+		t.Fatalf("scan: %v", err)
+	}
+	if biz.EthUserRecordStatusUnmatched != dt.record("0x30", 0).Status {
+		t.Errorf("0x30 status = %q, want unmatched", dt.record("0x30", 0).Status)
+	}
+	if biz.EthUserRecordStatusClaimed != dt.record("0x31", 0).Status {
+		t.Errorf("0x31 on another chain status = %q, want claimed", dt.record("0x31", 0).Status)
+	}
+}
@@ -0,0 +1,148 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// depositTierPrecision 链上 18 位精度转为系统 10 位精度
+var depositTierPrecision = big.NewInt(100000000)
+
+// defaultDepositTiers deposit_tier 表为空时写入的初始档位，与原先写死的 100/200/500 USDT 一致
+var defaultDepositTiers = []*DepositTier{
+	{Token: "USDT", Amount: "100000000000000000000", Level: 1, Multiplier: 5, Enabled: true},
+	{Token: "USDT", Amount: "200000000000000000000", Level: 2, Multiplier: 5, Enabled: true},
+	{Token: "USDT", Amount: "500000000000000000000", Level: 3, Multiplier: 5, Enabled: true},
+}
+
+// DepositTier 充值档位，金额必须完全一致才入账
+type DepositTier struct {
+	ID         int64
+	Token      string
+	Amount     string // 按 18 位小数计的十进制字符串，各链代币精度不同时入账前已换算
+	Level      int64  // 占位等级
+	Multiplier int64  // 出局倍数，占位最大分红为入金的 Multiplier 倍
+	Enabled    bool
+	PairToken  string // 组合档位：同一地址还需在 pair_window 内转入 PairAmount 的 PairToken
+	PairAmount string
+	CreatedAt  time.Time
+}
+
+// Value 入金的系统精度金额
+func (t *DepositTier) Value() int64 {
+	return depositValue(t.Amount)
+}
+
+// CurrentMax 占位最大分红
+func (t *DepositTier) CurrentMax() int64 {
+	return t.Value() * t.Multiplier
+}
+
+// depositValue 18 位小数的金额转为系统精度
+func depositValue(amount string) int64 {
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return 0
+	}
+
+	return value.Quo(value, depositTierPrecision).Int64()
+}
+
+type DepositTierRepo interface {
+	GetDepositTiers(ctx context.Context) ([]*DepositTier, error)
+	CreateDepositTier(ctx context.Context, t *DepositTier) (*DepositTier, error)
+	UpdateDepositTier(ctx context.Context, t *DepositTier) (*DepositTier, error)
+}
+
+// getDepositTiers 读取全部档位，表为空时先写入初始档位
+func getDepositTiers(ctx context.Context, repo DepositTierRepo) ([]*DepositTier, error) {
+	tiers, err := repo.GetDepositTiers(ctx)
+	if nil != err {
+		return nil, err
+	}
+	if 0 < len(tiers) {
+		return tiers, nil
+	}
+
+	for _, v := range defaultDepositTiers {
+		tmp := *v
+		_, _ = repo.CreateDepositTier(ctx, &tmp) // (token, amount) 唯一，并发写入时失败的忽略
+	}
+
+	return repo.GetDepositTiers(ctx)
+}
+
+// isPairToken 代币是否为启用的组合档位的第二种代币
+func isPairToken(tiers []*DepositTier, token string) bool {
+	for _, v := range tiers {
+		if v.Enabled && "" != v.PairToken && strings.EqualFold(token, v.PairToken) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchDepositTier 按代币和金额匹配启用的档位
+func matchDepositTier(tiers []*DepositTier, token string, amount string) *DepositTier {
+	for _, v := range tiers {
+		if v.Enabled && strings.EqualFold(token, v.Token) && amount == v.Amount {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// GetDepositTiers .
+func (duc *DepositUseCase) GetDepositTiers(ctx context.Context) ([]*DepositTier, error) {
+	return getDepositTiers(ctx, duc.tierRepo)
+}
+
+// SaveDepositTier 新增或修改档位，ID 为 0 时新增
+func (duc *DepositUseCase) SaveDepositTier(ctx context.Context, t *DepositTier) (*DepositTier, error) {
+	amount, ok := new(big.Int).SetString(t.Amount, 10)
+	if !ok || 0 >= amount.Sign() || "" == t.Token || 0 >= t.Level || 0 >= t.Multiplier {
+		return nil, errors.New(500, "DEPOSIT_TIER_INVALID", "充值档位参数错误")
+	}
+	if 0 >= t.Value() {
+		return nil, errors.New(500, "DEPOSIT_TIER_INVALID", "充值档位金额过小")
+	}
+	t.Token = strings.ToUpper(t.Token)
+	t.Amount = amount.String()
+	if "" != t.PairToken {
+		pairAmount, ok := new(big.Int).SetString(t.PairAmount, 10)
+		if !ok || 0 >= pairAmount.Sign() {
+			return nil, errors.New(500, "DEPOSIT_TIER_INVALID", "组合档位金额错误")
+		}
+		t.PairToken = strings.ToUpper(t.PairToken)
+		t.PairAmount = pairAmount.String()
+		if t.PairToken == t.Token || !duc.chains.hasToken(t.PairToken) {
+			return nil, errors.New(500, "DEPOSIT_TIER_INVALID", "组合档位代币未配置")
+		}
+	} else {
+		t.PairAmount = ""
+	}
+	if !duc.chains.hasToken(t.Token) {
+		return nil, errors.New(500, "DEPOSIT_TIER_INVALID", "充值代币未配置")
+	}
+
+	tiers, err := getDepositTiers(ctx, duc.tierRepo)
+	if nil != err {
+		return nil, err
+	}
+	for _, v := range tiers {
+		if v.ID != t.ID && v.Token == t.Token && v.Amount == t.Amount {
+			return nil, errors.New(500, "DEPOSIT_TIER_EXISTS", "相同金额的充值档位已存在")
+		}
+	}
+
+	if 0 == t.ID {
+		return duc.tierRepo.CreateDepositTier(ctx, t)
+	}
+
+	return duc.tierRepo.UpdateDepositTier(ctx, t)
+}
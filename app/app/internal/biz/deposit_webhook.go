@@ -0,0 +1,143 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strconv"
+	"strings"
+)
+
+// DepositWebhookResult 推送批次的处理结果
+type DepositWebhookResult struct {
+	Count   int64 // 入账条数
+	Pending int64 // 未达到确认数的条数
+	Ignored int64 // 非充值代币、非收款地址、批次内重复或与链上回执不一致的条数
+}
+
+// HandleWebhookTransfers 处理索引服务推送的转账，按 (hash, logIndex) 去重后与扫描任务走同一流程入账。
+// 推送内容逐笔与 chainId 所在链的交易回执核对，不一致的忽略；未达到确认数的记为 pending，由之后的推送或扫描任务入账
+func (duc *DepositUseCase) HandleWebhookTransfers(ctx context.Context, chainId int64, transfers []*DepositTransfer) (*DepositWebhookResult, error) {
+	res := &DepositWebhookResult{}
+
+	c, err := duc.chains.Get(chainId)
+	if nil != err {
+		return nil, err
+	}
+	watch, err := duc.depositWatch(ctx, c)
+	if nil != err {
+		return nil, err
+	}
+	receiving := make(map[string]bool, len(watch.addresses))
+	for _, v := range watch.addresses {
+		receiving[v] = true
+	}
+
+	// 批次内按 (hash, logIndex) 去重，按 hash 分组核对回执
+	var (
+		seen   = make(map[string]bool, len(transfers))
+		hashes = make([]string, 0)
+		pushed = make(map[string][]*DepositTransfer, 0)
+	)
+	for _, v := range transfers {
+		hash := strings.ToLower(v.Hash)
+		key := hash + ":" + strconv.FormatInt(v.LogIndex, 10)
+		if seen[key] || !depositTxHashRegexp.MatchString(hash) || nil == c.TokenByContract(v.Contract) || !receiving[strings.ToLower(v.To)] {
+			res.Ignored++
+			continue
+		}
+		seen[key] = true
+		if _, ok := pushed[hash]; !ok {
+			hashes = append(hashes, hash)
+		}
+		pushed[hash] = append(pushed[hash], v)
+	}
+
+	verified := make([]*DepositTransfer, 0)
+	for _, hash := range hashes {
+		onChain, err := c.Source.TransactionTransfers(ctx, hash)
+		if nil != err {
+			if errors.Is(err, ErrDepositTxNotFound) || errors.Is(err, ErrDepositTxFailed) {
+				duc.log.Warnf("deposit webhook: %s ignored, %v", hash, err)
+				res.Ignored += int64(len(pushed[hash]))
+				continue
+			}
+			return nil, err
+		}
+
+		for _, v := range pushed[hash] {
+			transfer := matchOnChainTransfer(onChain, v)
+			if nil == transfer {
+				duc.log.Warnf("deposit webhook: %s log %d does not match receipt", hash, v.LogIndex)
+				res.Ignored++
+				continue
+			}
+			depositTransferToken(transfer, c.TokenByContract(transfer.Contract), watch)
+			verified = append(verified, transfer)
+		}
+	}
+	if 0 >= len(verified) {
+		return res, nil
+	}
+
+	latest, err := c.Source.LatestBlock(ctx)
+	if nil != err {
+		return nil, err
+	}
+	var safe uint64 // 已达到确认数的最高区块
+	if confirmations := uint64(c.Confirmations()); latest > confirmations {
+		safe = latest - confirmations
+	}
+
+	var (
+		confirmed = make([]*DepositTransfer, 0, len(verified))
+		pending   = make([]*DepositTransfer, 0)
+		toBlock   uint64
+	)
+	for _, v := range verified {
+		if v.BlockNumber > safe {
+			pending = append(pending, v)
+			continue
+		}
+		confirmed = append(confirmed, v)
+		if v.BlockNumber > toBlock {
+			toBlock = v.BlockNumber
+		}
+	}
+
+	if 0 < len(confirmed) {
+		handled, err := duc.handleTransfers(ctx, c, confirmed)
+		if nil != err {
+			return nil, err
+		}
+		paired, err := duc.pairDeposits(ctx, c, toBlock)
+		if nil != err {
+			return nil, err
+		}
+		res.Count = handled + paired
+	}
+
+	if 0 < len(pending) {
+		if err = duc.savePendingTransfers(ctx, c, pending, latest); nil != err {
+			return nil, err
+		}
+		res.Pending = int64(len(pending))
+	}
+
+	return res, nil
+}
+
+// matchOnChainTransfer 按 logIndex 找到回执中的转账，合约、发送方、接收方、金额需与推送一致
+func matchOnChainTransfer(onChain []*DepositTransfer, v *DepositTransfer) *DepositTransfer {
+	for _, transfer := range onChain {
+		if transfer.LogIndex != v.LogIndex {
+			continue
+		}
+		if strings.EqualFold(transfer.Contract, v.Contract) && strings.EqualFold(transfer.From, v.From) &&
+			strings.EqualFold(transfer.To, v.To) && transfer.Value == v.Value {
+			return transfer
+		}
+		return nil
+	}
+
+	return nil
+}
@@ -0,0 +1,321 @@
+package biz_test
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// 测试用的内存仓储，只实现用到的方法，未实现的方法调用时 panic
+
+type fakeTx struct{}
+
+func (fakeTx) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type fakeCursorRepo struct {
+	cursors map[int64]*biz.DepositCursor
+}
+
+func newFakeCursorRepo() *fakeCursorRepo {
+	return &fakeCursorRepo{cursors: make(map[int64]*biz.DepositCursor)}
+}
+
+func (f *fakeCursorRepo) GetDepositCursor(ctx context.Context, chainId int64) (*biz.DepositCursor, error) {
+	c, ok := f.cursors[chainId]
+	if !ok {
+		return nil, nil
+	}
+	tmp := *c
+	return &tmp, nil
+}
+
+func (f *fakeCursorRepo) SaveDepositCursor(ctx context.Context, c *biz.DepositCursor) error {
+	tmp := *c
+	f.cursors[c.ChainId] = &tmp
+	return nil
+}
+
+type fakePendingRepo struct {
+	pending []*biz.DepositPending
+}
+
+func (f *fakePendingRepo) SaveDepositPending(ctx context.Context, p *biz.DepositPending) error {
+	for _, v := range f.pending {
+		if v.ChainId == p.ChainId && v.Hash == p.Hash && strings.EqualFold(v.Contract, p.Contract) && v.LogIndex == p.LogIndex {
+			v.Confirmations, v.Status = p.Confirmations, p.Status
+			return nil
+		}
+	}
+	tmp := *p
+	tmp.ID = int64(len(f.pending) + 1)
+	f.pending = append(f.pending, &tmp)
+	return nil
+}
+
+func (f *fakePendingRepo) UpdateDepositPendingStatus(ctx context.Context, chainId int64, status string, hash ...string) error {
+	for _, v := range f.pending {
+		for _, h := range hash {
+			if v.ChainId == chainId && v.Hash == h {
+				v.Status = status
+			}
+		}
+	}
+	return nil
+}
+
+func (f *fakePendingRepo) GetDepositPendingByBlock(ctx context.Context, chainId int64, contract string, toBlock uint64) ([]*biz.DepositPending, error) {
+	res := make([]*biz.DepositPending, 0)
+	for _, v := range f.pending {
+		if v.ChainId == chainId && strings.EqualFold(v.Contract, contract) && biz.DepositPendingStatusPending == v.Status && v.BlockNumber <= toBlock {
+			tmp := *v
+			res = append(res, &tmp)
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool { return res[i].BlockNumber < res[j].BlockNumber })
+	return res, nil
+}
+
+func (f *fakePendingRepo) DropDepositPending(ctx context.Context, id ...int64) (int64, error) {
+	var count int64
+	for _, v := range f.pending {
+		for _, i := range id {
+			if v.ID == i && biz.DepositPendingStatusPending == v.Status {
+				v.Status = biz.DepositPendingStatusDropped
+				count++
+			}
+		}
+	}
+	return count, nil
+}
+
+func (f *fakePendingRepo) GetDepositPendingByUserId(ctx context.Context, userId int64) ([]*biz.DepositPending, error) {
+	panic("not implemented")
+}
+
+type fakeTierRepo struct {
+	tiers []*biz.DepositTier
+}
+
+func (f *fakeTierRepo) GetDepositTiers(ctx context.Context) ([]*biz.DepositTier, error) {
+	return f.tiers, nil
+}
+
+func (f *fakeTierRepo) CreateDepositTier(ctx context.Context, t *biz.DepositTier) (*biz.DepositTier, error) {
+	f.tiers = append(f.tiers, t)
+	return t, nil
+}
+
+func (f *fakeTierRepo) UpdateDepositTier(ctx context.Context, t *biz.DepositTier) (*biz.DepositTier, error) {
+	panic("not implemented")
+}
+
+type fakeUserRepo struct {
+	biz.UserRepo
+	users map[string]*biz.User
+}
+
+func (f *fakeUserRepo) GetUserByAddresses(ctx context.Context, addresses ...string) (map[string]*biz.User, error) {
+	res := make(map[string]*biz.User)
+	for _, v := range addresses {
+		if u, ok := f.users[v]; ok {
+			res[v] = u
+		}
+	}
+	return res, nil
+}
+
+type fakeRecordRepo struct {
+	biz.EthUserRecordRepo
+	records []*biz.EthUserRecord
+}
+
+func (f *fakeRecordRepo) GetEthUserRecordListByKey(ctx context.Context, keys ...biz.EthUserRecordKey) (map[biz.EthUserRecordKey]*biz.EthUserRecord, error) {
+	res := make(map[biz.EthUserRecordKey]*biz.EthUserRecord)
+	for _, k := range keys {
+		for _, v := range f.records {
+			if k == v.Key() {
+				res[k] = v
+			}
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeRecordRepo) CreateEthUserRecordListByHash(ctx context.Context, r *biz.EthUserRecord) (*biz.EthUserRecord, error) {
+	tmp := *r
+	tmp.ID = int64(len(f.records) + 1)
+	f.records = append(f.records, &tmp)
+	return &tmp, nil
+}
+
+func (f *fakeRecordRepo) GetEthUserRecordListByBlock(ctx context.Context, chainId int64, coinType string, fromBlock uint64, toBlock uint64) ([]*biz.EthUserRecord, error) {
+	res := make([]*biz.EthUserRecord, 0)
+	for _, v := range f.records {
+		if v.ChainId != chainId || v.CoinType != coinType || ("success" != v.Status && biz.EthUserRecordStatusRetry != v.Status) {
+			continue
+		}
+		if 0 < v.BlockNumber && v.BlockNumber >= fromBlock && v.BlockNumber <= toBlock {
+			tmp := *v
+			res = append(res, &tmp)
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeRecordRepo) GetEthUserRecordsByStatus(ctx context.Context, chainId int64, status string) ([]*biz.EthUserRecord, error) {
+	res := make([]*biz.EthUserRecord, 0)
+	for _, v := range f.records {
+		if v.ChainId == chainId && v.Status == status {
+			tmp := *v
+			res = append(res, &tmp)
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeRecordRepo) UpdateEthUserRecordStatus(ctx context.Context, id int64, fromStatus string, status string) error {
+	for _, v := range f.records {
+		if v.ID == id && v.Status == fromStatus {
+			v.Status = status
+			return nil
+		}
+	}
+	return biz.ErrWithdrawStatusChanged
+}
+
+// fakeBalanceRepo 提现和分红相关的 UserBalanceRepo
+type fakeBalanceRepo struct {
+	biz.UserBalanceRepo
+	mu        sync.Mutex
+	withdraws map[int64]*biz.Withdraw
+	returned  map[int64]int64 // 退回余额，按用户
+	flagged   map[int64]string
+}
+
+func newFakeBalanceRepo() *fakeBalanceRepo {
+	return &fakeBalanceRepo{
+		withdraws: make(map[int64]*biz.Withdraw),
+		returned:  make(map[int64]int64),
+		flagged:   make(map[int64]string),
+	}
+}
+
+func (f *fakeBalanceRepo) FlagLocationRewards(ctx context.Context, locationId int64, review string) (int64, error) {
+	f.flagged[locationId] = review
+	return 1, nil
+}
+
+func (f *fakeBalanceRepo) GetWithdrawById(ctx context.Context, id int64) (*biz.Withdraw, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	tmp := *f.withdraws[id]
+	return &tmp, nil
+}
+
+func (f *fakeBalanceRepo) GetWithdrawsByStatus(ctx context.Context, status string) ([]*biz.Withdraw, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	res := make([]*biz.Withdraw, 0)
+	for _, v := range f.withdraws {
+		if status == v.Status {
+			tmp := *v
+			res = append(res, &tmp)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res, nil
+}
+
+func (f *fakeBalanceRepo) UpdateWithdrawStatus(ctx context.Context, id int64, fromStatus string, status string, amount int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w, ok := f.withdraws[id]
+	if !ok || fromStatus != w.Status {
+		return biz.ErrWithdrawStatusChanged
+	}
+	w.Status = status
+	if 0 < amount {
+		w.Amount = amount
+	}
+	return nil
+}
+
+func (f *fakeBalanceRepo) UpdateWithdrawPayoutTx(ctx context.Context, id int64, tx *biz.PayoutTx) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w := f.withdraws[id]
+	w.TxHash, w.Nonce, w.RawTx = tx.Hash, int64(tx.Nonce), tx.Raw
+	return nil
+}
+
+func (f *fakeBalanceRepo) UpdateWithdrawReceipt(ctx context.Context, id int64, txHash string, gasUsed int64, blockNumber int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w := f.withdraws[id]
+	w.TxHash, w.GasUsed, w.BlockNumber = txHash, gasUsed, blockNumber
+	return nil
+}
+
+func (f *fakeBalanceRepo) ReturnWithdraw(ctx context.Context, userId int64, amount int64, coinType string) error {
+	f.returned[userId] += amount
+	return nil
+}
+
+func (f *fakeBalanceRepo) CreateRefundWithdraw(ctx context.Context, w *biz.Withdraw) (*biz.Withdraw, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	tmp := *w
+	tmp.ID = int64(len(f.withdraws) + 1)
+	tmp.Status = biz.WithdrawStatusNew
+	tmp.Kind = biz.WithdrawKindRefund
+	f.withdraws[tmp.ID] = &tmp
+	res := tmp
+	return &res, nil
+}
+
+type fakeEventRepo struct {
+	events []*biz.WithdrawEvent
+}
+
+func (f *fakeEventRepo) CreateWithdrawEvent(ctx context.Context, e *biz.WithdrawEvent) error {
+	f.events = append(f.events, e)
+	return nil
+}
+
+func (f *fakeEventRepo) GetWithdrawEvents(ctx context.Context, withdrawId int64) ([]*biz.WithdrawEvent, error) {
+	res := make([]*biz.WithdrawEvent, 0)
+	for _, v := range f.events {
+		if withdrawId == v.WithdrawId {
+			res = append(res, v)
+		}
+	}
+	return res, nil
+}
+
+// fakeWallet 未配置 xpub，只扫描公共收款地址
+type fakeWallet struct {
+	biz.DepositWallet
+}
+
+func (fakeWallet) Enabled() bool {
+	return false
+}
+
+// fakePayer 按 hash 返回回执，未实现的方法不会被调用
+type fakePayer struct {
+	biz.Payer
+	receipts   map[string]*biz.PayoutReceipt
+	minedNonce uint64
+}
+
+func (f *fakePayer) Receipt(ctx context.Context, hash string) (*biz.PayoutReceipt, error) {
+	return f.receipts[hash], nil
+}
+
+func (f *fakePayer) MinedNonce(ctx context.Context) (uint64, error) {
+	return f.minedNonce, nil
+}
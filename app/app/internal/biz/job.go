@@ -0,0 +1,200 @@
+package biz
+
+import (
+	"context"
+	"dhb/app/app/internal/conf"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+const (
+	JobDeposit          = "deposit"
+	JobWithdraw         = "withdraw"
+	JobWithdrawEth      = "withdraw_eth"
+	JobWithdrawReceipt  = "withdraw_receipt"
+	JobFee              = "fee"
+	JobDepositSweep     = "deposit_sweep"
+	JobDepositSubmit    = "deposit_submit"    // 用户提交交易 hash 入账，与扫描任务互斥
+	JobDepositWebhook   = "deposit_webhook"   // 索引服务推送充值，与扫描任务互斥
+	JobDepositUnmatched = "deposit_unmatched" // 后台处理未匹配档位的充值，与扫描任务互斥
+
+	JobRunStatusRunning = "running"
+	JobRunStatusSuccess = "success"
+	JobRunStatusFail    = "fail"
+
+	jobLockBalance = "balance" // 充值入账、提现分红、手续费分配、归集，读写点位和余额
+	jobLockPayout  = "payout"  // 打款、回执，只修改打款状态
+
+	jobLockExpireDefault = 2 * time.Minute
+	jobRunErrorMax       = 1000
+)
+
+// jobLockDomains 同一互斥域的任务共用一把锁，未列出的任务按任务名单独加锁；
+// 修改点位或余额的任务必须在 balance 域，互相之间不能并发
+var jobLockDomains = map[string]string{
+	JobDeposit:          jobLockBalance,
+	JobDepositSubmit:    jobLockBalance,
+	JobDepositWebhook:   jobLockBalance,
+	JobDepositUnmatched: jobLockBalance,
+	JobDepositSweep:     jobLockBalance,
+	JobWithdraw:         jobLockBalance,
+	JobFee:              jobLockBalance,
+	JobWithdrawEth:      jobLockPayout,
+	JobWithdrawReceipt:  jobLockPayout,
+}
+
+var ErrJobRunning = errors.New(409, "JOB_RUNNING", "其他任务执行中，请稍后再试")
+
+type JobRun struct {
+	ID        int64
+	Name      string
+	Status    string
+	StartedAt time.Time
+	EndedAt   time.Time
+	Items     int64
+	Error     string
+}
+
+type JobRepo interface {
+	LockJob(ctx context.Context, domain string, token string, expire time.Duration) (bool, error)
+	ExtendJobLock(ctx context.Context, domain string, token string, expire time.Duration) (bool, error)
+	UnLockJob(ctx context.Context, domain string, token string) error
+	CreateJobRun(ctx context.Context, jr *JobRun) (*JobRun, error)
+	UpdateJobRun(ctx context.Context, jr *JobRun) error
+	GetJobRunLastSuccess(ctx context.Context, name string) (*JobRun, error)
+	GetJobRuns(ctx context.Context, b *Pagination, name string) ([]*JobRun, error, int64)
+}
+
+type JobUseCase struct {
+	repo JobRepo
+	cs   *conf.Scheduler
+	log  *log.Helper
+}
+
+func NewJobUseCase(repo JobRepo, cs *conf.Scheduler, logger log.Logger) *JobUseCase {
+	return &JobUseCase{
+		repo: repo,
+		cs:   cs,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Run 执行任务并记录 job_run，同一互斥域的任务通过锁互斥，执行期间定期续期，
+// 定时触发和接口触发都经过这里
+func (juc *JobUseCase) Run(ctx context.Context, name string, fn func(ctx context.Context) (int64, error)) (int64, error) {
+	token, err := randomHex(16)
+	if nil != err {
+		return 0, err
+	}
+
+	domain, ok := jobLockDomains[name]
+	if !ok {
+		domain = name
+	}
+	lockExpire := jobLockExpireDefault
+	if 0 < juc.cs.GetLockExpire().AsDuration() {
+		lockExpire = juc.cs.GetLockExpire().AsDuration()
+	}
+	lock, err := juc.repo.LockJob(ctx, domain, token, lockExpire)
+	if nil != err {
+		return 0, err
+	}
+	if !lock {
+		return 0, ErrJobRunning
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	defer func() {
+		close(done)
+		cancel()
+		if err := juc.repo.UnLockJob(context.Background(), domain, token); nil != err {
+			juc.log.Errorf("unlock job %s: %v", name, err)
+		}
+	}()
+	go juc.extendLock(cancel, done, name, domain, token, lockExpire)
+
+	// 按月执行的任务本月已成功过则跳过
+	if juc.monthly(name) {
+		last, err := juc.repo.GetJobRunLastSuccess(ctx, name)
+		if nil != err {
+			return 0, err
+		}
+		if nil != last && sameMonth(last.StartedAt, time.Now()) {
+			return 0, nil
+		}
+	}
+
+	jobRun, err := juc.repo.CreateJobRun(ctx, &JobRun{
+		Name:      name,
+		Status:    JobRunStatusRunning,
+		StartedAt: time.Now(),
+	})
+	if nil != err {
+		return 0, err
+	}
+
+	items, runErr := fn(ctx)
+
+	jobRun.Items = items
+	jobRun.EndedAt = time.Now()
+	jobRun.Status = JobRunStatusSuccess
+	if nil != runErr {
+		jobRun.Status = JobRunStatusFail
+		jobRun.Error = runErr.Error()
+		if jobRunErrorMax < len(jobRun.Error) {
+			jobRun.Error = jobRun.Error[:jobRunErrorMax]
+		}
+	}
+	if err = juc.repo.UpdateJobRun(context.Background(), jobRun); nil != err {
+		juc.log.Errorf("update job run %d: %v", jobRun.ID, err)
+	}
+
+	return items, runErr
+}
+
+// extendLock 每 1/3 过期时间续期一次，锁已丢失时取消任务，避免与其他实例同时执行
+func (juc *JobUseCase) extendLock(cancel context.CancelFunc, done chan struct{}, name string, domain string, token string, expire time.Duration) {
+	ticker := time.NewTicker(expire / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			ok, err := juc.repo.ExtendJobLock(context.Background(), domain, token, expire)
+			if nil != err {
+				juc.log.Errorf("extend job lock %s: %v", name, err)
+				continue
+			}
+			if !ok {
+				juc.log.Errorf("job %s lost lock %s, cancelled", name, domain)
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// GetJobRuns 后台查询任务执行记录
+func (juc *JobUseCase) GetJobRuns(ctx context.Context, page int64, name string) ([]*JobRun, error, int64) {
+	return juc.repo.GetJobRuns(ctx, &Pagination{
+		PageNum:  int(page),
+		PageSize: 10,
+	}, name)
+}
+
+func (juc *JobUseCase) monthly(name string) bool {
+	for _, v := range juc.cs.GetJobs() {
+		if name == v.Name {
+			return v.Monthly
+		}
+	}
+	return false
+}
+
+func sameMonth(a time.Time, b time.Time) bool {
+	return a.Year() == b.Year() && a.Month() == b.Month()
+}
@@ -0,0 +1,115 @@
+package biz
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"dhb/app/app/internal/conf"
+	"encoding/hex"
+	"github.com/go-kratos/kratos/v2/errors"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const machineSkewDefault = 5 * time.Minute
+
+// MachineRequest 定时任务等服务调用方的签名请求
+type MachineRequest struct {
+	KeyId     string
+	Timestamp string
+	Signature string
+	Method    string
+	Path      string // 包含查询参数
+	BodyHash  string // 请求体 sha256 的 hex
+	Operation string
+}
+
+// MachineSignPayload 签名内容：method、path、timestamp、请求体 sha256 以换行拼接
+func MachineSignPayload(method string, path string, timestamp string, bodyHash string) string {
+	return strings.Join([]string{strings.ToUpper(method), path, timestamp, bodyHash}, "\n")
+}
+
+// VerifyMachineRequest 校验服务密钥签名、时间戳和接口授权，同一签名只能使用一次
+func (auc *AuthUseCase) VerifyMachineRequest(ctx context.Context, r *MachineRequest) error {
+	var secret []byte
+	for _, v := range auc.ca.MachineKeys {
+		if r.KeyId != v.KeyId || 0 >= len(auc.machineSecrets[v.KeyId]) {
+			continue
+		}
+		for _, operation := range v.Operations {
+			if operation == r.Operation {
+				secret = auc.machineSecrets[v.KeyId]
+				break
+			}
+		}
+		break
+	}
+	if nil == secret {
+		return errors.New(401, "MACHINE_KEY_INVALID", "服务密钥无效或未授权该接口")
+	}
+
+	skew := machineSkewDefault
+	if nil != auc.ca.MachineSkew && 0 < auc.ca.MachineSkew.AsDuration() {
+		skew = auc.ca.MachineSkew.AsDuration()
+	}
+	timestamp, err := strconv.ParseInt(r.Timestamp, 10, 64)
+	if nil != err {
+		return errors.New(401, "MACHINE_TIMESTAMP_INVALID", "时间戳错误")
+	}
+	if d := time.Since(time.Unix(timestamp, 0)); d > skew || d < -skew {
+		return errors.New(401, "MACHINE_TIMESTAMP_INVALID", "时间戳已过期")
+	}
+
+	sig, err := hex.DecodeString(r.Signature)
+	if nil != err {
+		return errors.New(401, "MACHINE_SIGN_INVALID", "签名错误")
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(MachineSignPayload(r.Method, r.Path, r.Timestamp, r.BodyHash)))
+	if !hmac.Equal(sig, mac.Sum(nil)) {
+		return errors.New(401, "MACHINE_SIGN_INVALID", "签名错误")
+	}
+
+	// 时间窗口内记录已使用的签名，防止重放
+	ok, err := auc.repo.UseMachineSignature(ctx, r.KeyId, strings.ToLower(r.Signature), 2*skew)
+	if nil != err {
+		return err
+	}
+	if !ok {
+		return errors.New(401, "MACHINE_SIGN_REPLAYED", "签名已被使用")
+	}
+
+	return nil
+}
+
+// machineSecrets 按 key_id 读取服务密钥，依次使用 secret_env、secret_file；都未配置或读取不到时返回错误
+func machineSecrets(keys []*conf.Auth_MachineKey) (map[string][]byte, error) {
+	res := make(map[string][]byte, len(keys))
+	for _, v := range keys {
+		var secret string
+		if "" != v.GetSecretEnv() {
+			var ok bool
+			if secret, ok = os.LookupEnv(v.GetSecretEnv()); !ok {
+				return nil, errors.New(500, "MACHINE_KEY_CONFIG_ERROR", "未设置环境变量 "+v.GetSecretEnv())
+			}
+		} else if "" != v.GetSecretFile() {
+			content, err := ioutil.ReadFile(v.GetSecretFile())
+			if nil != err {
+				return nil, errors.New(500, "MACHINE_KEY_CONFIG_ERROR", "读取 secret_file 失败: "+err.Error())
+			}
+			secret = string(content)
+		} else {
+			return nil, errors.New(500, "MACHINE_KEY_CONFIG_ERROR", v.GetKeyId()+" 未配置 secret_env 或 secret_file")
+		}
+
+		if secret = strings.TrimSpace(secret); "" == secret {
+			return nil, errors.New(500, "MACHINE_KEY_CONFIG_ERROR", v.GetKeyId()+" 密钥为空")
+		}
+		res[v.GetKeyId()] = []byte(secret)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,61 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"math/big"
+)
+
+// ErrPayoutInsufficientFunds 打款账户原生币不足以支付手续费
+var ErrPayoutInsufficientFunds = errors.New(500, "PAYOUT_INSUFFICIENT_FUNDS", "打款账户手续费不足")
+
+// ErrPayoutRejected 节点明确拒绝了交易，交易未广播，可以重新签名发送
+var ErrPayoutRejected = errors.New(500, "PAYOUT_REJECTED", "打款交易被节点拒绝")
+
+// PayoutTx 已签名的打款交易，发送前与提现一起保存，重启后用于恢复未打包的交易
+type PayoutTx struct {
+	Nonce uint64
+	Hash  string
+	Raw   string // 签名后的交易，0x 开头的 hex
+}
+
+// ReplacedTx 未打包的交易按更高的 gas price 用相同 nonce 重发
+type ReplacedTx struct {
+	Nonce   uint64
+	OldHash string
+	NewHash string
+	NewRaw  string
+}
+
+// PayoutReceipt 打款交易回执
+type PayoutReceipt struct {
+	Hash          string
+	Status        uint64 // 1 成功，0 执行失败
+	GasUsed       uint64
+	BlockNumber   uint64
+	Confirmations uint64 // 包含交易所在区块的确认数
+}
+
+// Payer 打款账户，本地按顺序分配 nonce，可以不等上链连续发送多笔交易
+type Payer interface {
+	// Address 打款账户地址
+	Address() string
+	// TransferToken 签名代币转账，先调用 save 保存交易再发送，节点接受后即返回，不等待上链。
+	// 返回的交易为 nil 时交易未广播，可以重新发送；不为 nil 且有错误时交易可能已广播，
+	// 交易保留在未打包列表中由 ReplaceStuck 用相同 nonce 重发，不能换 nonce 重新打款
+	TransferToken(ctx context.Context, contract string, to string, amount *big.Int, save func(tx *PayoutTx) error) (*PayoutTx, error)
+	// TransferNative 发送原生币转账
+	TransferNative(ctx context.Context, to string, amount *big.Int) (string, error)
+	// NativeBalance 包含未打包交易的原生币余额
+	NativeBalance(ctx context.Context) (*big.Int, error)
+	// Receipt 交易回执，未打包时返回 nil
+	Receipt(ctx context.Context, hash string) (*PayoutReceipt, error)
+	// Unconfirmed 已发送未打包的交易数
+	Unconfirmed(ctx context.Context) (uint64, error)
+	// MinedNonce 最新区块中打款账户的 nonce，小于该值的 nonce 都已被打包的交易使用
+	MinedNonce(ctx context.Context) (uint64, error)
+	// ReplaceStuck 与链上 nonce 同步，超过 stuck_after 未打包的交易提高 gas price 重发
+	ReplaceStuck(ctx context.Context) ([]*ReplacedTx, error)
+	// Restore 恢复重启前已保存的未打包交易
+	Restore(ctx context.Context, txs []*PayoutTx) error
+}
@@ -4,19 +4,56 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-kratos/kratos/v2/log"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 )
 
 type EthUserRecord struct {
-	ID       int64
-	UserId   int64
+	ID          int64
+	UserId      int64
+	ChainId     int64
+	Hash        string
+	Address     string // 发送地址
+	Status      string
+	Type        string
+	Amount      string
+	CoinType    string
+	BlockNumber uint64
+	TxIndex     int64
+	LogIndex    int64
+	LocationId  int64 // 本次充值产生的占位，分红记录通过它关联
+	CreatedAt   time.Time
+	Pair        *EthUserRecord // 组合档位配对的另一种代币转账，与本记录在同一事务中入账
+}
+
+// EthUserRecordLegacyLogIndex 按 hash 去重时期的历史记录迁移后的日志序号，代表整笔交易
+const EthUserRecordLegacyLogIndex = -1
+
+// EthUserRecordKey 入账记录的唯一键，一笔交易中的每个 Transfer 事件各一条记录
+type EthUserRecordKey struct {
+	ChainId  int64
 	Hash     string
-	Status   string
-	Type     string
-	Amount   string
-	CoinType string
+	LogIndex int64
+}
+
+// Key .
+func (r *EthUserRecord) Key() EthUserRecordKey {
+	return EthUserRecordKey{ChainId: r.ChainId, Hash: r.Hash, LogIndex: r.LogIndex}
+}
+
+const (
+	EthUserRecordSkipQuery   = "query"   // 查询占位或推荐人失败
+	EthUserRecordSkipRunning = "running" // 用户已有运行中的占位
+	EthUserRecordSkipTier    = "tier"    // 不匹配任何档位或组合档位缺少配对
+	EthUserRecordSkipTx      = "tx"      // 入账事务失败
+)
+
+// EthUserRecordSkip EthUserRecordHandle 未能入账的记录
+type EthUserRecordSkip struct {
+	Record *EthUserRecord
+	Reason string
 }
 
 type Location struct {
@@ -45,13 +82,23 @@ type RecordUseCase struct {
 	userBalanceRepo               UserBalanceRepo
 	userInfoRepo                  UserInfoRepo
 	userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo
+	depositTierRepo               DepositTierRepo
 	tx                            Transaction
 	log                           *log.Helper
 }
 
 type EthUserRecordRepo interface {
-	GetEthUserRecordListByHash(ctx context.Context, hash ...string) (map[string]*EthUserRecord, error)
+	GetEthUserRecordListByKey(ctx context.Context, keys ...EthUserRecordKey) (map[EthUserRecordKey]*EthUserRecord, error)
 	CreateEthUserRecordListByHash(ctx context.Context, r *EthUserRecord) (*EthUserRecord, error)
+	GetEthUserRecordListByBlock(ctx context.Context, chainId int64, coinType string, fromBlock uint64, toBlock uint64) ([]*EthUserRecord, error)
+	GetEthUserRecordListByStatus(ctx context.Context, b *Pagination, status ...string) ([]*EthUserRecord, error, int64)
+	GetEthUserRecordById(ctx context.Context, id int64) (*EthUserRecord, error)
+	GetEthUserRecordListByUserId(ctx context.Context, userId int64, status ...string) ([]*EthUserRecord, error)
+	GetLastEthUserRecordByUserId(ctx context.Context, userId int64, statuses ...string) (*EthUserRecord, error)
+	GetEthUserRecordsByStatus(ctx context.Context, chainId int64, status string) ([]*EthUserRecord, error)
+	UpdateEthUserRecordStatus(ctx context.Context, id int64, fromStatus string, status string) error
+	UpdateEthUserRecordLocation(ctx context.Context, id int64, userId int64, status string, locationId int64) error
+	ClaimEthUserRecord(ctx context.Context, address string, userId int64) (int64, error)
 }
 
 type LocationRepo interface {
@@ -83,6 +130,7 @@ func NewRecordUseCase(
 	userInfoRepo UserInfoRepo,
 	configRepo ConfigRepo,
 	userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo,
+	depositTierRepo DepositTierRepo,
 	tx Transaction,
 	logger log.Logger) *RecordUseCase {
 	return &RecordUseCase{
@@ -93,18 +141,23 @@ func NewRecordUseCase(
 		userBalanceRepo:               userBalanceRepo,
 		userCurrentMonthRecommendRepo: userCurrentMonthRecommendRepo,
 		userInfoRepo:                  userInfoRepo,
+		depositTierRepo:               depositTierRepo,
 		tx:                            tx,
 		log:                           log.NewHelper(logger),
 	}
 }
 
-func (ruc *RecordUseCase) GetEthUserRecordByTxHash(ctx context.Context, txHash ...string) (map[string]*EthUserRecord, error) {
-	return ruc.ethUserRecordRepo.GetEthUserRecordListByHash(ctx, txHash...)
+func (ruc *RecordUseCase) GetEthUserRecordByKey(ctx context.Context, keys ...EthUserRecordKey) (map[EthUserRecordKey]*EthUserRecord, error) {
+	return ruc.ethUserRecordRepo.GetEthUserRecordListByKey(ctx, keys...)
 }
 
-func (ruc *RecordUseCase) EthUserRecordHandle(ctx context.Context, ethUserRecord ...*EthUserRecord) (bool, error) {
+// EthUserRecordHandle 按链上顺序逐条入账并占位，保证占位行列和分红对象可复现。
+// 未能入账的记录不写入，连同原因返回，由调用方保存后重试
+func (ruc *RecordUseCase) EthUserRecordHandle(ctx context.Context, ethUserRecord ...*EthUserRecord) ([]*EthUserRecordSkip, error) {
+	ethUserRecord = sortEthUserRecord(ethUserRecord)
 
 	var (
+		skipped           = make([]*EthUserRecordSkip, 0)
 		configs           []*Config
 		recommendNeed     int64
 		recommendNeedVip1 int64
@@ -138,6 +191,11 @@ func (ruc *RecordUseCase) EthUserRecordHandle(ctx context.Context, ethUserRecord
 	}
 	fmt.Println(recommendNeed, recommendNeedVip1, recommendNeedVip2, recommendNeedVip3, recommendNeedVip4, recommendNeedVip5, timeAgain)
 
+	tiers, err := getDepositTiers(ctx, ruc.depositTierRepo)
+	if nil != err {
+		return nil, err
+	}
+
 	for _, v := range ethUserRecord {
 		var (
 			lastLocation                    *Location
@@ -167,6 +225,7 @@ func (ruc *RecordUseCase) EthUserRecordHandle(ctx context.Context, ethUserRecord
 		// 获取当前用户的占位信息，已经有运行中的跳过
 		myLocations, err = ruc.locationRepo.GetLocationsByUserId(ctx, v.UserId)
 		if nil == myLocations { // 查询异常跳过本次循环
+			skipped = append(skipped, &EthUserRecordSkip{Record: v, Reason: EthUserRecordSkipQuery})
 			continue
 		}
 		if 0 < len(myLocations) { // 也代表复投
@@ -179,6 +238,7 @@ func (ruc *RecordUseCase) EthUserRecordHandle(ctx context.Context, ethUserRecord
 			}
 
 			if tmpStatusRunning { // 有运行中直接跳过本次循环
+				skipped = append(skipped, &EthUserRecordSkip{Record: v, Reason: EthUserRecordSkipRunning})
 				continue
 			}
 		}
@@ -219,22 +279,19 @@ func (ruc *RecordUseCase) EthUserRecordHandle(ctx context.Context, ethUserRecord
 			}
 		}
 
-		// todo
-		if "100000000000000000000" == v.Amount {
-			locationCurrentLevel = 1
-			locationCurrentMax = 5000000000000
-			currentValue = 1000000000000
-		} else if "200000000000000000000" == v.Amount {
-			locationCurrentLevel = 2
-			locationCurrentMax = 10000000000000
-			currentValue = 2000000000000
-		} else if "500000000000000000000" == v.Amount {
-			locationCurrentLevel = 3
-			locationCurrentMax = 25000000000000
-			currentValue = 5000000000000
-		} else {
+		// 充值档位
+		tier := matchDepositTier(tiers, v.CoinType, v.Amount)
+		if nil == tier {
+			skipped = append(skipped, &EthUserRecordSkip{Record: v, Reason: EthUserRecordSkipTier})
 			continue
 		}
+		if "" != tier.PairToken && (nil == v.Pair || !strings.EqualFold(tier.PairToken, v.Pair.CoinType) || tier.PairAmount != v.Pair.Amount) { // 组合档位缺少配对
+			skipped = append(skipped, &EthUserRecordSkip{Record: v, Reason: EthUserRecordSkipTier})
+			continue
+		}
+		locationCurrentLevel = tier.Level
+		locationCurrentMax = tier.CurrentMax()
+		currentValue = tier.Value()
 		amount = currentValue
 
 		// 占位分红人
@@ -243,6 +300,7 @@ func (ruc *RecordUseCase) EthUserRecordHandle(ctx context.Context, ethUserRecord
 		// 推荐人
 		userRecommend, err = ruc.userRecommendRepo.GetUserRecommendByUserId(ctx, v.UserId)
 		if nil != err {
+			skipped = append(skipped, &EthUserRecordSkip{Record: v, Reason: EthUserRecordSkipQuery})
 			continue
 		}
 		if "" != userRecommend.RecommendCode {
@@ -468,39 +526,21 @@ func (ruc *RecordUseCase) EthUserRecordHandle(ctx context.Context, ethUserRecord
 				return err
 			}
 
-			_, err = ruc.ethUserRecordRepo.CreateEthUserRecordListByHash(ctx, &EthUserRecord{
-				Hash:     v.Hash,
-				UserId:   v.UserId,
-				Status:   v.Status,
-				Type:     v.Type,
-				Amount:   v.Amount,
-				CoinType: v.CoinType,
-			})
-			if nil != err {
-				return err
+			if nil != v.Pair { // 组合档位的另一种代币入余额
+				_, err = depositBalance(ctx, ruc.userBalanceRepo, v.Pair.CoinType, v.UserId, depositValue(v.Pair.Amount))
+				if nil != err {
+					return err
+				}
+				err = ruc.saveEthUserRecord(ctx, v.Pair, v.UserId, currentLocation.ID)
+				if nil != err {
+					return err
+				}
 			}
 
-			//dhbAmount, _ := strconv.ParseInt(ethUserRecord[k+1].Amount, 10, 64)
-			//dhbAmount /= 100000000                                                             // 转换为系统精度
-			//_, err = ruc.userBalanceRepo.DepositDhb(ctx, ethUserRecord[k+1].UserId, dhbAmount) // 充值
-			//if nil != err {
-			//	return err
-			//}
-			//
-			//_, err = ruc.ethUserRecordRepo.CreateEthUserRecordListByHash(ctx, &EthUserRecord{
-			//	Hash:     ethUserRecord[k+1].Hash,
-			//	UserId:   ethUserRecord[k+1].UserId,
-			//	Status:   ethUserRecord[k+1].Status,
-			//	Type:     ethUserRecord[k+1].Type,
-			//	Amount:   ethUserRecord[k+1].Amount,
-			//	CoinType: ethUserRecord[k+1].CoinType,
-			//})
-			//if nil != err {
-			//	return err
-			//}
-
-			return nil
+			return ruc.saveEthUserRecord(ctx, v, v.UserId, currentLocation.ID)
 		}); nil != err {
+			ruc.log.Errorf("eth user record: %s log %d not handled: %v", v.Hash, v.LogIndex, err)
+			skipped = append(skipped, &EthUserRecordSkip{Record: v, Reason: EthUserRecordSkipTx})
 			continue
 		}
 
@@ -523,7 +563,7 @@ func (ruc *RecordUseCase) EthUserRecordHandle(ctx context.Context, ethUserRecord
 		}
 	}
 
-	return true, nil
+	return skipped, nil
 }
 
 func (ruc *RecordUseCase) LockEthUserRecordHandle(ctx context.Context, ethUserRecord ...*EthUserRecord) (bool, error) {
@@ -545,3 +585,59 @@ func (ruc *RecordUseCase) LockEthUserRecordHandle(ctx context.Context, ethUserRe
 func (ruc *RecordUseCase) UnLockEthUserRecordHandle(ctx context.Context, ethUserRecord ...*EthUserRecord) (bool, error) {
 	return ruc.locationRepo.UnLockGlobalLocation(ctx)
 }
+
+// saveEthUserRecord 入账记录，已有记录（未匹配、未认领、等待配对）修改为已占位
+func (ruc *RecordUseCase) saveEthUserRecord(ctx context.Context, v *EthUserRecord, userId int64, locationId int64) error {
+	if 0 < v.ID {
+		return ruc.ethUserRecordRepo.UpdateEthUserRecordLocation(ctx, v.ID, userId, v.Status, locationId)
+	}
+
+	_, err := ruc.ethUserRecordRepo.CreateEthUserRecordListByHash(ctx, &EthUserRecord{
+		ChainId:     v.ChainId,
+		Hash:        v.Hash,
+		Address:     v.Address,
+		UserId:      userId,
+		Status:      v.Status,
+		Type:        v.Type,
+		Amount:      v.Amount,
+		CoinType:    v.CoinType,
+		BlockNumber: v.BlockNumber,
+		TxIndex:     v.TxIndex,
+		LogIndex:    v.LogIndex,
+		LocationId:  locationId,
+	})
+
+	return err
+}
+
+// ClaimOrphanDeposits 认领注册前从该地址转入的充值，只修改归属和状态，入账由充值任务在互斥锁内处理
+func (ruc *RecordUseCase) ClaimOrphanDeposits(ctx context.Context, user *User) (int64, error) {
+	return ruc.ethUserRecordRepo.ClaimEthUserRecord(ctx, user.Address, user.ID)
+}
+
+// sortEthUserRecord 按 (区块, 交易序号, 日志序号) 升序排列，不修改传入的切片
+func sortEthUserRecord(ethUserRecord []*EthUserRecord) []*EthUserRecord {
+	res := make([]*EthUserRecord, len(ethUserRecord))
+	copy(res, ethUserRecord)
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].BlockNumber != res[j].BlockNumber {
+			return res[i].BlockNumber < res[j].BlockNumber
+		}
+		if res[i].TxIndex != res[j].TxIndex {
+			return res[i].TxIndex < res[j].TxIndex
+		}
+		return res[i].LogIndex < res[j].LogIndex
+	})
+
+	return res
+}
+
+// GetLastDepositAt 用户最近一次已入账或已入余额的充值时间，没有充值时为零值；未匹配、待认领、已回滚等未入账的记录不计入
+func (ruc *RecordUseCase) GetLastDepositAt(ctx context.Context, userId int64) (time.Time, error) {
+	record, err := ruc.ethUserRecordRepo.GetLastEthUserRecordByUserId(ctx, userId, "success", EthUserRecordStatusUnmatchedCredit)
+	if nil != err || nil == record {
+		return time.Time{}, err
+	}
+
+	return record.CreatedAt, nil
+}
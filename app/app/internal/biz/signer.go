@@ -0,0 +1,19 @@
+package biz
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/go-kratos/kratos/v2/errors"
+	"math/big"
+)
+
+// ErrSignerNotConfigured 链未配置打款签名账户
+var ErrSignerNotConfigured = errors.New(500, "SIGNER_NOT_CONFIGURED", "未配置打款签名账户")
+
+// Signer 热钱包签名账户，私钥只保存在加密 keystore 文件或远程签名服务中，不出现在代码和配置里
+type Signer interface {
+	// Address 签名账户地址
+	Address() string
+	// SignTx 按 EIP-155 签名交易
+	SignTx(ctx context.Context, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error)
+}
@@ -0,0 +1,107 @@
+package biz
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const siweHeaderSuffix = " wants you to sign in with your Ethereum account:"
+
+// SiweMessage Sign-In with Ethereum(EIP-4361) 消息
+type SiweMessage struct {
+	Domain         string
+	Address        string
+	Statement      string
+	URI            string
+	Version        string
+	ChainId        int64
+	Nonce          string
+	IssuedAt       time.Time
+	ExpirationTime time.Time
+	NotBefore      time.Time
+	RequestId      string
+	Resources      []string
+}
+
+// ParseSiweMessage 按 EIP-4361 格式解析消息
+func ParseSiweMessage(message string) (*SiweMessage, error) {
+	var (
+		siwe  = &SiweMessage{}
+		lines = strings.Split(strings.ReplaceAll(message, "\r\n", "\n"), "\n")
+		i     int
+		err   error
+	)
+
+	if 2 > len(lines) || !strings.HasSuffix(lines[0], siweHeaderSuffix) {
+		return nil, errors.New(401, "SIWE_INVALID", "签名消息格式错误")
+	}
+	siwe.Domain = strings.TrimSuffix(lines[0], siweHeaderSuffix)
+	if idx := strings.Index(siwe.Domain, "://"); 0 <= idx { // 可选的 scheme
+		siwe.Domain = siwe.Domain[idx+3:]
+	}
+	siwe.Address = lines[1]
+	if "" == siwe.Domain || !common.IsHexAddress(siwe.Address) {
+		return nil, errors.New(401, "SIWE_INVALID", "签名消息格式错误")
+	}
+
+	// 地址后空一行，之后到 URI 之前的非空行为 statement
+	for i = 2; i < len(lines) && !strings.HasPrefix(lines[i], "URI: "); i++ {
+		if "" != lines[i] {
+			if "" != siwe.Statement {
+				return nil, errors.New(401, "SIWE_INVALID", "签名消息格式错误")
+			}
+			siwe.Statement = lines[i]
+		}
+	}
+
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if "" == line {
+			continue
+		}
+		if "Resources:" == line {
+			for i++; i < len(lines) && strings.HasPrefix(lines[i], "- "); i++ {
+				siwe.Resources = append(siwe.Resources, strings.TrimPrefix(lines[i], "- "))
+			}
+			i--
+			continue
+		}
+
+		kv := strings.SplitN(line, ": ", 2)
+		if 2 != len(kv) {
+			return nil, errors.New(401, "SIWE_INVALID", "签名消息格式错误")
+		}
+		switch kv[0] {
+		case "URI":
+			siwe.URI = kv[1]
+		case "Version":
+			siwe.Version = kv[1]
+		case "Chain ID":
+			siwe.ChainId, err = strconv.ParseInt(kv[1], 10, 64)
+		case "Nonce":
+			siwe.Nonce = kv[1]
+		case "Issued At":
+			siwe.IssuedAt, err = time.Parse(time.RFC3339, kv[1])
+		case "Expiration Time":
+			siwe.ExpirationTime, err = time.Parse(time.RFC3339, kv[1])
+		case "Not Before":
+			siwe.NotBefore, err = time.Parse(time.RFC3339, kv[1])
+		case "Request ID":
+			siwe.RequestId = kv[1]
+		default:
+			return nil, errors.New(401, "SIWE_INVALID", "签名消息格式错误")
+		}
+		if nil != err {
+			return nil, errors.New(401, "SIWE_INVALID", "签名消息格式错误")
+		}
+	}
+
+	if "" == siwe.URI || "1" != siwe.Version || 0 >= siwe.ChainId || 8 > len(siwe.Nonce) || siwe.IssuedAt.IsZero() {
+		return nil, errors.New(401, "SIWE_INVALID", "签名消息格式错误")
+	}
+
+	return siwe, nil
+}
@@ -61,6 +61,15 @@ type Withdraw struct {
 	BalanceRecordId int64
 	Status          string
 	Type            string
+	ChainId         int64  // 打款所在链
+	Kind            string // 为 refund 时是充值原路退款，不计入提现限额
+	Address         string // 打款地址，为空时打给用户地址
+	TxHash          string // 打款交易 hash，提高 gas price 重发后为新交易
+	Nonce           int64  // 打款交易 nonce
+	RawTx           string // 签名后的打款交易，重启后恢复未打包的交易
+	ReplacedTxHash  string // 被重发替换的交易 hash，逗号分隔
+	GasUsed         int64
+	BlockNumber     int64
 	CreatedAt       time.Time
 }
 
@@ -77,6 +86,9 @@ type UserUseCase struct {
 	ubRepo                        UserBalanceRepo
 	locationRepo                  LocationRepo
 	userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo
+	ruc                           *RecordUseCase
+	wuc                           *WithdrawUseCase
+	chains                        Chains
 	tx                            Transaction
 	log                           *log.Helper
 }
@@ -101,6 +113,7 @@ type Pagination struct {
 
 type ConfigRepo interface {
 	GetConfigByKeys(ctx context.Context, keys ...string) ([]*Config, error)
+	LockConfig(ctx context.Context, key string) error
 	GetConfigs(ctx context.Context) ([]*Config, error)
 	UpdateConfig(ctx context.Context, id int64, value string) (bool, error)
 }
@@ -122,20 +135,26 @@ type UserBalanceRepo interface {
 	DepositLast(ctx context.Context, userId int64, lastAmount int64, locationId int64) (int64, error)
 	DepositDhb(ctx context.Context, userId int64, amount int64) (int64, error)
 	GetUserBalance(ctx context.Context, userId int64) (*UserBalance, error)
+	LockUserBalance(ctx context.Context, userId int64) error
 	GetUserRewardByUserId(ctx context.Context, userId int64) ([]*Reward, error)
 	GetUserRewardByUserIds(ctx context.Context, userIds ...int64) (map[int64]*UserSortRecommendReward, error)
 	GetUserRewards(ctx context.Context, b *Pagination, userId int64) ([]*Reward, error, int64)
 	GetUserRewardsLastMonthFee(ctx context.Context) ([]*Reward, error)
 	GetUserBalanceByUserIds(ctx context.Context, userIds ...int64) (map[int64]*UserBalance, error)
 	GetUserBalanceUsdtTotal(ctx context.Context) (int64, error)
-	GreateWithdraw(ctx context.Context, userId int64, amount int64, coinType string) (*Withdraw, error)
+	GreateWithdraw(ctx context.Context, userId int64, amount int64, coinType string, chainId int64) (*Withdraw, error)
 	WithdrawUsdt(ctx context.Context, userId int64, amount int64) error
 	WithdrawDhb(ctx context.Context, userId int64, amount int64) error
 	GetWithdrawByUserId(ctx context.Context, userId int64) ([]*Withdraw, error)
 	GetWithdraws(ctx context.Context, b *Pagination, userId int64) ([]*Withdraw, error, int64)
 	GetWithdrawPassOrRewarded(ctx context.Context) ([]*Withdraw, error)
-	UpdateWithdraw(ctx context.Context, id int64, status string) (*Withdraw, error)
+	UpdateWithdrawStatus(ctx context.Context, id int64, fromStatus string, status string, amount int64) error
 	GetWithdrawById(ctx context.Context, id int64) (*Withdraw, error)
+	GetWithdrawTotal(ctx context.Context, userId int64, coinType string, since time.Time) (int64, int64, error)
+	GetWithdrawsByStatus(ctx context.Context, status string) ([]*Withdraw, error)
+	UpdateWithdrawPayoutTx(ctx context.Context, id int64, tx *PayoutTx) error
+	ReplaceWithdrawTxHash(ctx context.Context, chainId int64, oldHash string, newHash string, newRaw string) error
+	UpdateWithdrawReceipt(ctx context.Context, id int64, txHash string, gasUsed int64, blockNumber int64) error
 	GetWithdrawNotDeal(ctx context.Context) ([]*Withdraw, error)
 	GetUserBalanceRecordUserUsdtTotal(ctx context.Context, userId int64) (int64, error)
 	GetUserBalanceRecordUsdtTotal(ctx context.Context) (int64, error)
@@ -144,9 +163,12 @@ type UserBalanceRepo interface {
 	GetUserWithdrawUsdtTotal(ctx context.Context) (int64, error)
 	GetUserRewardUsdtTotal(ctx context.Context) (int64, error)
 	GetSystemRewardUsdtTotal(ctx context.Context) (int64, error)
-	UpdateWithdrawAmount(ctx context.Context, id int64, status string, amount int64) (*Withdraw, error)
+	ReturnWithdraw(ctx context.Context, userId int64, amount int64, coinType string) error
 	GetUserRewardRecommendSort(ctx context.Context) ([]*UserSortRecommendReward, error)
 	GetUserRewardTodayTotalByUserId(ctx context.Context, userId int64) (*UserSortRecommendReward, error)
+	FlagLocationRewards(ctx context.Context, locationId int64, review string) (int64, error)
+	DepositUsdt(ctx context.Context, userId int64, amount int64) (int64, error)
+	CreateRefundWithdraw(ctx context.Context, w *Withdraw) (*Withdraw, error)
 }
 
 type UserRecommendRepo interface {
@@ -182,7 +204,7 @@ type UserRepo interface {
 	GetUserCountToday(ctx context.Context) (int64, error)
 }
 
-func NewUserUseCase(repo UserRepo, tx Transaction, configRepo ConfigRepo, uiRepo UserInfoRepo, urRepo UserRecommendRepo, locationRepo LocationRepo, userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo, ubRepo UserBalanceRepo, logger log.Logger) *UserUseCase {
+func NewUserUseCase(repo UserRepo, tx Transaction, configRepo ConfigRepo, uiRepo UserInfoRepo, urRepo UserRecommendRepo, locationRepo LocationRepo, userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo, ubRepo UserBalanceRepo, ruc *RecordUseCase, wuc *WithdrawUseCase, chains Chains, logger log.Logger) *UserUseCase {
 	return &UserUseCase{
 		repo:                          repo,
 		tx:                            tx,
@@ -192,6 +214,9 @@ func NewUserUseCase(repo UserRepo, tx Transaction, configRepo ConfigRepo, uiRepo
 		uiRepo:                        uiRepo,
 		urRepo:                        urRepo,
 		ubRepo:                        ubRepo,
+		ruc:                           ruc,
+		wuc:                           wuc,
+		chains:                        chains,
 		log:                           log.NewHelper(logger),
 	}
 }
@@ -208,9 +233,6 @@ func (uuc *UserUseCase) GetExistUserByAddressOrCreate(ctx context.Context, u *Us
 	var (
 		user          *User
 		recommendUser *UserRecommend
-		userRecommend *UserRecommend
-		userInfo      *UserInfo
-		userBalance   *UserBalance
 		err           error
 		userId        int64
 		decodeBytes   []byte
@@ -242,17 +264,17 @@ func (uuc *UserUseCase) GetExistUserByAddressOrCreate(ctx context.Context, u *Us
 				return err
 			}
 
-			userInfo, err = uuc.uiRepo.CreateUserInfo(ctx, user) // 创建用户信息
+			_, err = uuc.uiRepo.CreateUserInfo(ctx, user) // 创建用户信息
 			if err != nil {
 				return err
 			}
 
-			userRecommend, err = uuc.urRepo.CreateUserRecommend(ctx, user, recommendUser) // 创建用户信息
+			_, err = uuc.urRepo.CreateUserRecommend(ctx, user, recommendUser) // 创建用户信息
 			if err != nil {
 				return err
 			}
 
-			userBalance, err = uuc.ubRepo.CreateUserBalance(ctx, user) // 创建余额信息
+			_, err = uuc.ubRepo.CreateUserBalance(ctx, user) // 创建余额信息
 			if err != nil {
 				return err
 			}
@@ -263,6 +285,11 @@ func (uuc *UserUseCase) GetExistUserByAddressOrCreate(ctx context.Context, u *Us
 		}
 	}
 
+	// 注册前转入的充值，认领是单条 update，失败时不影响登录，下次登录继续认领
+	if _, err = uuc.ruc.ClaimOrphanDeposits(ctx, user); nil != err {
+		uuc.log.Errorf("claim orphan deposits: user %d: %v", user.ID, err)
+	}
+
 	return user, nil
 }
 
@@ -521,6 +548,12 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 		userTodayReward = userTodayRewardTotal.Total
 	}
 
+	// 默认链的 USDT 合约
+	usdtContract := "0x55d398326f99059fF775485246999027B3197955"
+	if chain, err := uuc.chains.Get(0); nil == err && nil != chain.Token("USDT") {
+		usdtContract = chain.Token("USDT").Contract
+	}
+
 	return &v1.UserInfoReply{
 		Address:           myUser.Address,
 		Level:             userInfo.Vip,
@@ -540,7 +573,7 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 		Col:               colNum,
 		RecommendTotal:    fmt.Sprintf("%.2f", float64(recommendTotal)/float64(10000000000)),
 		LocationTotal:     fmt.Sprintf("%.2f", float64(locationTotal)/float64(10000000000)),
-		Usdt:              "0x55d398326f99059fF775485246999027B3197955",
+		Usdt:              usdtContract,
 		Account:           "0x12ad46402226ebf2b5dff48485bd0b1eb84cabce",
 		AmountB:           fmt.Sprintf("%.2f", float64(myLastLocationCurrent)/float64(10000000000)),
 		UserCount:         userCount,
@@ -685,6 +718,9 @@ func (uuc *UserUseCase) WithdrawList(ctx context.Context, user *User) (*v1.Withd
 			Amount:    fmt.Sprintf("%.2f", float64(v.RelAmount)/float64(10000000000)),
 			Status:    v.Status,
 			Type:      v.Type,
+			ChainId:   v.ChainId,
+			Id:        v.ID,
+			TxHash:    v.TxHash,
 		})
 	}
 
@@ -703,6 +739,17 @@ func (uuc *UserUseCase) Withdraw(ctx context.Context, req *v1.WithdrawRequest, u
 		}, nil
 	}
 
+	// 打款链，未传时为默认链，需配置了该代币
+	chain, err := uuc.chains.Get(req.SendBody.ChainId)
+	if nil != err {
+		return nil, err
+	}
+	if nil == chain.Token(req.SendBody.Type) {
+		return &v1.WithdrawReply{
+			Status: "fail",
+		}, nil
+	}
+
 	userBalance, err = uuc.ubRepo.GetUserBalance(ctx, user.ID)
 	if nil != err {
 		return nil, err
@@ -728,14 +775,19 @@ func (uuc *UserUseCase) Withdraw(ctx context.Context, req *v1.WithdrawRequest, u
 			Status: "fail",
 		}, nil
 	}
+
 	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		// 风控规则，锁定余额行后检查，不通过时不扣余额
+		if err = uuc.checkWithdrawRules(ctx, user, req.SendBody.Type, amount); nil != err {
+			return err
+		}
 
 		if "usdt" == req.SendBody.Type {
 			err = uuc.ubRepo.WithdrawUsdt(ctx, user.ID, amount) // 提现
 			if nil != err {
 				return err
 			}
-			_, err = uuc.ubRepo.GreateWithdraw(ctx, user.ID, amount, req.SendBody.Type)
+			_, err = uuc.ubRepo.GreateWithdraw(ctx, user.ID, amount, req.SendBody.Type, chain.ChainId())
 			if nil != err {
 				return err
 			}
@@ -745,7 +797,7 @@ func (uuc *UserUseCase) Withdraw(ctx context.Context, req *v1.WithdrawRequest, u
 			if nil != err {
 				return err
 			}
-			_, err = uuc.ubRepo.GreateWithdraw(ctx, user.ID, amount, req.SendBody.Type)
+			_, err = uuc.ubRepo.GreateWithdraw(ctx, user.ID, amount, req.SendBody.Type, chain.ChainId())
 			if nil != err {
 				return err
 			}
@@ -1129,14 +1181,6 @@ func (uuc *UserUseCase) GetWithdrawPassOrRewardedList(ctx context.Context) ([]*W
 	return uuc.ubRepo.GetWithdrawPassOrRewarded(ctx)
 }
 
-func (uuc *UserUseCase) UpdateWithdrawDoing(ctx context.Context, id int64) (*Withdraw, error) {
-	return uuc.ubRepo.UpdateWithdraw(ctx, id, "doing")
-}
-
-func (uuc *UserUseCase) UpdateWithdrawSuccess(ctx context.Context, id int64) (*Withdraw, error) {
-	return uuc.ubRepo.UpdateWithdraw(ctx, id, "success")
-}
-
 func (uuc *UserUseCase) AdminWithdrawList(ctx context.Context, req *v1.AdminWithdrawListRequest) (*v1.AdminWithdrawListReply, error) {
 	var (
 		withdraws  []*Withdraw
@@ -1189,13 +1233,17 @@ func (uuc *UserUseCase) AdminWithdrawList(ctx context.Context, req *v1.AdminWith
 			continue
 		}
 		res.Withdraw = append(res.Withdraw, &v1.AdminWithdrawListReply_List{
-			Id:        v.ID,
-			CreatedAt: v.CreatedAt.Format("2006-01-02 15:04:05"),
-			Amount:    fmt.Sprintf("%.2f", float64(v.Amount)/float64(10000000000)),
-			Status:    v.Status,
-			Type:      v.Type,
-			Address:   users[v.UserId].Address,
-			RelAmount: fmt.Sprintf("%.2f", float64(v.RelAmount)/float64(10000000000)),
+			Id:          v.ID,
+			CreatedAt:   v.CreatedAt.Format("2006-01-02 15:04:05"),
+			Amount:      fmt.Sprintf("%.2f", float64(v.Amount)/float64(10000000000)),
+			Status:      v.Status,
+			Type:        v.Type,
+			Address:     users[v.UserId].Address,
+			RelAmount:   fmt.Sprintf("%.2f", float64(v.RelAmount)/float64(10000000000)),
+			ChainId:     v.ChainId,
+			TxHash:      v.TxHash,
+			GasUsed:     v.GasUsed,
+			BlockNumber: v.BlockNumber,
 		})
 	}
 
@@ -1211,6 +1259,7 @@ func (uuc *UserUseCase) AdminFee(ctx context.Context, req *v1.AdminFeeRequest) (
 		userCount      int64
 		fee            int64
 		myLocationLast *Location
+		feeCount       int64
 		err            error
 	)
 
@@ -1284,9 +1333,10 @@ func (uuc *UserUseCase) AdminFee(ctx context.Context, req *v1.AdminFeeRequest) (
 		}); nil != err {
 			return nil, err
 		}
+		feeCount++
 	}
 
-	return &v1.AdminFeeReply{}, err
+	return &v1.AdminFeeReply{Count: feeCount}, err
 }
 
 func (uuc *UserUseCase) AdminAll(ctx context.Context, req *v1.AdminAllRequest) (*v1.AdminAllReply, error) {
@@ -1346,6 +1396,7 @@ func (uuc *UserUseCase) AdminWithdraw(ctx context.Context, req *v1.AdminWithdraw
 		recommendNeedVip3 int64
 		recommendNeedVip4 int64
 		recommendNeedVip5 int64
+		dealCount         int64
 		err               error
 	)
 	// 配置
@@ -1369,8 +1420,6 @@ func (uuc *UserUseCase) AdminWithdraw(ctx context.Context, req *v1.AdminWithdraw
 		}
 	}
 
-	time.Sleep(30 * time.Second) // 错开时间和充值
-
 	// todo 全局锁
 	//for i := 0; i < 3; i++ {
 	//	lock, _ = uuc.locationRepo.LockGlobalWithdraw(ctx)
@@ -1391,9 +1440,10 @@ func (uuc *UserUseCase) AdminWithdraw(ctx context.Context, req *v1.AdminWithdraw
 	}
 
 	for _, withdraw := range withdrawNotDeal {
-		if "" != withdraw.Status {
+		if WithdrawStatusNew != withdraw.Status {
 			continue
 		}
+		dealCount++
 
 		currentValue = withdraw.Amount
 
@@ -1619,7 +1669,7 @@ func (uuc *UserUseCase) AdminWithdraw(ctx context.Context, req *v1.AdminWithdraw
 				return err
 			}
 
-			_, err = uuc.ubRepo.UpdateWithdrawAmount(ctx, withdraw.ID, "rewarded", withdrawAmount)
+			err = uuc.wuc.TransitAmount(ctx, withdraw, WithdrawStatusRewarded, withdrawAmount, WithdrawActorSystem, "提现分红")
 			if nil != err {
 				return err
 			}
@@ -1650,5 +1700,5 @@ func (uuc *UserUseCase) AdminWithdraw(ctx context.Context, req *v1.AdminWithdraw
 
 	//_, _ = uuc.locationRepo.UnLockGlobalWithdraw(ctx)
 
-	return &v1.AdminWithdrawReply{}, nil
+	return &v1.AdminWithdrawReply{Count: dealCount}, nil
 }
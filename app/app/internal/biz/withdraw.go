@@ -0,0 +1,447 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"strings"
+	"time"
+)
+
+const (
+	WithdrawStatusNew       = ""          // 新建，等待提现分红
+	WithdrawStatusRewarded  = "rewarded"  // 已完成提现分红，等待打款
+	WithdrawStatusPass      = "pass"      // 无需分红（原路退款、失败后重新打款），等待打款
+	WithdrawStatusDoing     = "doing"     // 打款中
+	WithdrawStatusBroadcast = "broadcast" // 打款交易已广播，等待回执
+	WithdrawStatusConfirmed = "confirmed" // 打款交易已上链并达到确认数
+	WithdrawStatusSuccess   = "success"   // 已打款，只用于跟踪回执之前的历史数据
+	WithdrawStatusFailed    = "failed"    // 打款失败，已签名的交易确定不会上链后才能重新打款或拒绝
+	WithdrawStatusRejected  = "rejected"  // 已拒绝，金额退回余额
+	WithdrawStatusCancelled = "cancelled" // 用户已取消，金额退回余额
+
+	WithdrawKindRefund = "refund" // 充值原路退款
+
+	WithdrawActorSystem = "system" // 定时任务
+
+	withdrawAutoRetryMax = 3 // 打款交易执行失败后自动重新打款的次数，超过后等待人工处理
+)
+
+// withdrawTransitions 提现状态机，只允许列出的状态变化
+var withdrawTransitions = map[string][]string{
+	WithdrawStatusNew:       {WithdrawStatusRewarded, WithdrawStatusPass, WithdrawStatusRejected, WithdrawStatusCancelled},
+	WithdrawStatusRewarded:  {WithdrawStatusDoing, WithdrawStatusRejected},
+	WithdrawStatusPass:      {WithdrawStatusDoing, WithdrawStatusRejected},
+	WithdrawStatusDoing:     {WithdrawStatusBroadcast, WithdrawStatusFailed},
+	WithdrawStatusBroadcast: {WithdrawStatusConfirmed, WithdrawStatusFailed},
+	WithdrawStatusFailed:    {WithdrawStatusPass, WithdrawStatusRejected, WithdrawStatusConfirmed},
+}
+
+var (
+	ErrWithdrawTransition    = errors.New(500, "WITHDRAW_TRANSITION_ERROR", "提现状态不允许该操作")
+	ErrWithdrawStatusChanged = errors.New(500, "WITHDRAW_STATUS_CHANGED", "提现状态已变化")
+	ErrWithdrawTxPending     = errors.New(500, "WITHDRAW_TX_PENDING", "已签名的打款交易仍可能上链")
+	ErrWithdrawTxMined       = errors.New(500, "WITHDRAW_TX_MINED", "打款交易已上链")
+)
+
+// WithdrawEvent 提现状态变化记录
+type WithdrawEvent struct {
+	ID         int64
+	WithdrawId int64
+	FromStatus string
+	ToStatus   string
+	Actor      string // system、user:ID 或 admin:ID
+	Reason     string
+	CreatedAt  time.Time
+}
+
+type WithdrawEventRepo interface {
+	CreateWithdrawEvent(ctx context.Context, e *WithdrawEvent) error
+	GetWithdrawEvents(ctx context.Context, withdrawId int64) ([]*WithdrawEvent, error)
+}
+
+type WithdrawUseCase struct {
+	ubRepo    UserBalanceRepo
+	eventRepo WithdrawEventRepo
+	chains    Chains
+	tx        Transaction
+	log       *log.Helper
+}
+
+func NewWithdrawUseCase(ubRepo UserBalanceRepo, eventRepo WithdrawEventRepo, chains Chains, tx Transaction, logger log.Logger) *WithdrawUseCase {
+	return &WithdrawUseCase{
+		ubRepo:    ubRepo,
+		eventRepo: eventRepo,
+		chains:    chains,
+		tx:        tx,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// CanTransit 状态机是否允许 from 变为 to
+func CanTransit(from string, to string) bool {
+	for _, v := range withdrawTransitions[from] {
+		if to == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Transit 按状态机修改提现状态并记录事件，只修改状态仍为 w.Status 的记录，成功后更新 w.Status
+func (wuc *WithdrawUseCase) Transit(ctx context.Context, w *Withdraw, to string, actor string, reason string) error {
+	return wuc.transit(ctx, w, to, 0, actor, reason, nil)
+}
+
+// TransitAmount 同 Transit，同时修改金额，用于提现分红后记录实际打款金额
+func (wuc *WithdrawUseCase) TransitAmount(ctx context.Context, w *Withdraw, to string, amount int64, actor string, reason string) error {
+	if 0 >= amount {
+		return errors.New(500, "WITHDRAW_AMOUNT_ERROR", "提现金额错误")
+	}
+	return wuc.transit(ctx, w, to, amount, actor, reason, nil)
+}
+
+// transit fn 不为空时与状态修改在同一事务中执行
+func (wuc *WithdrawUseCase) transit(ctx context.Context, w *Withdraw, to string, amount int64, actor string, reason string, fn func(ctx context.Context) error) error {
+	if !CanTransit(w.Status, to) {
+		return ErrWithdrawTransition
+	}
+
+	if err := wuc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if err := wuc.ubRepo.UpdateWithdrawStatus(ctx, w.ID, w.Status, to, amount); nil != err {
+			return err
+		}
+		if nil != fn {
+			if err := fn(ctx); nil != err {
+				return err
+			}
+		}
+		return wuc.eventRepo.CreateWithdrawEvent(ctx, &WithdrawEvent{
+			WithdrawId: w.ID,
+			FromStatus: w.Status,
+			ToStatus:   to,
+			Actor:      actor,
+			Reason:     reason,
+		})
+	}); nil != err {
+		return err
+	}
+
+	w.Status = to
+	if 0 < amount {
+		w.Amount = amount
+	}
+
+	return nil
+}
+
+// Refund 创建原路退款并改为待打款，打给 address，不经过提现分红，也不计入提现限额
+func (wuc *WithdrawUseCase) Refund(ctx context.Context, w *Withdraw, actor string, reason string) (*Withdraw, error) {
+	if 0 >= w.Amount {
+		return nil, errors.New(500, "WITHDRAW_AMOUNT_ERROR", "提现金额错误")
+	}
+	if "" == w.Address {
+		return nil, errors.New(500, "WITHDRAW_ADDRESS_ERROR", "退款地址错误")
+	}
+
+	var refund *Withdraw
+	if err := wuc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		var err error
+		refund, err = wuc.ubRepo.CreateRefundWithdraw(ctx, w)
+		if nil != err {
+			return err
+		}
+		return wuc.transit(ctx, refund, WithdrawStatusPass, 0, actor, reason, nil)
+	}); nil != err {
+		return nil, err
+	}
+
+	return refund, nil
+}
+
+// SavePayoutTx 发送前保存签名后的打款交易，待打款的提现在同一事务中改为打款中；
+// 节点拒绝后重新签名时覆盖之前保存的交易
+func (wuc *WithdrawUseCase) SavePayoutTx(ctx context.Context, w *Withdraw, tx *PayoutTx) error {
+	save := func(ctx context.Context) error {
+		return wuc.ubRepo.UpdateWithdrawPayoutTx(ctx, w.ID, tx)
+	}
+
+	var err error
+	if WithdrawStatusDoing == w.Status {
+		err = save(ctx)
+	} else {
+		err = wuc.transit(ctx, w, WithdrawStatusDoing, 0, WithdrawActorSystem, "开始打款 tx "+tx.Hash, save)
+	}
+	if nil != err {
+		return err
+	}
+
+	w.TxHash = tx.Hash
+	w.Nonce = int64(tx.Nonce)
+	w.RawTx = tx.Raw
+	return nil
+}
+
+// FailUnsent 打款交易确定未广播时标记为打款失败，同时清除已保存的交易，之后可以直接重新打款或拒绝
+func (wuc *WithdrawUseCase) FailUnsent(ctx context.Context, w *Withdraw, reason string) error {
+	if err := wuc.transit(ctx, w, WithdrawStatusFailed, 0, WithdrawActorSystem, reason, func(ctx context.Context) error {
+		return wuc.ubRepo.UpdateWithdrawPayoutTx(ctx, w.ID, &PayoutTx{})
+	}); nil != err {
+		return err
+	}
+
+	w.TxHash, w.Nonce, w.RawTx = "", 0, ""
+	return nil
+}
+
+// Broadcast 已保存的打款交易已发送，等待回执
+func (wuc *WithdrawUseCase) Broadcast(ctx context.Context, w *Withdraw) error {
+	return wuc.transit(ctx, w, WithdrawStatusBroadcast, 0, WithdrawActorSystem, "tx "+w.TxHash, nil)
+}
+
+// ReplaceTxHash 打款交易提高 gas price 重发后记录新交易，原交易 hash 保留用于查询回执
+func (wuc *WithdrawUseCase) ReplaceTxHash(ctx context.Context, chainId int64, r *ReplacedTx) error {
+	return wuc.ubRepo.ReplaceWithdrawTxHash(ctx, chainId, r.OldHash, r.NewHash, r.NewRaw)
+}
+
+// RestorePayout 打款前恢复上次运行中断的交易：已保存交易但还是打款中的提现改为已广播，
+// 已保存的未确认交易交给打款账户，由 ReplaceStuck 用相同 nonce 重发，不会重新打款
+func (wuc *WithdrawUseCase) RestorePayout(ctx context.Context, chain *Chain) error {
+	doing, err := wuc.ubRepo.GetWithdrawsByStatus(ctx, WithdrawStatusDoing)
+	if nil != err {
+		return err
+	}
+	broadcast, err := wuc.ubRepo.GetWithdrawsByStatus(ctx, WithdrawStatusBroadcast)
+	if nil != err {
+		return err
+	}
+
+	txs := make([]*PayoutTx, 0)
+	for _, w := range append(doing, broadcast...) {
+		if chain.ChainId() != w.ChainId || "" == w.RawTx {
+			continue
+		}
+		if WithdrawStatusDoing == w.Status {
+			if err = wuc.Broadcast(ctx, w); nil != err {
+				return err
+			}
+		}
+		txs = append(txs, &PayoutTx{Nonce: uint64(w.Nonce), Hash: w.TxHash, Raw: w.RawTx})
+	}
+
+	return chain.Payer.Restore(ctx, txs)
+}
+
+// payoutDropped 已保存的打款交易不会再上链时返回 nil：没有保存交易、交易已执行失败，或 nonce 已被其他交易使用。
+// 交易已成功上链返回 ErrWithdrawTxMined，仍可能上链返回 ErrWithdrawTxPending
+func (wuc *WithdrawUseCase) payoutDropped(ctx context.Context, w *Withdraw) error {
+	if "" == w.RawTx {
+		return nil
+	}
+	chain, err := wuc.chains.Get(w.ChainId)
+	if nil != err {
+		return err
+	}
+	if nil == chain.Payer {
+		return ErrWithdrawTxPending
+	}
+
+	receipt, err := wuc.receipt(ctx, chain, w)
+	if nil != err {
+		return err
+	}
+	if nil == receipt {
+		nonce, err := chain.Payer.MinedNonce(ctx)
+		if nil != err {
+			return err
+		}
+		if nonce <= uint64(w.Nonce) {
+			return ErrWithdrawTxPending
+		}
+		// nonce 已被使用，再查一次回执，避免两次查询之间刚好打包了这笔交易
+		if receipt, err = wuc.receipt(ctx, chain, w); nil != err {
+			return err
+		}
+		if nil == receipt {
+			return nil
+		}
+	}
+	if 1 == receipt.Status {
+		return ErrWithdrawTxMined
+	}
+
+	return nil
+}
+
+// WatchReceipts 查询已广播提现的交易回执，达到链的确认数后标记为已到账；
+// 执行失败的标记为打款失败并自动重新打款。标记为打款失败但保存的交易之后上链成功的也改为已到账，返回处理条数
+func (wuc *WithdrawUseCase) WatchReceipts(ctx context.Context) (int64, error) {
+	withdraws, err := wuc.ubRepo.GetWithdrawsByStatus(ctx, WithdrawStatusBroadcast)
+	if nil != err {
+		return 0, err
+	}
+	failed, err := wuc.ubRepo.GetWithdrawsByStatus(ctx, WithdrawStatusFailed)
+	if nil != err {
+		return 0, err
+	}
+	for _, w := range failed {
+		if "" != w.RawTx {
+			withdraws = append(withdraws, w)
+		}
+	}
+
+	var count int64
+	for _, w := range withdraws {
+		chain, err := wuc.chains.Get(w.ChainId)
+		if nil != err || nil == chain.Payer {
+			wuc.log.Errorf("withdraw receipt: withdraw %d chain %d payout_signer not configured", w.ID, w.ChainId)
+			continue
+		}
+
+		receipt, err := wuc.receipt(ctx, chain, w)
+		if nil != err {
+			wuc.log.Errorf("withdraw receipt: withdraw %d err: %v", w.ID, err)
+			continue
+		}
+		if nil == receipt || receipt.Confirmations < uint64(chain.Confirmations()) {
+			continue
+		}
+		if WithdrawStatusFailed == w.Status && 1 != receipt.Status {
+			continue
+		}
+
+		if err = wuc.settle(ctx, w, receipt); nil != err {
+			wuc.log.Errorf("withdraw receipt: withdraw %d settle err: %v", w.ID, err)
+			continue
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+// receipt 依次查询当前交易和被替换的交易，相同 nonce 的交易只会有一笔上链
+func (wuc *WithdrawUseCase) receipt(ctx context.Context, chain *Chain, w *Withdraw) (*PayoutReceipt, error) {
+	hashes := []string{w.TxHash}
+	if "" != w.ReplacedTxHash {
+		hashes = append(hashes, strings.Split(w.ReplacedTxHash, ",")...)
+	}
+
+	for _, hash := range hashes {
+		if "" == hash {
+			continue
+		}
+		receipt, err := chain.Payer.Receipt(ctx, hash)
+		if nil != err {
+			return nil, err
+		}
+		if nil != receipt {
+			return receipt, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// settle 按回执记录上链结果，执行失败时未超过自动重试次数则重新打款
+func (wuc *WithdrawUseCase) settle(ctx context.Context, w *Withdraw, r *PayoutReceipt) error {
+	to, reason := WithdrawStatusConfirmed, "tx "+r.Hash
+	if 1 != r.Status {
+		to, reason = WithdrawStatusFailed, "交易执行失败 tx "+r.Hash
+	}
+
+	if err := wuc.transit(ctx, w, to, 0, WithdrawActorSystem, reason, func(ctx context.Context) error {
+		return wuc.ubRepo.UpdateWithdrawReceipt(ctx, w.ID, r.Hash, int64(r.GasUsed), int64(r.BlockNumber))
+	}); nil != err {
+		return err
+	}
+	if WithdrawStatusFailed != to {
+		return nil
+	}
+
+	// 交易执行失败时代币未转出，可以安全地重新打款
+	events, err := wuc.eventRepo.GetWithdrawEvents(ctx, w.ID)
+	if nil != err {
+		return err
+	}
+	var failed int
+	for _, e := range events {
+		if WithdrawStatusFailed == e.ToStatus {
+			failed++
+		}
+	}
+	if withdrawAutoRetryMax < failed {
+		return nil
+	}
+
+	return wuc.Transit(ctx, w, WithdrawStatusPass, WithdrawActorSystem, "交易执行失败，自动重新打款")
+}
+
+// Reject 拒绝提现并将金额退回余额，已分红的只退回分红后的金额
+func (wuc *WithdrawUseCase) Reject(ctx context.Context, id int64, actor string, reason string) error {
+	return wuc.refund(ctx, id, WithdrawStatusRejected, 0, actor, reason)
+}
+
+// Cancel 用户取消还未处理的提现，金额退回余额
+func (wuc *WithdrawUseCase) Cancel(ctx context.Context, id int64, userId int64, actor string) error {
+	return wuc.refund(ctx, id, WithdrawStatusCancelled, userId, actor, "用户取消")
+}
+
+func (wuc *WithdrawUseCase) refund(ctx context.Context, id int64, to string, userId int64, actor string, reason string) error {
+	w, err := wuc.ubRepo.GetWithdrawById(ctx, id)
+	if nil != err {
+		return err
+	}
+	if 0 < userId && userId != w.UserId {
+		return errors.NotFound("WITHDRAW_NOT_FOUND", "withdraw not found")
+	}
+
+	if !CanTransit(w.Status, to) {
+		return ErrWithdrawTransition
+	}
+	if err = wuc.payoutDropped(ctx, w); nil != err {
+		return err
+	}
+
+	return wuc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if err = wuc.Transit(ctx, w, to, actor, reason); nil != err {
+			return err
+		}
+		return wuc.ubRepo.ReturnWithdraw(ctx, w.UserId, w.Amount, strings.ToLower(w.Type))
+	})
+}
+
+// Retry 管理员确认打款失败的提现链上未到账后重新打款
+func (wuc *WithdrawUseCase) Retry(ctx context.Context, id int64, actor string, reason string) error {
+	w, err := wuc.ubRepo.GetWithdrawById(ctx, id)
+	if nil != err {
+		return err
+	}
+	if WithdrawStatusFailed != w.Status {
+		return ErrWithdrawTransition
+	}
+	if err = wuc.payoutDropped(ctx, w); nil != err {
+		return err
+	}
+
+	return wuc.Transit(ctx, w, WithdrawStatusPass, actor, reason)
+}
+
+// Fail 管理员将长时间处于打款中的提现标记为失败
+func (wuc *WithdrawUseCase) Fail(ctx context.Context, id int64, actor string, reason string) error {
+	w, err := wuc.ubRepo.GetWithdrawById(ctx, id)
+	if nil != err {
+		return err
+	}
+	if WithdrawStatusDoing != w.Status {
+		return ErrWithdrawTransition
+	}
+
+	return wuc.Transit(ctx, w, WithdrawStatusFailed, actor, reason)
+}
+
+// GetWithdrawEvents 提现的状态变化记录
+func (wuc *WithdrawUseCase) GetWithdrawEvents(ctx context.Context, withdrawId int64) ([]*WithdrawEvent, error) {
+	return wuc.eventRepo.GetWithdrawEvents(ctx, withdrawId)
+}
@@ -0,0 +1,165 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strconv"
+	"time"
+)
+
+// 提现风控规则的 config key，金额为代币数量，按代币区分的 key 后缀为 _usdt、_dhb；未配置或为 0 时不限制
+const (
+	configWithdrawMin             = "withdraw_min_"               // 单笔最小提现数量
+	configWithdrawUserDailyAmount = "withdraw_user_daily_amount_" // 每个用户每日提现数量上限
+	configWithdrawDailyAmount     = "withdraw_daily_amount_"      // 全网每日提现数量上限
+	configWithdrawUserDailyCount  = "withdraw_user_daily_count"   // 每个用户每日提现次数上限，不区分代币
+	configWithdrawDepositCooldown = "withdraw_deposit_cooldown"   // 充值后多少小时内不能提现
+	configWithdrawAccountHold     = "withdraw_account_hold"       // 注册不满多少小时不能提现
+)
+
+var (
+	ErrWithdrawAmountTooSmall  = errors.New(500, "WITHDRAW_AMOUNT_TOO_SMALL", "低于最小提现数量")
+	ErrWithdrawUserDailyCount  = errors.New(500, "WITHDRAW_USER_DAILY_COUNT", "超过每日提现次数")
+	ErrWithdrawUserDailyAmount = errors.New(500, "WITHDRAW_USER_DAILY_AMOUNT", "超过每日提现数量")
+	ErrWithdrawDailyAmount     = errors.New(500, "WITHDRAW_DAILY_AMOUNT", "今日全网提现额度已用完")
+	ErrWithdrawDepositCooldown = errors.New(500, "WITHDRAW_DEPOSIT_COOLDOWN", "充值后需等待一段时间才能提现")
+	ErrWithdrawAccountHold     = errors.New(500, "WITHDRAW_ACCOUNT_HOLD", "新注册账户需等待一段时间才能提现")
+)
+
+// WithdrawRules 提现风控规则，金额为系统精度，为 0 时不限制
+type WithdrawRules struct {
+	Min             int64
+	UserDailyAmount int64
+	DailyAmount     int64
+	UserDailyCount  int64
+	DepositCooldown time.Duration
+	AccountHold     time.Duration
+}
+
+// GetWithdrawRules 从 config 表读取代币的提现风控规则
+func (uuc *UserUseCase) GetWithdrawRules(ctx context.Context, coinType string) (*WithdrawRules, error) {
+	configs, err := uuc.configRepo.GetConfigByKeys(ctx,
+		configWithdrawMin+coinType,
+		configWithdrawUserDailyAmount+coinType,
+		configWithdrawDailyAmount+coinType,
+		configWithdrawUserDailyCount,
+		configWithdrawDepositCooldown,
+		configWithdrawAccountHold,
+	)
+	if nil != err {
+		return nil, err
+	}
+
+	rules := &WithdrawRules{}
+	for _, v := range configs {
+		switch v.KeyName {
+		case configWithdrawMin + coinType:
+			rules.Min = withdrawConfigAmount(v.Value)
+		case configWithdrawUserDailyAmount + coinType:
+			rules.UserDailyAmount = withdrawConfigAmount(v.Value)
+		case configWithdrawDailyAmount + coinType:
+			rules.DailyAmount = withdrawConfigAmount(v.Value)
+		case configWithdrawUserDailyCount:
+			rules.UserDailyCount, _ = strconv.ParseInt(v.Value, 10, 64)
+		case configWithdrawDepositCooldown:
+			hours, _ := strconv.ParseInt(v.Value, 10, 64)
+			rules.DepositCooldown = time.Duration(hours) * time.Hour
+		case configWithdrawAccountHold:
+			hours, _ := strconv.ParseInt(v.Value, 10, 64)
+			rules.AccountHold = time.Duration(hours) * time.Hour
+		}
+	}
+
+	return rules, nil
+}
+
+// checkWithdrawRules 按风控规则检查提现，amount 为系统精度；已拒绝和已取消的提现不计入每日次数和数量。
+// 需在创建提现的事务中调用，先锁定用户余额行，有全网上限时再锁定该配置行，同一用户和全网的提现依次检查
+func (uuc *UserUseCase) checkWithdrawRules(ctx context.Context, user *User, coinType string, amount int64) error {
+	rules, err := uuc.GetWithdrawRules(ctx, coinType)
+	if nil != err {
+		return err
+	}
+
+	// 先加锁再读取，事务中的查询才能看到上一个持有锁的提现
+	if err = uuc.ubRepo.LockUserBalance(ctx, user.ID); nil != err {
+		return err
+	}
+	if 0 < rules.DailyAmount {
+		if err = uuc.configRepo.LockConfig(ctx, configWithdrawDailyAmount+coinType); nil != err {
+			return err
+		}
+	}
+
+	if 0 < rules.Min && amount < rules.Min {
+		return ErrWithdrawAmountTooSmall
+	}
+
+	now := time.Now()
+	if 0 < rules.AccountHold {
+		u, err := uuc.repo.GetUserById(ctx, user.ID)
+		if nil != err {
+			return err
+		}
+		if now.Sub(u.CreatedAt) < rules.AccountHold {
+			return ErrWithdrawAccountHold
+		}
+	}
+
+	if 0 < rules.DepositCooldown {
+		lastDeposit, err := uuc.ruc.GetLastDepositAt(ctx, user.ID)
+		if nil != err {
+			return err
+		}
+		if !lastDeposit.IsZero() && now.Sub(lastDeposit) < rules.DepositCooldown {
+			return ErrWithdrawDepositCooldown
+		}
+	}
+
+	dayStart := withdrawDayStart(now)
+	if 0 < rules.UserDailyCount {
+		count, _, err := uuc.ubRepo.GetWithdrawTotal(ctx, user.ID, "", dayStart)
+		if nil != err {
+			return err
+		}
+		if count >= rules.UserDailyCount {
+			return ErrWithdrawUserDailyCount
+		}
+	}
+	if 0 < rules.UserDailyAmount {
+		_, total, err := uuc.ubRepo.GetWithdrawTotal(ctx, user.ID, coinType, dayStart)
+		if nil != err {
+			return err
+		}
+		if total+amount > rules.UserDailyAmount {
+			return ErrWithdrawUserDailyAmount
+		}
+	}
+	if 0 < rules.DailyAmount {
+		_, total, err := uuc.ubRepo.GetWithdrawTotal(ctx, 0, coinType, dayStart)
+		if nil != err {
+			return err
+		}
+		if total+amount > rules.DailyAmount {
+			return ErrWithdrawDailyAmount
+		}
+	}
+
+	return nil
+}
+
+// withdrawConfigAmount 代币数量转为系统精度，格式错误时为 0 即不限制
+func withdrawConfigAmount(value string) int64 {
+	amountFloat, err := strconv.ParseFloat(value, 64)
+	if nil != err || 0 >= amountFloat {
+		return 0
+	}
+	amount, _ := strconv.ParseInt(strconv.FormatFloat(amountFloat*10000000000, 'f', 0, 64), 10, 64)
+	return amount
+}
+
+// withdrawDayStart 按北京时间零点划分每日
+func withdrawDayStart(now time.Time) time.Time {
+	t := now.In(time.FixedZone("CST", 8*3600))
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,247 @@
+package biz_test
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"testing"
+)
+
+type withdrawTest struct {
+	ub     *fakeBalanceRepo
+	events *fakeEventRepo
+	wuc    *biz.WithdrawUseCase
+}
+
+func newWithdrawTest(withdraws ...*biz.Withdraw) *withdrawTest {
+	t := &withdrawTest{ub: newFakeBalanceRepo(), events: &fakeEventRepo{}}
+	for _, v := range withdraws {
+		tmp := *v
+		t.ub.withdraws[v.ID] = &tmp
+	}
+	t.wuc = biz.NewWithdrawUseCase(t.ub, t.events, nil, fakeTx{}, log.DefaultLogger)
+	return t
+}
+
+func TestCanTransit(t *testing.T) {
+	allowed := [][2]string{
+		{biz.WithdrawStatusNew, biz.WithdrawStatusRewarded},
+		{biz.WithdrawStatusNew, biz.WithdrawStatusCancelled},
+		{biz.WithdrawStatusRewarded, biz.WithdrawStatusDoing},
+		{biz.WithdrawStatusPass, biz.WithdrawStatusDoing},
+		{biz.WithdrawStatusDoing, biz.WithdrawStatusBroadcast},
+		{biz.WithdrawStatusBroadcast, biz.WithdrawStatusConfirmed},
+		{biz.WithdrawStatusBroadcast, biz.WithdrawStatusFailed},
+		{biz.WithdrawStatusFailed, biz.WithdrawStatusPass},
+		{biz.WithdrawStatusFailed, biz.WithdrawStatusRejected},
+		{biz.WithdrawStatusFailed, biz.WithdrawStatusConfirmed}, // 标记失败后保存的交易仍上链成功
+	}
+	for _, v := range allowed {
+		if !biz.CanTransit(v[0], v[1]) {
+			t.Errorf("%q -> %q not allowed", v[0], v[1])
+		}
+	}
+
+	denied := [][2]string{
+		{biz.WithdrawStatusNew, biz.WithdrawStatusDoing},          // 未分红不能打款
+		{biz.WithdrawStatusRewarded, biz.WithdrawStatusCancelled}, // 分红后不能取消
+		{biz.WithdrawStatusDoing, biz.WithdrawStatusRejected},     // 打款中不能退回余额
+		{biz.WithdrawStatusBroadcast, biz.WithdrawStatusRejected},
+		{biz.WithdrawStatusBroadcast, biz.WithdrawStatusDoing},
+		{biz.WithdrawStatusConfirmed, biz.WithdrawStatusFailed},
+		{biz.WithdrawStatusRejected, biz.WithdrawStatusPass},
+		{biz.WithdrawStatusCancelled, biz.WithdrawStatusNew},
+		{biz.WithdrawStatusSuccess, biz.WithdrawStatusDoing},
+	}
+	for _, v := range denied {
+		if biz.CanTransit(v[0], v[1]) {
+			t.Errorf("%q -> %q allowed", v[0], v[1])
+		}
+	}
+}
+
+func TestTransitRecordsEvents(t *testing.T) {
+	var (
+		ctx = context.Background()
+		w   = &biz.Withdraw{ID: 1, UserId: 1, Amount: 100, Type: "usdt"}
+		wt  = newWithdrawTest(w)
+	)
+
+	stale := *w
+	if err := wt.wuc.TransitAmount(ctx, w, biz.WithdrawStatusRewarded, 90, biz.WithdrawActorSystem, "提现分红"); nil != err {
+		t.Fatalf("transit: %v", err)
+	}
+	if biz.WithdrawStatusRewarded != w.Status || 90 != w.Amount {
+		t.Errorf("withdraw = %q %d, want rewarded 90", w.Status, w.Amount)
+	}
+
+	// 状态机不允许的变化不修改记录
+	if err := wt.wuc.Transit(ctx, w, biz.WithdrawStatusBroadcast, biz.WithdrawActorSystem, ""); !errors.Is(err, biz.ErrWithdrawTransition) {
+		t.Errorf("rewarded -> broadcast: err = %v, want transition error", err)
+	}
+	// 按旧状态修改时记录已被其他任务修改
+	if err := wt.wuc.Transit(ctx, &stale, biz.WithdrawStatusCancelled, "user:1", ""); !errors.Is(err, biz.ErrWithdrawStatusChanged) {
+		t.Errorf("stale transit: err = %v, want status changed", err)
+	}
+	if biz.WithdrawStatusRewarded != wt.ub.withdraws[1].Status {
+		t.Errorf("stored status = %q, want rewarded", wt.ub.withdraws[1].Status)
+	}
+
+	events, _ := wt.wuc.GetWithdrawEvents(ctx, 1)
+	if 1 != len(events) {
+		t.Fatalf("events = %d, want 1", len(events))
+	}
+	if biz.WithdrawStatusNew != events[0].FromStatus || biz.WithdrawStatusRewarded != events[0].ToStatus || biz.WithdrawActorSystem != events[0].Actor {
+		t.Errorf("event = %+v", events[0])
+	}
+}
+
+func TestPayoutTransitions(t *testing.T) {
+	var (
+		ctx = context.Background()
+		w   = &biz.Withdraw{ID: 1, UserId: 1, Amount: 100, Type: "usdt", Status: biz.WithdrawStatusRewarded}
+		wt  = newWithdrawTest(w)
+	)
+
+	tx := &biz.PayoutTx{Nonce: 3, Hash: "0xaa", Raw: "0xf8"}
+	if err := wt.wuc.SavePayoutTx(ctx, w, tx); nil != err {
+		t.Fatalf("save payout tx: %v", err)
+	}
+	stored := wt.ub.withdraws[1]
+	if biz.WithdrawStatusDoing != stored.Status || "0xaa" != stored.TxHash || 3 != stored.Nonce || "0xf8" != stored.RawTx {
+		t.Fatalf("stored = %q %s %d %s, want doing with saved tx", stored.Status, stored.TxHash, stored.Nonce, stored.RawTx)
+	}
+
+	// 节点拒绝后重新签名，打款中只覆盖交易，不再记录状态变化
+	if err := wt.wuc.SavePayoutTx(ctx, w, &biz.PayoutTx{Nonce: 3, Hash: "0xab", Raw: "0xf9"}); nil != err {
+		t.Fatalf("save payout tx again: %v", err)
+	}
+	if biz.WithdrawStatusDoing != stored.Status || "0xab" != stored.TxHash {
+		t.Errorf("stored = %q %s, want doing 0xab", stored.Status, stored.TxHash)
+	}
+
+	if err := wt.wuc.Broadcast(ctx, w); nil != err {
+		t.Fatalf("broadcast: %v", err)
+	}
+	if biz.WithdrawStatusBroadcast != stored.Status {
+		t.Errorf("stored status = %q, want broadcast", stored.Status)
+	}
+
+	// 已广播的提现不能拒绝，也不能由管理员标记失败
+	if err := wt.wuc.Reject(ctx, 1, "admin:1", ""); !errors.Is(err, biz.ErrWithdrawTransition) {
+		t.Errorf("reject broadcast: err = %v, want transition error", err)
+	}
+	if err := wt.wuc.Fail(ctx, 1, "admin:1", ""); !errors.Is(err, biz.ErrWithdrawTransition) {
+		t.Errorf("fail broadcast: err = %v, want transition error", err)
+	}
+	if 0 != len(wt.ub.returned) {
+		t.Errorf("returned = %v, want none", wt.ub.returned)
+	}
+
+	events, _ := wt.wuc.GetWithdrawEvents(ctx, 1)
+	if 2 != len(events) || biz.WithdrawStatusDoing != events[0].ToStatus || biz.WithdrawStatusBroadcast != events[1].ToStatus {
+		t.Errorf("events = %d, want doing and broadcast", len(events))
+	}
+}
+
+func TestRejectAndCancelReturnBalance(t *testing.T) {
+	var (
+		ctx = context.Background()
+		wt  = newWithdrawTest(
+			&biz.Withdraw{ID: 1, UserId: 1, Amount: 100, Type: "usdt"},
+			&biz.Withdraw{ID: 2, UserId: 2, Amount: 50, Type: "usdt"},
+			&biz.Withdraw{ID: 3, UserId: 2, Amount: 80, Type: "usdt", Status: biz.WithdrawStatusFailed},
+		)
+	)
+
+	if err := wt.wuc.Cancel(ctx, 1, 2, "user:2"); nil == err {
+		t.Errorf("cancel other user's withdraw succeeded")
+	}
+	if err := wt.wuc.Cancel(ctx, 1, 1, "user:1"); nil != err {
+		t.Fatalf("cancel: %v", err)
+	}
+	if err := wt.wuc.Cancel(ctx, 1, 1, "user:1"); !errors.Is(err, biz.ErrWithdrawTransition) {
+		t.Errorf("cancel twice: err = %v, want transition error", err)
+	}
+	if err := wt.wuc.Reject(ctx, 2, "admin:1", "风控"); nil != err {
+		t.Fatalf("reject: %v", err)
+	}
+	if err := wt.wuc.Reject(ctx, 3, "admin:1", "链上未到账"); nil != err {
+		t.Fatalf("reject failed: %v", err)
+	}
+
+	if biz.WithdrawStatusCancelled != wt.ub.withdraws[1].Status || biz.WithdrawStatusRejected != wt.ub.withdraws[2].Status {
+		t.Errorf("status = %q %q, want cancelled rejected", wt.ub.withdraws[1].Status, wt.ub.withdraws[2].Status)
+	}
+	if 100 != wt.ub.returned[1] || 130 != wt.ub.returned[2] {
+		t.Errorf("returned = %v, want user 1: 100, user 2: 130", wt.ub.returned)
+	}
+}
+
+func TestFailedPayoutKeepsSavedTx(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		payer = &fakePayer{receipts: map[string]*biz.PayoutReceipt{}, minedNonce: 3}
+		wt    = newWithdrawTest(
+			&biz.Withdraw{ID: 1, UserId: 1, Amount: 100, Type: "usdt", Status: biz.WithdrawStatusFailed, ChainId: 56, TxHash: "0xaa", Nonce: 3, RawTx: "0xf8"},
+			&biz.Withdraw{ID: 2, UserId: 2, Amount: 50, Type: "usdt", Status: biz.WithdrawStatusFailed, ChainId: 56, TxHash: "0xbb", Nonce: 4, RawTx: "0xf9"},
+		)
+	)
+	wt.wuc = biz.NewWithdrawUseCase(wt.ub, wt.events, biz.Chains{{Conf: &conf.Chain{ChainId: 56, Confirmations: 3}, Payer: payer}}, fakeTx{}, log.DefaultLogger)
+
+	// 交易未上链且 nonce 未被使用，不能退回余额，也不能重新打款
+	if err := wt.wuc.Reject(ctx, 1, "admin:1", ""); !errors.Is(err, biz.ErrWithdrawTxPending) {
+		t.Errorf("reject pending: err = %v, want tx pending", err)
+	}
+	if err := wt.wuc.Retry(ctx, 1, "admin:1", ""); !errors.Is(err, biz.ErrWithdrawTxPending) {
+		t.Errorf("retry pending: err = %v, want tx pending", err)
+	}
+
+	// 交易上链成功后不能退回余额，由回执任务改为已到账
+	payer.receipts["0xaa"] = &biz.PayoutReceipt{Hash: "0xaa", Status: 1, Confirmations: 3}
+	if err := wt.wuc.Reject(ctx, 1, "admin:1", ""); !errors.Is(err, biz.ErrWithdrawTxMined) {
+		t.Errorf("reject mined: err = %v, want tx mined", err)
+	}
+	if count, err := wt.wuc.WatchReceipts(ctx); nil != err || 1 != count {
+		t.Errorf("watch receipts = %d %v, want 1", count, err)
+	}
+	if biz.WithdrawStatusConfirmed != wt.ub.withdraws[1].Status {
+		t.Errorf("status = %q, want confirmed", wt.ub.withdraws[1].Status)
+	}
+
+	// nonce 已被其他交易使用，保存的交易不会再上链
+	payer.minedNonce = 5
+	if err := wt.wuc.Reject(ctx, 2, "admin:1", "链上未到账"); nil != err {
+		t.Fatalf("reject dropped: %v", err)
+	}
+	if 0 != wt.ub.returned[1] || 50 != wt.ub.returned[2] {
+		t.Errorf("returned = %v, want user 2: 50", wt.ub.returned)
+	}
+}
+
+func TestRefundWithdraw(t *testing.T) {
+	var (
+		ctx = context.Background()
+		wt  = newWithdrawTest()
+	)
+
+	if _, err := wt.wuc.Refund(ctx, &biz.Withdraw{UserId: 1, Amount: 100, Type: "usdt"}, "admin:1", ""); nil == err {
+		t.Errorf("refund without address succeeded")
+	}
+
+	refund, err := wt.wuc.Refund(ctx, &biz.Withdraw{UserId: 1, Amount: 100, Type: "usdt", Address: testUserAddress}, "admin:1", "unmatched deposit 1 refund")
+	if nil != err {
+		t.Fatalf("refund: %v", err)
+	}
+	stored := wt.ub.withdraws[refund.ID]
+	if biz.WithdrawStatusPass != stored.Status || biz.WithdrawKindRefund != stored.Kind || testUserAddress != stored.Address {
+		t.Errorf("stored = %q %q %s, want pass refund to user", stored.Status, stored.Kind, stored.Address)
+	}
+
+	events, _ := wt.wuc.GetWithdrawEvents(ctx, refund.ID)
+	if 1 != len(events) || biz.WithdrawStatusNew != events[0].FromStatus || biz.WithdrawStatusPass != events[0].ToStatus || "admin:1" != events[0].Actor {
+		t.Errorf("events = %+v, want new -> pass by admin:1", events)
+	}
+}
@@ -0,0 +1,244 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 比较并删除随机数，同时记录已使用，保证随机数只能使用一次
+var useAuthNonceScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	redis.call("DEL", KEYS[1])
+	redis.call("SET", KEYS[2], "1", "EX", ARGV[2])
+	return 1
+end
+return 0
+`)
+
+// 轮换刷新令牌：只有 session 当前的刷新令牌可以轮换，否则视为重放并作废整个 session
+var rotateRefreshTokenScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) ~= ARGV[1] then
+	redis.call("DEL", KEYS[1])
+	return 0
+end
+redis.call("SET", KEYS[2], ARGV[3], "EX", ARGV[4])
+redis.call("SET", KEYS[1], ARGV[2], "EX", ARGV[4])
+return 1
+`)
+
+type AuthRecord struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	UserId    int64     `gorm:"type:int;not null"`
+	Address   string    `gorm:"type:varchar(100);not null"`
+	Message   string    `gorm:"type:varchar(2000);not null"`
+	TokenHash string    `gorm:"type:varchar(100);not null"`
+	ExpiresAt time.Time `gorm:"type:datetime;not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type AuthRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAuthRepo(data *Data, logger log.Logger) biz.AuthRepo {
+	return &AuthRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func authNonceKey(address string) string {
+	return "auth:nonce:" + strings.ToLower(address)
+}
+
+func authNonceUsedKey(address string, nonce string) string {
+	return "auth:nonce_used:" + strings.ToLower(address) + ":" + nonce
+}
+
+func refreshTokenKey(tokenHash string) string {
+	return "auth:refresh:" + tokenHash
+}
+
+func sessionKey(sessionId string) string {
+	return "auth:session:" + sessionId
+}
+
+func revokedTokenKey(jti string) string {
+	return "auth:revoked:" + jti
+}
+
+func refreshTokenValue(rt *biz.RefreshToken) string {
+	return strconv.FormatInt(rt.UserId, 10) + ":" + rt.SessionId
+}
+
+func machineSignatureKey(keyId string, signature string) string {
+	return "auth:machine_sig:" + keyId + ":" + signature
+}
+
+// CreateAuthNonce .
+func (a *AuthRepo) CreateAuthNonce(ctx context.Context, address string, nonce string, expire time.Duration) error {
+	if err := a.data.rdb.Set(ctx, authNonceKey(address), nonce, expire).Err(); nil != err {
+		return errors.New(500, "CREATE_AUTH_NONCE_ERROR", "登录随机数创建失败")
+	}
+
+	return nil
+}
+
+// GetAuthNonce .
+func (a *AuthRepo) GetAuthNonce(ctx context.Context, address string) (string, error) {
+	nonce, err := a.data.rdb.Get(ctx, authNonceKey(address)).Result()
+	if nil != err {
+		if redis.Nil == err {
+			return "", nil
+		}
+
+		return "", errors.New(500, "AUTH NONCE ERROR", err.Error())
+	}
+
+	return nonce, nil
+}
+
+// UseAuthNonce .
+func (a *AuthRepo) UseAuthNonce(ctx context.Context, address string, nonce string, usedExpire time.Duration) (bool, error) {
+	res, err := useAuthNonceScript.Run(ctx, a.data.rdb,
+		[]string{authNonceKey(address), authNonceUsedKey(address, nonce)},
+		nonce, int64(usedExpire/time.Second)).Int()
+	if nil != err {
+		return false, errors.New(500, "AUTH NONCE ERROR", err.Error())
+	}
+
+	return 1 == res, nil
+}
+
+// IsAuthNonceUsed .
+func (a *AuthRepo) IsAuthNonceUsed(ctx context.Context, address string, nonce string) (bool, error) {
+	count, err := a.data.rdb.Exists(ctx, authNonceUsedKey(address, nonce)).Result()
+	if nil != err {
+		return false, errors.New(500, "AUTH NONCE ERROR", err.Error())
+	}
+
+	return 0 < count, nil
+}
+
+// CreateAuthRecord .
+func (a *AuthRepo) CreateAuthRecord(ctx context.Context, r *biz.AuthRecord) (*biz.AuthRecord, error) {
+	var authRecord AuthRecord
+	authRecord.UserId = r.UserId
+	authRecord.Address = r.Address
+	authRecord.Message = r.Message
+	authRecord.TokenHash = r.TokenHash
+	authRecord.ExpiresAt = r.ExpiresAt
+
+	res := a.data.DB(ctx).Table("auth_record").Create(&authRecord)
+	if res.Error != nil {
+		return nil, errors.New(500, "CREATE_AUTH_RECORD_ERROR", "登录记录创建失败")
+	}
+
+	return &biz.AuthRecord{
+		ID:        authRecord.ID,
+		UserId:    authRecord.UserId,
+		Address:   authRecord.Address,
+		Message:   authRecord.Message,
+		TokenHash: authRecord.TokenHash,
+		ExpiresAt: authRecord.ExpiresAt,
+		CreatedAt: authRecord.CreatedAt,
+	}, nil
+}
+
+// CreateRefreshToken .
+func (a *AuthRepo) CreateRefreshToken(ctx context.Context, tokenHash string, rt *biz.RefreshToken, expire time.Duration) error {
+	_, err := a.data.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, refreshTokenKey(tokenHash), refreshTokenValue(rt), expire)
+		pipe.Set(ctx, sessionKey(rt.SessionId), tokenHash, expire)
+		return nil
+	})
+	if nil != err {
+		return errors.New(500, "CREATE_REFRESH_TOKEN_ERROR", "刷新令牌创建失败")
+	}
+
+	return nil
+}
+
+// GetRefreshToken .
+func (a *AuthRepo) GetRefreshToken(ctx context.Context, tokenHash string) (*biz.RefreshToken, error) {
+	value, err := a.data.rdb.Get(ctx, refreshTokenKey(tokenHash)).Result()
+	if nil != err {
+		if redis.Nil == err {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "REFRESH TOKEN ERROR", err.Error())
+	}
+
+	parts := strings.SplitN(value, ":", 2)
+	if 2 != len(parts) {
+		return nil, nil
+	}
+	userId, err := strconv.ParseInt(parts[0], 10, 64)
+	if nil != err {
+		return nil, nil
+	}
+
+	return &biz.RefreshToken{
+		UserId:    userId,
+		SessionId: parts[1],
+	}, nil
+}
+
+// RotateRefreshToken .
+func (a *AuthRepo) RotateRefreshToken(ctx context.Context, oldTokenHash string, newTokenHash string, rt *biz.RefreshToken, expire time.Duration) (bool, error) {
+	res, err := rotateRefreshTokenScript.Run(ctx, a.data.rdb,
+		[]string{sessionKey(rt.SessionId), refreshTokenKey(newTokenHash)},
+		oldTokenHash, newTokenHash, refreshTokenValue(rt), int64(expire/time.Second)).Int()
+	if nil != err {
+		return false, errors.New(500, "REFRESH TOKEN ERROR", err.Error())
+	}
+
+	return 1 == res, nil
+}
+
+// RevokeSession .
+func (a *AuthRepo) RevokeSession(ctx context.Context, sessionId string) error {
+	if err := a.data.rdb.Del(ctx, sessionKey(sessionId)).Err(); nil != err {
+		return errors.New(500, "REVOKE_SESSION_ERROR", "注销登录失败")
+	}
+
+	return nil
+}
+
+// RevokeToken .
+func (a *AuthRepo) RevokeToken(ctx context.Context, jti string, expire time.Duration) error {
+	if err := a.data.rdb.Set(ctx, revokedTokenKey(jti), "1", expire).Err(); nil != err {
+		return errors.New(500, "REVOKE_TOKEN_ERROR", "注销登录失败")
+	}
+
+	return nil
+}
+
+// IsTokenRevoked .
+func (a *AuthRepo) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	count, err := a.data.rdb.Exists(ctx, revokedTokenKey(jti)).Result()
+	if nil != err {
+		return false, errors.New(500, "REVOKED TOKEN ERROR", err.Error())
+	}
+
+	return 0 < count, nil
+}
+
+// UseMachineSignature .
+func (a *AuthRepo) UseMachineSignature(ctx context.Context, keyId string, signature string, expire time.Duration) (bool, error) {
+	ok, err := a.data.rdb.SetNX(ctx, machineSignatureKey(keyId, signature), "1", expire).Result()
+	if nil != err {
+		return false, errors.New(500, "MACHINE SIGNATURE ERROR", err.Error())
+	}
+
+	return ok, nil
+}
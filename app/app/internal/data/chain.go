@@ -0,0 +1,58 @@
+package data
+
+import (
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"strings"
+)
+
+// NewChains 为配置的每条链创建充值数据来源、专属充值地址钱包和打款签名账户，chain ID 不能重复
+func NewChains(cs []*conf.Chain, logger log.Logger) (biz.Chains, error) {
+	if 0 >= len(cs) {
+		return nil, errors.New(500, "CHAIN_CONFIG_ERROR", "未配置 chains")
+	}
+
+	res := make(biz.Chains, 0, len(cs))
+	exist := make(map[int64]bool, len(cs))
+	for _, c := range cs {
+		source, err := NewDepositSource(c, logger)
+		if nil != err {
+			return nil, err
+		}
+		wallet, err := NewDepositWallet(c, logger)
+		if nil != err {
+			return nil, err
+		}
+
+		signer, err := NewSigner(c.GetPayoutSigner(), logger)
+		if nil != err {
+			return nil, err
+		}
+		gasSigner, err := NewSigner(c.GetGasSigner(), logger)
+		if nil != err {
+			return nil, err
+		}
+
+		chain := &biz.Chain{
+			Conf:      c,
+			Source:    source,
+			Wallet:    wallet,
+			Signer:    signer,
+			GasSigner: gasSigner,
+			Payer:     NewPayer(c, signer, logger),
+			GasPayer:  NewPayer(c, gasSigner, logger),
+		}
+		if nil != signer && "" != c.GetPayoutAddress() && !strings.EqualFold(c.GetPayoutAddress(), signer.Address()) {
+			return nil, errors.New(500, "CHAIN_CONFIG_ERROR", "payout_signer 账户与 payout_address 不一致: "+chain.Name())
+		}
+		if exist[chain.ChainId()] {
+			return nil, errors.New(500, "CHAIN_CONFIG_ERROR", "chains 中 chain_id 重复: "+chain.Name())
+		}
+		exist[chain.ChainId()] = true
+		res = append(res, chain)
+	}
+
+	return res, nil
+}
@@ -17,7 +17,7 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewDB, NewRedis, NewTransaction, NewUserRepo, NewUserInfoRepo, NewUserBalanceRepo, NewConfigRepo, NewUserRecommendRepo, NewEthUserRecordRepo, NewLocationRepo, NewUserCurrentMonthRecommendRepo)
+var ProviderSet = wire.NewSet(NewData, NewDB, NewRedis, NewTransaction, NewUserRepo, NewUserInfoRepo, NewUserBalanceRepo, NewConfigRepo, NewUserRecommendRepo, NewEthUserRecordRepo, NewLocationRepo, NewUserCurrentMonthRecommendRepo, NewAuthRepo, NewAdminRepo, NewJobRepo, NewChains, NewDepositCursorRepo, NewDepositPendingRepo, NewDepositTierRepo, NewDepositAddressRepo, NewWithdrawEventRepo)
 
 type Data struct {
 	db  *gorm.DB
@@ -31,6 +31,9 @@ type contextTxKey struct{}
 func NewData(c *conf.Data, logger log.Logger, db *gorm.DB, rdb *redis.Client) (*Data, func(), error) {
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
+		if err := rdb.Close(); err != nil {
+			log.NewHelper(logger).Error(err)
+		}
 	}
 	return &Data{
 		db:  db,
@@ -43,9 +46,9 @@ func NewTransaction(d *Data) biz.Transaction {
 	return d
 }
 
-// ExecTx gorm Transaction
+// ExecTx gorm Transaction，已在事务中时使用 savepoint 嵌套
 func (d *Data) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
-	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return d.DB(ctx).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		ctx = context.WithValue(ctx, contextTxKey{}, tx)
 		return fn(ctx)
 	})
@@ -97,9 +100,6 @@ func NewRedis(c *conf.Data) *redis.Client {
 		ReadTimeout:  c.Redis.ReadTimeout.AsDuration(),
 	})
 
-	if err := rdb.Close(); err != nil {
-		log.Error(err)
-	}
 	return rdb
 }
 
@@ -0,0 +1,884 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"encoding/json"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DepositSourceBscScan        = "bscscan"
+	DepositSourceBscScanTokenTx = "bscscan_tokentx"
+	DepositSourceRpc            = "rpc"
+	DepositSourceFake           = "fake"
+
+	bscScanUrlDefault      = "https://api.bscscan.com/api"
+	bscScanPageSizeDefault = 200
+	bscScanMaxPagesDefault = 10
+)
+
+// ERC20 Transfer(address,address,uint256) 事件
+var transferTopic = common.BytesToHash(crypto.Keccak256([]byte("Transfer(address,address,uint256)")))
+
+type DepositCursor struct {
+	ID          int64     `gorm:"primarykey;type:int"`
+	ChainId     int64     `gorm:"type:bigint;not null;default:0;uniqueIndex:idx_deposit_cursor"`
+	BlockNumber uint64    `gorm:"type:bigint;not null"`
+	LogIndex    int64     `gorm:"type:bigint;not null"`
+	CreatedAt   time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt   time.Time `gorm:"type:datetime;not null"`
+}
+
+type DepositPending struct {
+	ID            int64     `gorm:"primarykey;type:int"`
+	ChainId       int64     `gorm:"type:bigint;not null;default:0;uniqueIndex:idx_deposit_pending"`
+	UserId        int64     `gorm:"type:int;not null"`
+	Hash          string    `gorm:"type:varchar(100);not null;uniqueIndex:idx_deposit_pending"`
+	Contract      string    `gorm:"type:varchar(100);not null;uniqueIndex:idx_deposit_pending"`
+	LogIndex      int64     `gorm:"type:int;not null;default:0;uniqueIndex:idx_deposit_pending"`
+	CoinType      string    `gorm:"type:varchar(45);not null;default:''"`
+	Amount        string    `gorm:"type:varchar(45);not null"`
+	BlockNumber   uint64    `gorm:"type:bigint;not null"`
+	Confirmations int64     `gorm:"type:bigint;not null"`
+	Status        string    `gorm:"type:varchar(45);not null"`
+	CreatedAt     time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt     time.Time `gorm:"type:datetime;not null"`
+}
+
+type DepositCursorRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewDepositCursorRepo(data *Data, logger log.Logger) biz.DepositCursorRepo {
+	return &DepositCursorRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetDepositCursor .
+func (d *DepositCursorRepo) GetDepositCursor(ctx context.Context, chainId int64) (*biz.DepositCursor, error) {
+	var cursor DepositCursor
+	if err := d.data.DB(ctx).Table("deposit_cursor").Where("chain_id=?", chainId).First(&cursor).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "DEPOSIT CURSOR ERROR", err.Error())
+	}
+
+	return &biz.DepositCursor{
+		ID:          cursor.ID,
+		ChainId:     cursor.ChainId,
+		BlockNumber: cursor.BlockNumber,
+		LogIndex:    cursor.LogIndex,
+		UpdatedAt:   cursor.UpdatedAt,
+	}, nil
+}
+
+// SaveDepositCursor .
+func (d *DepositCursorRepo) SaveDepositCursor(ctx context.Context, c *biz.DepositCursor) error {
+	if 0 < c.ID {
+		res := d.data.DB(ctx).Table("deposit_cursor").Where("id=?", c.ID).
+			Updates(map[string]interface{}{"block_number": c.BlockNumber, "log_index": c.LogIndex, "updated_at": time.Now()})
+		if res.Error != nil {
+			return errors.New(500, "UPDATE_DEPOSIT_CURSOR_ERROR", "扫描游标修改失败")
+		}
+		return nil
+	}
+
+	cursor := DepositCursor{
+		ChainId:     c.ChainId,
+		BlockNumber: c.BlockNumber,
+		LogIndex:    c.LogIndex,
+	}
+	res := d.data.DB(ctx).Table("deposit_cursor").Create(&cursor)
+	if res.Error != nil {
+		return errors.New(500, "CREATE_DEPOSIT_CURSOR_ERROR", "扫描游标创建失败")
+	}
+	c.ID = cursor.ID
+
+	return nil
+}
+
+type DepositPendingRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewDepositPendingRepo(data *Data, logger log.Logger) biz.DepositPendingRepo {
+	return &DepositPendingRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// SaveDepositPending 按 (chain_id, hash, contract, log_index) 新增或更新，重新出现在链上的转账恢复为 pending
+func (d *DepositPendingRepo) SaveDepositPending(ctx context.Context, p *biz.DepositPending) error {
+	var pending DepositPending
+	err := d.data.DB(ctx).Table("deposit_pending").
+		Where("chain_id=? and hash=? and contract=? and log_index=?", p.ChainId, p.Hash, strings.ToLower(p.Contract), p.LogIndex).First(&pending).Error
+	if nil == err {
+		if biz.DepositPendingStatusConfirmed == pending.Status {
+			return nil
+		}
+		res := d.data.DB(ctx).Table("deposit_pending").Where("id=?", pending.ID).
+			Updates(map[string]interface{}{
+				"block_number":  p.BlockNumber,
+				"confirmations": p.Confirmations,
+				"status":        p.Status,
+				"updated_at":    time.Now(),
+			})
+		if res.Error != nil {
+			return errors.New(500, "UPDATE_DEPOSIT_PENDING_ERROR", "待确认充值修改失败")
+		}
+		return nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return errors.New(500, "DEPOSIT PENDING ERROR", err.Error())
+	}
+
+	pending = DepositPending{
+		ChainId:       p.ChainId,
+		UserId:        p.UserId,
+		Hash:          p.Hash,
+		Contract:      strings.ToLower(p.Contract),
+		LogIndex:      p.LogIndex,
+		CoinType:      p.CoinType,
+		Amount:        p.Amount,
+		BlockNumber:   p.BlockNumber,
+		Confirmations: p.Confirmations,
+		Status:        p.Status,
+	}
+	res := d.data.DB(ctx).Table("deposit_pending").Create(&pending)
+	if res.Error != nil {
+		return errors.New(500, "CREATE_DEPOSIT_PENDING_ERROR", "待确认充值创建失败")
+	}
+
+	return nil
+}
+
+// UpdateDepositPendingStatus .
+func (d *DepositPendingRepo) UpdateDepositPendingStatus(ctx context.Context, chainId int64, status string, hash ...string) error {
+	if 0 >= len(hash) {
+		return nil
+	}
+
+	res := d.data.DB(ctx).Table("deposit_pending").
+		Where("chain_id=? and hash IN (?)", chainId, hash).
+		Updates(map[string]interface{}{"status": status, "updated_at": time.Now()})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_DEPOSIT_PENDING_ERROR", "待确认充值修改失败")
+	}
+
+	return nil
+}
+
+// GetDepositPendingByBlock 区块已确认但仍是 pending 的转账，按区块升序
+func (d *DepositPendingRepo) GetDepositPendingByBlock(ctx context.Context, chainId int64, contract string, toBlock uint64) ([]*biz.DepositPending, error) {
+	var pending []*DepositPending
+	if err := d.data.DB(ctx).Table("deposit_pending").
+		Where("chain_id=? and contract=? and status=? and block_number<=?", chainId, strings.ToLower(contract), biz.DepositPendingStatusPending, toBlock).
+		Order("block_number asc, id asc").Find(&pending).Error; err != nil {
+		return nil, errors.New(500, "DEPOSIT PENDING ERROR", err.Error())
+	}
+
+	res := make([]*biz.DepositPending, 0, len(pending))
+	for _, item := range pending {
+		res = append(res, toBizDepositPending(item))
+	}
+
+	return res, nil
+}
+
+// DropDepositPending 已核对不在链上的 pending 转账标记为 dropped
+func (d *DepositPendingRepo) DropDepositPending(ctx context.Context, id ...int64) (int64, error) {
+	if 0 >= len(id) {
+		return 0, nil
+	}
+
+	res := d.data.DB(ctx).Table("deposit_pending").
+		Where("id IN (?) and status=?", id, biz.DepositPendingStatusPending).
+		Updates(map[string]interface{}{"status": biz.DepositPendingStatusDropped, "updated_at": time.Now()})
+	if res.Error != nil {
+		return 0, errors.New(500, "UPDATE_DEPOSIT_PENDING_ERROR", "待确认充值修改失败")
+	}
+
+	return res.RowsAffected, nil
+}
+
+// GetDepositPendingByUserId 用户尚未确认的充值
+func (d *DepositPendingRepo) GetDepositPendingByUserId(ctx context.Context, userId int64) ([]*biz.DepositPending, error) {
+	var pending []*DepositPending
+	if err := d.data.DB(ctx).Table("deposit_pending").
+		Where("user_id=? and status=?", userId, biz.DepositPendingStatusPending).
+		Order("id desc").Find(&pending).Error; err != nil {
+		return nil, errors.New(500, "DEPOSIT PENDING ERROR", err.Error())
+	}
+
+	res := make([]*biz.DepositPending, 0, len(pending))
+	for _, item := range pending {
+		res = append(res, toBizDepositPending(item))
+	}
+
+	return res, nil
+}
+
+func toBizDepositPending(item *DepositPending) *biz.DepositPending {
+	return &biz.DepositPending{
+		ID:            item.ID,
+		ChainId:       item.ChainId,
+		UserId:        item.UserId,
+		Hash:          item.Hash,
+		Contract:      item.Contract,
+		LogIndex:      item.LogIndex,
+		CoinType:      item.CoinType,
+		Amount:        item.Amount,
+		BlockNumber:   item.BlockNumber,
+		Confirmations: item.Confirmations,
+		Status:        item.Status,
+		CreatedAt:     item.CreatedAt,
+	}
+}
+
+// NewDepositSource 按 conf.Chain.source 选择充值数据来源，默认 bscscan。
+// 配置了 chain.hd_wallet.xpub 时 deposit_address 可以为空
+func NewDepositSource(c *conf.Chain, logger log.Logger) (biz.DepositSource, error) {
+	if !common.IsHexAddress(c.GetDepositAddress()) && ("" != c.GetDepositAddress() || "" == c.GetHdWallet().GetXpub()) {
+		return nil, errors.New(500, "CHAIN_CONFIG_ERROR", "chain.deposit_address 配置错误")
+	}
+
+	switch c.GetSource() {
+	case "", DepositSourceBscScan:
+		return NewBscScanDepositSource(c, logger), nil
+	case DepositSourceBscScanTokenTx:
+		return NewBscScanTokenTxDepositSource(c, logger), nil
+	case DepositSourceRpc:
+		if "" == c.GetRpcUrl() {
+			return nil, errors.New(500, "CHAIN_CONFIG_ERROR", "chain.rpc_url 配置错误")
+		}
+		return NewRpcDepositSource(c, logger), nil
+	case DepositSourceFake:
+		return NewFakeDepositSource(), nil
+	default:
+		return nil, errors.New(500, "CHAIN_CONFIG_ERROR", "未知的 chain.source: "+c.GetSource())
+	}
+}
+
+// BscScanDepositSource BscScan getLogs 接口查询 ERC20 Transfer 事件，按区块升序分页，
+// getLogs 直接返回 logIndex，不需要像 tokentx 那样逐笔查询回执；
+// getLogs 每次只能按一个接收地址过滤，多个收款地址逐个查询
+type BscScanDepositSource struct {
+	apiUrl   string
+	apiKey   string
+	pageSize int64
+	maxPages int64
+	client   *http.Client
+	log      *log.Helper
+}
+
+func NewBscScanDepositSource(c *conf.Chain, logger log.Logger) *BscScanDepositSource {
+	s := &BscScanDepositSource{
+		apiUrl:   c.BscscanUrl,
+		apiKey:   c.BscscanApiKey,
+		pageSize: c.PageSize,
+		maxPages: c.MaxPages,
+		client:   &http.Client{Timeout: 10 * time.Second},
+		log:      log.NewHelper(logger),
+	}
+	if "" == s.apiUrl {
+		s.apiUrl = bscScanUrlDefault
+	}
+	if 0 >= s.pageSize {
+		s.pageSize = bscScanPageSizeDefault
+	}
+	if 0 >= s.maxPages {
+		s.maxPages = bscScanMaxPagesDefault
+	}
+
+	return s
+}
+
+// LatestBlock .
+func (b *BscScanDepositSource) LatestBlock(ctx context.Context) (uint64, error) {
+	data := url.Values{}
+	data.Set("module", "proxy")
+	data.Set("action", "eth_blockNumber")
+
+	body, err := b.request(ctx, data)
+	if nil != err {
+		return 0, err
+	}
+
+	var i struct {
+		Result string `json:"result"`
+	}
+	if err = json.Unmarshal(body, &i); nil != err {
+		return 0, errors.New(500, "BSCSCAN_ERROR", err.Error())
+	}
+	latest, err := hexutil.DecodeUint64(i.Result)
+	if nil != err {
+		return 0, errors.New(500, "BSCSCAN_ERROR", "eth_blockNumber: "+i.Result)
+	}
+
+	return latest, nil
+}
+
+// ListTransfers 每个收款地址在区间内按区块升序分页查询，超过 maxPages 页返回 biz.ErrDepositRangeTooLarge
+func (b *BscScanDepositSource) ListTransfers(ctx context.Context, contract string, addresses []string, fromBlock uint64, toBlock uint64) ([]*biz.DepositTransfer, error) {
+	res := make([]*biz.DepositTransfer, 0)
+	for _, address := range addresses {
+		for page := int64(1); ; page++ {
+			if page > b.maxPages {
+				return nil, biz.ErrDepositRangeTooLarge
+			}
+
+			transfers, count, err := b.requestPage(ctx, contract, address, fromBlock, toBlock, page)
+			if nil != err {
+				return nil, err
+			}
+			res = append(res, transfers...)
+
+			if count < b.pageSize { // 最后一页
+				break
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (b *BscScanDepositSource) request(ctx context.Context, data url.Values) ([]byte, error) {
+	if "" != b.apiKey {
+		data.Set("apikey", b.apiKey)
+	}
+
+	u, err := url.ParseRequestURI(b.apiUrl)
+	if err != nil {
+		return nil, err
+	}
+	u.RawQuery = data.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return nil, errors.New(500, "BSCSCAN_ERROR", err.Error())
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.New(500, "BSCSCAN_ERROR", err.Error())
+	}
+
+	return body, nil
+}
+
+func (b *BscScanDepositSource) requestPage(ctx context.Context, contract string, address string, fromBlock uint64, toBlock uint64, page int64) ([]*biz.DepositTransfer, int64, error) {
+	data := url.Values{}
+	data.Set("module", "logs")
+	data.Set("action", "getLogs")
+	data.Set("address", contract)
+	data.Set("fromBlock", strconv.FormatUint(fromBlock, 10))
+	data.Set("toBlock", strconv.FormatUint(toBlock, 10))
+	data.Set("topic0", transferTopic.Hex())
+	data.Set("topic0_2_opr", "and")
+	data.Set("topic2", common.BytesToHash(common.HexToAddress(address).Bytes()).Hex())
+	data.Set("offset", strconv.FormatInt(b.pageSize, 10))
+	data.Set("page", strconv.FormatInt(page, 10))
+
+	body, err := b.request(ctx, data)
+	if nil != err {
+		return nil, 0, err
+	}
+
+	var i struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+		Result  json.RawMessage
+	}
+	if err = json.Unmarshal(body, &i); err != nil {
+		return nil, 0, errors.New(500, "BSCSCAN_ERROR", err.Error())
+	}
+
+	var result []*bscScanLog
+	if "1" != i.Status || nil != json.Unmarshal(i.Result, &result) {
+		if "No records found" == i.Message || "No transactions found" == i.Message {
+			return nil, 0, nil
+		}
+		return nil, 0, errors.New(500, "BSCSCAN_ERROR", i.Message+" "+string(i.Result))
+	}
+
+	res := make([]*biz.DepositTransfer, 0, len(result))
+	for _, v := range result {
+		transfer := v.transfer()
+		if nil == transfer || !strings.EqualFold(address, transfer.To) { // 接收者
+			continue
+		}
+		res = append(res, transfer)
+	}
+
+	return res, int64(len(result)), nil
+}
+
+// TransactionTransfers 通过 proxy eth_getTransactionReceipt 查询交易回执
+func (b *BscScanDepositSource) TransactionTransfers(ctx context.Context, hash string) ([]*biz.DepositTransfer, error) {
+	data := url.Values{}
+	data.Set("module", "proxy")
+	data.Set("action", "eth_getTransactionReceipt")
+	data.Set("txhash", hash)
+
+	body, err := b.request(ctx, data)
+	if nil != err {
+		return nil, err
+	}
+
+	var i struct {
+		Result *struct {
+			Status string        `json:"status"`
+			Logs   []*bscScanLog `json:"logs"`
+		} `json:"result"`
+		Error *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err = json.Unmarshal(body, &i); nil != err {
+		return nil, errors.New(500, "BSCSCAN_ERROR", err.Error())
+	}
+	if nil != i.Error {
+		return nil, errors.New(500, "BSCSCAN_ERROR", i.Error.Message)
+	}
+	if nil == i.Result {
+		return nil, biz.ErrDepositTxNotFound
+	}
+	if 1 != hexUint64(i.Result.Status) {
+		return nil, biz.ErrDepositTxFailed
+	}
+
+	res := make([]*biz.DepositTransfer, 0, len(i.Result.Logs))
+	for _, v := range i.Result.Logs {
+		if transfer := v.transfer(); nil != transfer {
+			res = append(res, transfer)
+		}
+	}
+
+	return res, nil
+}
+
+// bscScanLog getLogs 和交易回执中的日志，数字字段均为十六进制
+type bscScanLog struct {
+	Address     string   `json:"address"`
+	Topics      []string `json:"topics"`
+	Data        string   `json:"data"`
+	BlockNumber string   `json:"blockNumber"`
+	TxHash      string   `json:"transactionHash"`
+	TxIndex     string   `json:"transactionIndex"`
+	LogIndex    string   `json:"logIndex"`
+}
+
+// transfer 不是 ERC20 Transfer 事件时返回 nil
+func (l *bscScanLog) transfer() *biz.DepositTransfer {
+	if 3 != len(l.Topics) || !strings.EqualFold(transferTopic.Hex(), l.Topics[0]) {
+		return nil
+	}
+	value, err := hexutil.DecodeBig(hexTrimZero(l.Data))
+	if nil != err {
+		return nil
+	}
+
+	return &biz.DepositTransfer{
+		Hash:        strings.ToLower(l.TxHash),
+		From:        strings.ToLower(common.HexToAddress(l.Topics[1]).Hex()), // 与 rpc 一致使用小写地址
+		To:          strings.ToLower(common.HexToAddress(l.Topics[2]).Hex()),
+		Value:       value.String(),
+		Contract:    strings.ToLower(l.Address),
+		BlockNumber: hexUint64(l.BlockNumber),
+		TxIndex:     int64(hexUint64(l.TxIndex)),
+		LogIndex:    int64(hexUint64(l.LogIndex)),
+	}
+}
+
+// hexUint64 bscscan 返回的 "0x" 前缀十六进制，"0x" 表示 0
+func hexUint64(s string) uint64 {
+	v, _ := strconv.ParseUint(strings.TrimPrefix(s, "0x"), 16, 64)
+	return v
+}
+
+// hexTrimZero 去掉前导 0，hexutil.DecodeBig 不接受前导 0
+func hexTrimZero(s string) string {
+	s = strings.TrimLeft(strings.TrimPrefix(s, "0x"), "0")
+	if "" == s {
+		s = "0"
+	}
+	return "0x" + s
+}
+
+// BscScanTokenTxDepositSource BscScan account tokentx 接口，按区块升序分页。
+// tokentx 不返回 logIndex，同一交易的转账再通过交易回执补全 logIndex；
+// 区块高度、回执查询与 getLogs 方式共用 BscScanDepositSource
+type BscScanTokenTxDepositSource struct {
+	*BscScanDepositSource
+}
+
+func NewBscScanTokenTxDepositSource(c *conf.Chain, logger log.Logger) *BscScanTokenTxDepositSource {
+	return &BscScanTokenTxDepositSource{BscScanDepositSource: NewBscScanDepositSource(c, logger)}
+}
+
+// ListTransfers 每个收款地址在区间内按区块升序分页查询，超过 maxPages 页返回 biz.ErrDepositRangeTooLarge
+func (b *BscScanTokenTxDepositSource) ListTransfers(ctx context.Context, contract string, addresses []string, fromBlock uint64, toBlock uint64) ([]*biz.DepositTransfer, error) {
+	res := make([]*biz.DepositTransfer, 0)
+	for _, address := range addresses {
+		for page := int64(1); ; page++ {
+			if page > b.maxPages {
+				return nil, biz.ErrDepositRangeTooLarge
+			}
+
+			transfers, count, err := b.requestTokenTxPage(ctx, contract, address, fromBlock, toBlock, page)
+			if nil != err {
+				return nil, err
+			}
+			res = append(res, transfers...)
+
+			if count < b.pageSize { // 最后一页
+				break
+			}
+		}
+	}
+
+	if err := b.fillLogIndex(ctx, res); nil != err {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (b *BscScanTokenTxDepositSource) requestTokenTxPage(ctx context.Context, contract string, address string, fromBlock uint64, toBlock uint64, page int64) ([]*biz.DepositTransfer, int64, error) {
+	data := url.Values{}
+	data.Set("module", "account")
+	data.Set("action", "tokentx")
+	data.Set("contractaddress", contract)
+	data.Set("address", address)
+	data.Set("startblock", strconv.FormatUint(fromBlock, 10))
+	data.Set("endblock", strconv.FormatUint(toBlock, 10))
+	data.Set("sort", "asc")
+	data.Set("offset", strconv.FormatInt(b.pageSize, 10))
+	data.Set("page", strconv.FormatInt(page, 10))
+
+	body, err := b.request(ctx, data)
+	if nil != err {
+		return nil, 0, err
+	}
+
+	var i struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+		Result  json.RawMessage
+	}
+	if err = json.Unmarshal(body, &i); err != nil {
+		return nil, 0, errors.New(500, "BSCSCAN_ERROR", err.Error())
+	}
+
+	var result []*struct {
+		BlockNumber  string `json:"blockNumber"`
+		Hash         string `json:"hash"`
+		From         string `json:"from"`
+		To           string `json:"to"`
+		Value        string `json:"value"`
+		TokenSymbol  string `json:"tokenSymbol"`
+		ContractAddr string `json:"contractAddress"`
+		TxIndex      string `json:"transactionIndex"`
+	}
+	if "1" != i.Status || nil != json.Unmarshal(i.Result, &result) {
+		if "No records found" == i.Message || "No transactions found" == i.Message {
+			return nil, 0, nil
+		}
+		return nil, 0, errors.New(500, "BSCSCAN_ERROR", i.Message+" "+string(i.Result))
+	}
+
+	res := make([]*biz.DepositTransfer, 0, len(result))
+	for _, v := range result {
+		if !strings.EqualFold(address, v.To) { // 接收者
+			continue
+		}
+		blockNumber, _ := strconv.ParseUint(v.BlockNumber, 10, 64)
+		txIndex, _ := strconv.ParseInt(v.TxIndex, 10, 64)
+		res = append(res, &biz.DepositTransfer{
+			Hash:        strings.ToLower(v.Hash),
+			From:        strings.ToLower(v.From),
+			To:          strings.ToLower(v.To),
+			Value:       v.Value,
+			TokenSymbol: v.TokenSymbol,
+			Contract:    strings.ToLower(v.ContractAddr),
+			BlockNumber: blockNumber,
+			TxIndex:     txIndex,
+			LogIndex:    -1,
+		})
+	}
+
+	return res, int64(len(result)), nil
+}
+
+// fillLogIndex 按交易回执中的日志顺序补全 logIndex，同一交易中相同收款地址和金额的转账按出现顺序依次对应
+func (b *BscScanTokenTxDepositSource) fillLogIndex(ctx context.Context, transfers []*biz.DepositTransfer) error {
+	logs := make(map[string][]*biz.DepositTransfer)
+	for _, v := range transfers {
+		if _, ok := logs[v.Hash]; ok {
+			continue
+		}
+		receipt, err := b.TransactionTransfers(ctx, v.Hash)
+		if nil != err {
+			return err
+		}
+		logs[v.Hash] = receipt
+	}
+
+	for _, v := range transfers {
+		for k, l := range logs[v.Hash] {
+			if nil != l && strings.EqualFold(v.Contract, l.Contract) && strings.EqualFold(v.To, l.To) && v.Value == l.Value {
+				v.LogIndex = l.LogIndex
+				logs[v.Hash][k] = nil // 已对应
+				break
+			}
+		}
+		if 0 > v.LogIndex {
+			return errors.New(500, "BSCSCAN_ERROR", "tokentx 转账在交易回执中不存在: "+v.Hash)
+		}
+	}
+
+	return nil
+}
+
+// RpcDepositSource 直接通过节点 eth_getLogs 查询 ERC20 Transfer 事件
+type RpcDepositSource struct {
+	rpcUrl string
+	log    *log.Helper
+}
+
+func NewRpcDepositSource(c *conf.Chain, logger log.Logger) *RpcDepositSource {
+	return &RpcDepositSource{
+		rpcUrl: c.RpcUrl,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// LatestBlock .
+func (r *RpcDepositSource) LatestBlock(ctx context.Context) (uint64, error) {
+	rpcClient, err := rpc.DialContext(ctx, r.rpcUrl)
+	if nil != err {
+		return 0, errors.New(500, "RPC_ERROR", err.Error())
+	}
+	defer rpcClient.Close()
+
+	var latest hexutil.Uint64
+	if err = rpcClient.CallContext(ctx, &latest, "eth_blockNumber"); nil != err {
+		return 0, errors.New(500, "RPC_ERROR", err.Error())
+	}
+
+	return uint64(latest), nil
+}
+
+// ListTransfers 接收地址作为 topic2 的可选值一次查询
+func (r *RpcDepositSource) ListTransfers(ctx context.Context, contract string, addresses []string, fromBlock uint64, toBlock uint64) ([]*biz.DepositTransfer, error) {
+	if 0 >= len(addresses) {
+		return []*biz.DepositTransfer{}, nil
+	}
+	to := make([]common.Hash, 0, len(addresses))
+	for _, v := range addresses {
+		to = append(to, common.BytesToHash(common.HexToAddress(v).Bytes()))
+	}
+
+	rpcClient, err := rpc.DialContext(ctx, r.rpcUrl)
+	if nil != err {
+		return nil, errors.New(500, "RPC_ERROR", err.Error())
+	}
+	defer rpcClient.Close()
+
+	logs, err := ethclient.NewClient(rpcClient).FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		ToBlock:   new(big.Int).SetUint64(toBlock),
+		Addresses: []common.Address{common.HexToAddress(contract)},
+		Topics:    [][]common.Hash{{transferTopic}, nil, to},
+	})
+	if nil != err {
+		// 节点对区间和返回条数有限制，如 "block range too large"、"query returned more than 10000 results"
+		if msg := strings.ToLower(err.Error()); strings.Contains(msg, "range") || strings.Contains(msg, "more than") {
+			return nil, biz.ErrDepositRangeTooLarge
+		}
+		return nil, errors.New(500, "RPC_ERROR", err.Error())
+	}
+
+	res := make([]*biz.DepositTransfer, 0, len(logs))
+	for _, v := range logs {
+		if transfer := rpcTransfer(v); nil != transfer {
+			res = append(res, transfer)
+		}
+	}
+
+	return res, nil
+}
+
+// TransactionTransfers .
+func (r *RpcDepositSource) TransactionTransfers(ctx context.Context, hash string) ([]*biz.DepositTransfer, error) {
+	client, err := ethclient.DialContext(ctx, r.rpcUrl)
+	if nil != err {
+		return nil, errors.New(500, "RPC_ERROR", err.Error())
+	}
+	defer client.Close()
+
+	receipt, err := client.TransactionReceipt(ctx, common.HexToHash(hash))
+	if nil != err {
+		if ethereum.NotFound == err {
+			return nil, biz.ErrDepositTxNotFound
+		}
+		return nil, errors.New(500, "RPC_ERROR", err.Error())
+	}
+	if types.ReceiptStatusSuccessful != receipt.Status {
+		return nil, biz.ErrDepositTxFailed
+	}
+
+	res := make([]*biz.DepositTransfer, 0, len(receipt.Logs))
+	for _, v := range receipt.Logs {
+		if transfer := rpcTransfer(*v); nil != transfer {
+			res = append(res, transfer)
+		}
+	}
+
+	return res, nil
+}
+
+// rpcTransfer 不是 ERC20 Transfer 事件时返回 nil
+func rpcTransfer(v types.Log) *biz.DepositTransfer {
+	if 3 != len(v.Topics) || transferTopic != v.Topics[0] || v.Removed {
+		return nil
+	}
+
+	return &biz.DepositTransfer{
+		Hash:        strings.ToLower(v.TxHash.Hex()),
+		From:        strings.ToLower(common.BytesToAddress(v.Topics[1].Bytes()).Hex()), // 与 bscscan 一致使用小写地址
+		To:          strings.ToLower(common.BytesToAddress(v.Topics[2].Bytes()).Hex()),
+		Value:       new(big.Int).SetBytes(v.Data).String(),
+		Contract:    strings.ToLower(v.Address.Hex()),
+		BlockNumber: v.BlockNumber,
+		TxIndex:     int64(v.TxIndex),
+		LogIndex:    int64(v.Index),
+	}
+}
+
+// FakeDepositSource 内存实现，用于测试和本地调试
+type FakeDepositSource struct {
+	mu          sync.Mutex
+	latestBlock uint64
+	transfers   []*biz.DepositTransfer
+}
+
+func NewFakeDepositSource() *FakeDepositSource {
+	return &FakeDepositSource{}
+}
+
+// AddTransfer 添加模拟的链上转账
+func (f *FakeDepositSource) AddTransfer(transfers ...*biz.DepositTransfer) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.transfers = append(f.transfers, transfers...)
+	for _, v := range transfers {
+		if v.BlockNumber > f.latestBlock {
+			f.latestBlock = v.BlockNumber
+		}
+	}
+}
+
+// RemoveTransfer 模拟区块回滚，删除交易的所有转账
+func (f *FakeDepositSource) RemoveTransfer(hash string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	transfers := make([]*biz.DepositTransfer, 0, len(f.transfers))
+	for _, v := range f.transfers {
+		if !strings.EqualFold(hash, v.Hash) {
+			transfers = append(transfers, v)
+		}
+	}
+	f.transfers = transfers
+}
+
+// SetLatestBlock 模拟出块
+func (f *FakeDepositSource) SetLatestBlock(latestBlock uint64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.latestBlock = latestBlock
+}
+
+// LatestBlock .
+func (f *FakeDepositSource) LatestBlock(ctx context.Context) (uint64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.latestBlock, nil
+}
+
+// ListTransfers 未设置 To 的模拟转账视为转入任一收款地址
+func (f *FakeDepositSource) ListTransfers(ctx context.Context, contract string, addresses []string, fromBlock uint64, toBlock uint64) ([]*biz.DepositTransfer, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	watch := make(map[string]bool, len(addresses))
+	for _, v := range addresses {
+		watch[strings.ToLower(v)] = true
+	}
+
+	res := make([]*biz.DepositTransfer, 0, len(f.transfers))
+	for _, v := range f.transfers {
+		if v.BlockNumber < fromBlock || v.BlockNumber > toBlock {
+			continue
+		}
+		if "" != v.To && !watch[strings.ToLower(v.To)] {
+			continue
+		}
+		if "" == v.Contract || strings.EqualFold(contract, v.Contract) {
+			tmp := *v
+			res = append(res, &tmp)
+		}
+	}
+
+	return res, nil
+}
+
+// TransactionTransfers .
+func (f *FakeDepositSource) TransactionTransfers(ctx context.Context, hash string) ([]*biz.DepositTransfer, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	res := make([]*biz.DepositTransfer, 0)
+	for _, v := range f.transfers {
+		if strings.EqualFold(hash, v.Hash) {
+			tmp := *v
+			res = append(res, &tmp)
+		}
+	}
+	if 0 >= len(res) {
+		return nil, biz.ErrDepositTxNotFound
+	}
+
+	return res, nil
+}
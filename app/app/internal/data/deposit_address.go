@@ -0,0 +1,89 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"strings"
+	"time"
+)
+
+type DepositAddress struct {
+	ID           int64     `gorm:"primarykey;type:int"`
+	ChainId      int64     `gorm:"type:bigint;not null;default:0;uniqueIndex:idx_deposit_address_user;uniqueIndex:idx_deposit_address_index;uniqueIndex:idx_deposit_address"`
+	UserId       int64     `gorm:"type:int;not null;uniqueIndex:idx_deposit_address_user"`
+	AddressIndex uint32    `gorm:"type:int unsigned;not null;uniqueIndex:idx_deposit_address_index"`
+	Address      string    `gorm:"type:varchar(100);not null;uniqueIndex:idx_deposit_address"`
+	CreatedAt    time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt    time.Time `gorm:"type:datetime;not null"`
+}
+
+type DepositAddressRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewDepositAddressRepo(data *Data, logger log.Logger) biz.DepositAddressRepo {
+	return &DepositAddressRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetDepositAddressByUserId 用户还没有充值地址时返回 nil
+func (d *DepositAddressRepo) GetDepositAddressByUserId(ctx context.Context, chainId int64, userId int64) (*biz.DepositAddress, error) {
+	var depositAddress DepositAddress
+	if err := d.data.DB(ctx).Table("deposit_address").Where("chain_id=? and user_id=?", chainId, userId).First(&depositAddress).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "DEPOSIT ADDRESS ERROR", err.Error())
+	}
+
+	return toBizDepositAddress(&depositAddress), nil
+}
+
+// GetDepositAddresses 链上的所有专属充值地址
+func (d *DepositAddressRepo) GetDepositAddresses(ctx context.Context, chainId int64) ([]*biz.DepositAddress, error) {
+	var depositAddresses []*DepositAddress
+	if err := d.data.DB(ctx).Table("deposit_address").Where("chain_id=?", chainId).Order("id asc").Find(&depositAddresses).Error; err != nil {
+		return nil, errors.New(500, "DEPOSIT ADDRESS ERROR", err.Error())
+	}
+
+	res := make([]*biz.DepositAddress, 0, len(depositAddresses))
+	for _, item := range depositAddresses {
+		res = append(res, toBizDepositAddress(item))
+	}
+
+	return res, nil
+}
+
+// CreateDepositAddress .
+func (d *DepositAddressRepo) CreateDepositAddress(ctx context.Context, a *biz.DepositAddress) (*biz.DepositAddress, error) {
+	depositAddress := DepositAddress{
+		ChainId:      a.ChainId,
+		UserId:       a.UserId,
+		AddressIndex: a.AddressIndex,
+		Address:      strings.ToLower(a.Address),
+	}
+	res := d.data.DB(ctx).Table("deposit_address").Create(&depositAddress)
+	if res.Error != nil {
+		return nil, errors.New(500, "CREATE_DEPOSIT_ADDRESS_ERROR", "充值地址创建失败")
+	}
+
+	return toBizDepositAddress(&depositAddress), nil
+}
+
+func toBizDepositAddress(item *DepositAddress) *biz.DepositAddress {
+	return &biz.DepositAddress{
+		ID:           item.ID,
+		ChainId:      item.ChainId,
+		UserId:       item.UserId,
+		AddressIndex: item.AddressIndex,
+		Address:      item.Address,
+		CreatedAt:    item.CreatedAt,
+	}
+}
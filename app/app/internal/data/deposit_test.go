@@ -0,0 +1,76 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/conf"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const (
+	testTokenTxHash     = "0x00000000000000000000000000000000000000000000000000000000000000aa"
+	testTokenTxContract = "0x00000000000000000000000000000000000000c0"
+	testTokenTxTo       = "0x00000000000000000000000000000000000000d0"
+)
+
+// bscScanStandIn 代替 BscScan，tokentx 返回同一交易中的两笔转账，回执中另有一笔转给其他地址
+func bscScanStandIn(t *testing.T) *httptest.Server {
+	topic := func(address string) string {
+		return "0x000000000000000000000000" + address[2:]
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		var res interface{}
+		switch q.Get("action") {
+		case "tokentx":
+			if "100" != q.Get("startblock") || "110" != q.Get("endblock") || "asc" != q.Get("sort") || testTokenTxTo != q.Get("address") {
+				t.Errorf("tokentx query = %s", r.URL.RawQuery)
+			}
+			item := map[string]string{
+				"blockNumber": "105", "hash": testTokenTxHash, "from": "0x00000000000000000000000000000000000000a1", "to": testTokenTxTo,
+				"value": "5", "tokenSymbol": "USDT", "contractAddress": testTokenTxContract, "transactionIndex": "2",
+			}
+			res = map[string]interface{}{"status": "1", "message": "OK", "result": []map[string]string{item, item}}
+		case "eth_getTransactionReceipt":
+			l := func(to string, logIndex string) map[string]interface{} {
+				return map[string]interface{}{
+					"address": testTokenTxContract, "topics": []string{transferTopic.Hex(), topic("0x00000000000000000000000000000000000000a1"), topic(to)},
+					"data": "0x05", "blockNumber": "0x69", "transactionHash": testTokenTxHash, "transactionIndex": "0x2", "logIndex": logIndex,
+				}
+			}
+			res = map[string]interface{}{"result": map[string]interface{}{"status": "0x1", "logs": []interface{}{
+				l("0x00000000000000000000000000000000000000e1", "0x6"), l(testTokenTxTo, "0x7"), l(testTokenTxTo, "0x9"),
+			}}}
+		default:
+			t.Errorf("unexpected action %s", q.Get("action"))
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestBscScanTokenTxLogIndex(t *testing.T) {
+	ts := bscScanStandIn(t)
+	s, err := NewDepositSource(&conf.Chain{Source: DepositSourceBscScanTokenTx, BscscanUrl: ts.URL, DepositAddress: testTokenTxTo}, log.DefaultLogger)
+	if nil != err {
+		t.Fatalf("new source: %v", err)
+	}
+
+	transfers, err := s.ListTransfers(context.Background(), testTokenTxContract, []string{testTokenTxTo}, 100, 110)
+	if nil != err {
+		t.Fatalf("list transfers: %v", err)
+	}
+	if 2 != len(transfers) {
+		t.Fatalf("transfers = %d, want 2", len(transfers))
+	}
+	for k, want := range []int64{7, 9} {
+		v := transfers[k]
+		if want != v.LogIndex || 105 != v.BlockNumber || 2 != v.TxIndex || "5" != v.Value {
+			t.Errorf("transfer %d = %+v, want log index %d", k, v, want)
+		}
+	}
+}
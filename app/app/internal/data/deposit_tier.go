@@ -0,0 +1,112 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+type DepositTier struct {
+	ID         int64     `gorm:"primarykey;type:int"`
+	Token      string    `gorm:"type:varchar(45);not null;uniqueIndex:idx_deposit_tier"`
+	Amount     string    `gorm:"type:varchar(100);not null;uniqueIndex:idx_deposit_tier"`
+	Level      int64     `gorm:"type:int;not null"`
+	Multiplier int64     `gorm:"type:int;not null"`
+	Enabled    int64     `gorm:"type:int;not null"` // 1 启用，0 停用
+	PairToken  string    `gorm:"type:varchar(45);not null;default:''"`
+	PairAmount string    `gorm:"type:varchar(100);not null;default:''"`
+	CreatedAt  time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time `gorm:"type:datetime;not null"`
+}
+
+type DepositTierRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewDepositTierRepo(data *Data, logger log.Logger) biz.DepositTierRepo {
+	return &DepositTierRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetDepositTiers .
+func (d *DepositTierRepo) GetDepositTiers(ctx context.Context) ([]*biz.DepositTier, error) {
+	var tiers []*DepositTier
+	if err := d.data.DB(ctx).Table("deposit_tier").Order("token asc, level asc, id asc").Find(&tiers).Error; err != nil {
+		return nil, errors.New(500, "DEPOSIT TIER ERROR", err.Error())
+	}
+
+	res := make([]*biz.DepositTier, 0, len(tiers))
+	for _, item := range tiers {
+		res = append(res, toBizDepositTier(item))
+	}
+
+	return res, nil
+}
+
+// CreateDepositTier .
+func (d *DepositTierRepo) CreateDepositTier(ctx context.Context, t *biz.DepositTier) (*biz.DepositTier, error) {
+	tier := DepositTier{
+		Token:      t.Token,
+		Amount:     t.Amount,
+		Level:      t.Level,
+		Multiplier: t.Multiplier,
+		Enabled:    depositTierEnabled(t.Enabled),
+		PairToken:  t.PairToken,
+		PairAmount: t.PairAmount,
+	}
+	res := d.data.DB(ctx).Table("deposit_tier").Create(&tier)
+	if res.Error != nil {
+		return nil, errors.New(500, "CREATE_DEPOSIT_TIER_ERROR", "充值档位创建失败")
+	}
+
+	return toBizDepositTier(&tier), nil
+}
+
+// UpdateDepositTier .
+func (d *DepositTierRepo) UpdateDepositTier(ctx context.Context, t *biz.DepositTier) (*biz.DepositTier, error) {
+	res := d.data.DB(ctx).Table("deposit_tier").Where("id=?", t.ID).
+		Updates(map[string]interface{}{
+			"token":       t.Token,
+			"amount":      t.Amount,
+			"level":       t.Level,
+			"multiplier":  t.Multiplier,
+			"enabled":     depositTierEnabled(t.Enabled),
+			"pair_token":  t.PairToken,
+			"pair_amount": t.PairAmount,
+			"updated_at":  time.Now(),
+		})
+	if res.Error != nil {
+		return nil, errors.New(500, "UPDATE_DEPOSIT_TIER_ERROR", "充值档位修改失败")
+	}
+	if 0 == res.RowsAffected {
+		return nil, errors.NotFound("DEPOSIT_TIER_NOT_FOUND", "充值档位不存在")
+	}
+
+	return t, nil
+}
+
+func depositTierEnabled(enabled bool) int64 {
+	if enabled {
+		return 1
+	}
+	return 0
+}
+
+func toBizDepositTier(item *DepositTier) *biz.DepositTier {
+	return &biz.DepositTier{
+		ID:         item.ID,
+		Token:      item.Token,
+		Amount:     item.Amount,
+		Level:      item.Level,
+		Multiplier: item.Multiplier,
+		Enabled:    1 == item.Enabled,
+		PairToken:  item.PairToken,
+		PairAmount: item.PairAmount,
+		CreatedAt:  item.CreatedAt,
+	}
+}
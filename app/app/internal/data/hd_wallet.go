@@ -0,0 +1,394 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"encoding/binary"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"io/ioutil"
+	"math/big"
+	"strings"
+)
+
+var (
+	hdVersionXpub = []byte{0x04, 0x88, 0xb2, 0x1e}
+	hdVersionXprv = []byte{0x04, 0x88, 0xad, 0xe4}
+
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+	// ERC20 transfer(address,uint256) 和 balanceOf(address)
+	erc20TransferMethod  = crypto.Keccak256([]byte("transfer(address,uint256)"))[:4]
+	erc20BalanceOfMethod = crypto.Keccak256([]byte("balanceOf(address)"))[:4]
+)
+
+// hdKey BIP-32 扩展密钥，key 为 33 字节压缩公钥或 32 字节私钥
+type hdKey struct {
+	chainCode []byte
+	key       []byte
+	private   bool
+}
+
+// HdDepositWallet 按 BIP-32 从 chain.hd_wallet.xpub 派生用户充值地址，
+// 归集时才从 xprv_file 读取扩展私钥派生对应私钥，私钥不入库也不常驻内存
+type HdDepositWallet struct {
+	xpub     *hdKey
+	xprvFile string
+	treasury string
+	gasKey   string
+	gasValue string
+	rpcUrl   string
+	log      *log.Helper
+}
+
+func NewDepositWallet(c *conf.Chain, logger log.Logger) (biz.DepositWallet, error) {
+	w := &HdDepositWallet{
+		xprvFile: c.GetHdWallet().GetXprvFile(),
+		treasury: c.GetHdWallet().GetTreasury(),
+		gasKey:   c.GetHdWallet().GetGasKeyFile(),
+		gasValue: c.GetHdWallet().GetGasAmount(),
+		rpcUrl:   c.GetRpcUrl(),
+		log:      log.NewHelper(logger),
+	}
+	if "" == w.treasury { // 未单独配置时归集到链的 treasury
+		w.treasury = c.GetTreasury()
+	}
+	if "" == c.GetHdWallet().GetXpub() {
+		return w, nil
+	}
+
+	xpub, err := parseHdKey(c.GetHdWallet().GetXpub())
+	if nil != err {
+		return nil, errors.New(500, "CHAIN_CONFIG_ERROR", "chain.hd_wallet.xpub 配置错误: "+err.Error())
+	}
+	if xpub.private {
+		return nil, errors.New(500, "CHAIN_CONFIG_ERROR", "chain.hd_wallet.xpub 不能配置扩展私钥")
+	}
+	w.xpub = xpub
+
+	return w, nil
+}
+
+// Enabled .
+func (w *HdDepositWallet) Enabled() bool {
+	return nil != w.xpub
+}
+
+// DeriveAddress .
+func (w *HdDepositWallet) DeriveAddress(index uint32) (string, error) {
+	if nil == w.xpub {
+		return "", errors.New(500, "HD_WALLET_ERROR", "未配置 chain.hd_wallet.xpub")
+	}
+
+	child, err := w.xpub.child(index)
+	if nil != err {
+		return "", errors.New(500, "HD_WALLET_ERROR", err.Error())
+	}
+	address, err := child.address()
+	if nil != err {
+		return "", errors.New(500, "HD_WALLET_ERROR", err.Error())
+	}
+
+	return strings.ToLower(address.Hex()), nil
+}
+
+// TokenBalance .
+func (w *HdDepositWallet) TokenBalance(ctx context.Context, contract string, address string) (*big.Int, error) {
+	client, err := ethclient.DialContext(ctx, w.rpcUrl)
+	if nil != err {
+		return nil, errors.New(500, "RPC_ERROR", err.Error())
+	}
+	defer client.Close()
+
+	token := common.HexToAddress(contract)
+	data := append(append([]byte{}, erc20BalanceOfMethod...), common.LeftPadBytes(common.HexToAddress(address).Bytes(), 32)...)
+	res, err := client.CallContract(ctx, ethereum.CallMsg{To: &token, Data: data}, nil)
+	if nil != err {
+		return nil, errors.New(500, "RPC_ERROR", err.Error())
+	}
+
+	return new(big.Int).SetBytes(res), nil
+}
+
+// Sweep 充值地址 BNB 不足以支付手续费时，从 gas_key_file 账户补充后返回 biz.ErrSweepGasPending，
+// 补充的交易未上链前不重复补充
+func (w *HdDepositWallet) Sweep(ctx context.Context, index uint32, contract string, amount *big.Int) (string, error) {
+	if !common.IsHexAddress(w.treasury) {
+		return "", errors.New(500, "CHAIN_CONFIG_ERROR", "chain.hd_wallet.treasury 配置错误")
+	}
+
+	privateKey, err := w.privateKey(index)
+	if nil != err {
+		return "", err
+	}
+
+	rc, err := rpc.DialContext(ctx, w.rpcUrl)
+	if nil != err {
+		return "", errors.New(500, "RPC_ERROR", err.Error())
+	}
+	defer rc.Close()
+	client := ethclient.NewClient(rc)
+	chainId, err := readChainId(ctx, rc)
+	if nil != err {
+		return "", err
+	}
+
+	var (
+		from  = crypto.PubkeyToAddress(privateKey.PublicKey)
+		token = common.HexToAddress(contract)
+		data  []byte
+	)
+	data = append(data, erc20TransferMethod...)
+	data = append(data, common.LeftPadBytes(common.HexToAddress(w.treasury).Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(amount.Bytes(), 32)...)
+
+	gasPrice, err := client.SuggestGasPrice(ctx)
+	if nil != err {
+		return "", errors.New(500, "RPC_ERROR", err.Error())
+	}
+	gasLimit, err := client.EstimateGas(ctx, ethereum.CallMsg{From: from, To: &token, Data: data})
+	if nil != err {
+		return "", errors.New(500, "RPC_ERROR", err.Error())
+	}
+	fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gasLimit))
+
+	balance, err := client.BalanceAt(ctx, from, nil)
+	if nil != err {
+		return "", errors.New(500, "RPC_ERROR", err.Error())
+	}
+	if 0 > balance.Cmp(fee) {
+		pendingBalance, err := client.PendingBalanceAt(ctx, from)
+		if nil != err {
+			return "", errors.New(500, "RPC_ERROR", err.Error())
+		}
+		if 0 > pendingBalance.Cmp(fee) {
+			if err = w.sendGas(ctx, client, chainId, from, fee, gasPrice); nil != err {
+				return "", err
+			}
+		}
+		return "", biz.ErrSweepGasPending
+	}
+
+	return sendTransaction(ctx, client, chainId, privateKey, token, big.NewInt(0), gasLimit, gasPrice, data)
+}
+
+// sendGas 为充值地址补充手续费，未配置 gas_amount 时按预估手续费的 2 倍
+func (w *HdDepositWallet) sendGas(ctx context.Context, client *ethclient.Client, chainId *big.Int, to common.Address, fee *big.Int, gasPrice *big.Int) error {
+	if "" == w.gasKey {
+		return errors.New(500, "CHAIN_CONFIG_ERROR", "未配置 chain.hd_wallet.gas_key_file，无法补充手续费")
+	}
+	gasKey, err := readHexKey(w.gasKey)
+	if nil != err {
+		return err
+	}
+
+	value := new(big.Int).Mul(fee, big.NewInt(2))
+	if "" != w.gasValue {
+		if _, ok := value.SetString(w.gasValue, 10); !ok {
+			return errors.New(500, "CHAIN_CONFIG_ERROR", "chain.hd_wallet.gas_amount 配置错误")
+		}
+	}
+
+	hash, err := sendTransaction(ctx, client, chainId, gasKey, to, value, 21000, gasPrice, nil)
+	if nil != err {
+		return err
+	}
+	w.log.Infof("deposit sweep: gas %s sent to %s, hash %s", value.String(), strings.ToLower(to.Hex()), hash)
+
+	return nil
+}
+
+// privateKey 读取扩展私钥并派生第 index 个充值地址的私钥，扩展私钥必须与 xpub 对应
+func (w *HdDepositWallet) privateKey(index uint32) (*ecdsa.PrivateKey, error) {
+	if nil == w.xpub || "" == w.xprvFile {
+		return nil, errors.New(500, "CHAIN_CONFIG_ERROR", "未配置 chain.hd_wallet.xpub 或 xprv_file")
+	}
+
+	content, err := ioutil.ReadFile(w.xprvFile)
+	if nil != err {
+		return nil, errors.New(500, "HD_WALLET_ERROR", "读取 xprv_file 失败: "+err.Error())
+	}
+	xprv, err := parseHdKey(strings.TrimSpace(string(content)))
+	if nil != err || !xprv.private {
+		return nil, errors.New(500, "HD_WALLET_ERROR", "xprv_file 不是扩展私钥")
+	}
+	if !bytes.Equal(xprv.publicKey(), w.xpub.key) || !bytes.Equal(xprv.chainCode, w.xpub.chainCode) {
+		return nil, errors.New(500, "HD_WALLET_ERROR", "xprv_file 与 xpub 不对应")
+	}
+
+	child, err := xprv.child(index)
+	if nil != err {
+		return nil, errors.New(500, "HD_WALLET_ERROR", err.Error())
+	}
+
+	return crypto.ToECDSA(child.key)
+}
+
+// readHexKey 读取十六进制私钥文件
+func readHexKey(file string) (*ecdsa.PrivateKey, error) {
+	content, err := ioutil.ReadFile(file)
+	if nil != err {
+		return nil, errors.New(500, "HD_WALLET_ERROR", "读取私钥文件失败: "+err.Error())
+	}
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(string(content)), "0x"))
+	if nil != err {
+		return nil, errors.New(500, "HD_WALLET_ERROR", "私钥文件格式错误")
+	}
+
+	return key, nil
+}
+
+func sendTransaction(ctx context.Context, client *ethclient.Client, chainId *big.Int, key *ecdsa.PrivateKey, to common.Address, value *big.Int, gasLimit uint64, gasPrice *big.Int, data []byte) (string, error) {
+	nonce, err := client.PendingNonceAt(ctx, crypto.PubkeyToAddress(key.PublicKey))
+	if nil != err {
+		return "", errors.New(500, "RPC_ERROR", err.Error())
+	}
+	signedTx, err := types.SignTx(types.NewTransaction(nonce, to, value, gasLimit, gasPrice, data), types.NewEIP155Signer(chainId), key)
+	if nil != err {
+		return "", errors.New(500, "SIGN_TX_ERROR", err.Error())
+	}
+	if err = client.SendTransaction(ctx, signedTx); nil != err {
+		return "", errors.New(500, "RPC_ERROR", err.Error())
+	}
+
+	return strings.ToLower(signedTx.Hash().Hex()), nil
+}
+
+// parseHdKey 解析 base58check 编码的 xpub/xprv
+func parseHdKey(s string) (*hdKey, error) {
+	raw, err := base58Decode(s)
+	if nil != err {
+		return nil, err
+	}
+	if 82 != len(raw) {
+		return nil, errors.New(500, "HD_KEY_ERROR", "扩展密钥长度错误")
+	}
+
+	payload, checksum := raw[:78], raw[78:]
+	first := sha256.Sum256(payload)
+	second := sha256.Sum256(first[:])
+	if !bytes.Equal(checksum, second[:4]) {
+		return nil, errors.New(500, "HD_KEY_ERROR", "扩展密钥校验和错误")
+	}
+
+	// version(4) depth(1) fingerprint(4) child number(4) chain code(32) key(33)
+	k := &hdKey{chainCode: payload[13:45]}
+	keyData := payload[45:78]
+	switch {
+	case bytes.Equal(payload[:4], hdVersionXpub) && (0x02 == keyData[0] || 0x03 == keyData[0]):
+		if _, err = crypto.DecompressPubkey(keyData); nil != err {
+			return nil, errors.New(500, "HD_KEY_ERROR", "扩展公钥不在曲线上")
+		}
+		k.key = keyData
+	case bytes.Equal(payload[:4], hdVersionXprv) && 0x00 == keyData[0]:
+		k.key = keyData[1:]
+		k.private = true
+	default:
+		return nil, errors.New(500, "HD_KEY_ERROR", "不支持的扩展密钥版本")
+	}
+
+	return k, nil
+}
+
+// publicKey 33 字节压缩公钥
+func (k *hdKey) publicKey() []byte {
+	if !k.private {
+		return k.key
+	}
+
+	x, y := crypto.S256().ScalarBaseMult(k.key)
+	return crypto.CompressPubkey(&ecdsa.PublicKey{Curve: crypto.S256(), X: x, Y: y})
+}
+
+// child BIP-32 非强化派生第 index 个子密钥
+func (k *hdKey) child(index uint32) (*hdKey, error) {
+	if 0x80000000 <= index {
+		return nil, errors.New(500, "HD_KEY_ERROR", "不支持强化派生")
+	}
+
+	data := make([]byte, 0, 37)
+	data = append(data, k.publicKey()...)
+	data = append(data, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(data[33:], index)
+
+	mac := hmac.New(sha512.New, k.chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+
+	n := crypto.S256().Params().N
+	il := new(big.Int).SetBytes(sum[:32])
+	if 0 <= il.Cmp(n) { // 概率可忽略，BIP-32 规定跳过该序号
+		return nil, errors.New(500, "HD_KEY_ERROR", "派生结果无效，请跳过该序号")
+	}
+
+	child := &hdKey{chainCode: sum[32:], private: k.private}
+	if k.private {
+		key := il.Add(il, new(big.Int).SetBytes(k.key))
+		key.Mod(key, n)
+		if 0 == key.Sign() {
+			return nil, errors.New(500, "HD_KEY_ERROR", "派生结果无效，请跳过该序号")
+		}
+		child.key = math.PaddedBigBytes(key, 32)
+		return child, nil
+	}
+
+	parent, err := crypto.DecompressPubkey(k.key)
+	if nil != err {
+		return nil, errors.New(500, "HD_KEY_ERROR", err.Error())
+	}
+	x, y := crypto.S256().ScalarBaseMult(sum[:32])
+	if nil == x {
+		return nil, errors.New(500, "HD_KEY_ERROR", "派生结果无效，请跳过该序号")
+	}
+	x, y = crypto.S256().Add(x, y, parent.X, parent.Y)
+	if 0 == x.Sign() && 0 == y.Sign() {
+		return nil, errors.New(500, "HD_KEY_ERROR", "派生结果无效，请跳过该序号")
+	}
+	child.key = crypto.CompressPubkey(&ecdsa.PublicKey{Curve: crypto.S256(), X: x, Y: y})
+
+	return child, nil
+}
+
+// address 以太坊地址
+func (k *hdKey) address() (common.Address, error) {
+	pub, err := crypto.DecompressPubkey(k.publicKey())
+	if nil != err {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+func base58Decode(s string) ([]byte, error) {
+	res := big.NewInt(0)
+	radix := big.NewInt(58)
+	for _, c := range s {
+		i := strings.IndexRune(base58Alphabet, c)
+		if 0 > i {
+			return nil, errors.New(500, "HD_KEY_ERROR", "base58 编码错误")
+		}
+		res.Mul(res, radix)
+		res.Add(res, big.NewInt(int64(i)))
+	}
+
+	decoded := res.Bytes()
+	zeros := 0
+	for zeros < len(s) && '1' == s[zeros] {
+		zeros++
+	}
+
+	return append(make([]byte, zeros), decoded...), nil
+}
@@ -0,0 +1,165 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"time"
+)
+
+// 只释放自己持有的锁
+var unLockJobScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// 只续期自己持有的锁
+var extendJobLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+func jobLockKey(domain string) string {
+	return "job:lock:" + domain
+}
+
+type JobRun struct {
+	ID        int64      `gorm:"primarykey;type:int"`
+	Name      string     `gorm:"type:varchar(45);not null"`
+	Status    string     `gorm:"type:varchar(45);not null"`
+	StartedAt time.Time  `gorm:"type:datetime;not null"`
+	EndedAt   *time.Time `gorm:"type:datetime"`
+	Items     int64      `gorm:"type:bigint;not null"`
+	Error     string     `gorm:"type:varchar(1000);not null"`
+	CreatedAt time.Time  `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time  `gorm:"type:datetime;not null"`
+}
+
+type JobRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewJobRepo(data *Data, logger log.Logger) biz.JobRepo {
+	return &JobRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// LockJob .
+func (j *JobRepo) LockJob(ctx context.Context, domain string, token string, expire time.Duration) (bool, error) {
+	ok, err := j.data.rdb.SetNX(ctx, jobLockKey(domain), token, expire).Result()
+	if nil != err {
+		return false, errors.New(500, "JOB LOCK ERROR", err.Error())
+	}
+
+	return ok, nil
+}
+
+// ExtendJobLock 锁已过期或被其他实例持有时返回 false
+func (j *JobRepo) ExtendJobLock(ctx context.Context, domain string, token string, expire time.Duration) (bool, error) {
+	res, err := extendJobLockScript.Run(ctx, j.data.rdb, []string{jobLockKey(domain)}, token, expire.Milliseconds()).Int()
+	if nil != err {
+		return false, errors.New(500, "JOB LOCK ERROR", err.Error())
+	}
+
+	return 1 == res, nil
+}
+
+// UnLockJob .
+func (j *JobRepo) UnLockJob(ctx context.Context, domain string, token string) error {
+	if err := unLockJobScript.Run(ctx, j.data.rdb, []string{jobLockKey(domain)}, token).Err(); nil != err {
+		return errors.New(500, "JOB LOCK ERROR", err.Error())
+	}
+
+	return nil
+}
+
+// CreateJobRun .
+func (j *JobRepo) CreateJobRun(ctx context.Context, jr *biz.JobRun) (*biz.JobRun, error) {
+	var jobRun JobRun
+	jobRun.Name = jr.Name
+	jobRun.Status = jr.Status
+	jobRun.StartedAt = jr.StartedAt
+
+	res := j.data.DB(ctx).Table("job_run").Create(&jobRun)
+	if res.Error != nil {
+		return nil, errors.New(500, "CREATE_JOB_RUN_ERROR", "任务记录创建失败")
+	}
+
+	return toBizJobRun(&jobRun), nil
+}
+
+// UpdateJobRun .
+func (j *JobRepo) UpdateJobRun(ctx context.Context, jr *biz.JobRun) error {
+	res := j.data.DB(ctx).Table("job_run").Where("id=?", jr.ID).
+		Updates(map[string]interface{}{"status": jr.Status, "ended_at": jr.EndedAt, "items": jr.Items, "error": jr.Error})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_JOB_RUN_ERROR", "任务记录修改失败")
+	}
+
+	return nil
+}
+
+// GetJobRunLastSuccess .
+func (j *JobRepo) GetJobRunLastSuccess(ctx context.Context, name string) (*biz.JobRun, error) {
+	var jobRun JobRun
+	if err := j.data.db.Table("job_run").Where("name=? and status=?", name, biz.JobRunStatusSuccess).
+		Order("id desc").First(&jobRun).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "JOB RUN ERROR", err.Error())
+	}
+
+	return toBizJobRun(&jobRun), nil
+}
+
+// GetJobRuns .
+func (j *JobRepo) GetJobRuns(ctx context.Context, b *biz.Pagination, name string) ([]*biz.JobRun, error, int64) {
+	var (
+		jobRuns []*JobRun
+		count   int64
+	)
+	res := make([]*biz.JobRun, 0)
+
+	instance := j.data.db.Table("job_run")
+
+	if "" != name {
+		instance = instance.Where("name=?", name)
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&jobRuns).Error; err != nil {
+		return nil, errors.New(500, "JOB RUN ERROR", err.Error()), 0
+	}
+
+	for _, jobRun := range jobRuns {
+		res = append(res, toBizJobRun(jobRun))
+	}
+	return res, nil, count
+}
+
+func toBizJobRun(jobRun *JobRun) *biz.JobRun {
+	r := &biz.JobRun{
+		ID:        jobRun.ID,
+		Name:      jobRun.Name,
+		Status:    jobRun.Status,
+		StartedAt: jobRun.StartedAt,
+		Items:     jobRun.Items,
+		Error:     jobRun.Error,
+	}
+	if nil != jobRun.EndedAt {
+		r.EndedAt = *jobRun.EndedAt
+	}
+	return r
+}
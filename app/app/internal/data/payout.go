@@ -0,0 +1,454 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	payoutStuckAfterDefault = 3 * time.Minute
+	payoutNativeGasLimit    = 21000
+)
+
+// payoutTx 已发送未确认打包的交易，重发时保持 nonce、收款地址、金额和 data 不变
+type payoutTx struct {
+	tx     *types.Transaction
+	sentAt time.Time
+}
+
+// EthPayer 打款账户，nonce 在本地按顺序分配，发送失败后下次发送前与链上重新同步
+type EthPayer struct {
+	rpcUrl     string
+	signer     biz.Signer
+	address    common.Address
+	stuckAfter time.Duration
+	log        *log.Helper
+
+	mu      sync.Mutex
+	rpc     *rpc.Client
+	client  *ethclient.Client
+	chainId *big.Int
+	nonce   uint64
+	synced  bool
+	pending map[uint64]*payoutTx
+}
+
+// NewPayer 用 signer 创建打款账户，signer 为 nil 时返回 nil
+func NewPayer(c *conf.Chain, signer biz.Signer, logger log.Logger) biz.Payer {
+	if nil == signer {
+		return nil
+	}
+
+	stuckAfter := c.GetPayoutStuckAfter().AsDuration()
+	if 0 >= stuckAfter {
+		stuckAfter = payoutStuckAfterDefault
+	}
+
+	return &EthPayer{
+		rpcUrl:     c.GetRpcUrl(),
+		signer:     signer,
+		address:    common.HexToAddress(signer.Address()),
+		stuckAfter: stuckAfter,
+		log:        log.NewHelper(logger),
+		pending:    make(map[uint64]*payoutTx),
+	}
+}
+
+func (p *EthPayer) Address() string {
+	return p.signer.Address()
+}
+
+// TransferToken .
+func (p *EthPayer) TransferToken(ctx context.Context, contract string, to string, amount *big.Int, save func(tx *biz.PayoutTx) error) (*biz.PayoutTx, error) {
+	token := common.HexToAddress(contract)
+	var data []byte
+	data = append(data, erc20TransferMethod...)
+	data = append(data, common.LeftPadBytes(common.HexToAddress(to).Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(amount.Bytes(), 32)...)
+
+	return p.send(ctx, token, big.NewInt(0), data, save)
+}
+
+// TransferNative .
+func (p *EthPayer) TransferNative(ctx context.Context, to string, amount *big.Int) (string, error) {
+	tx, err := p.send(ctx, common.HexToAddress(to), amount, nil, nil)
+	if nil != err {
+		return "", err
+	}
+
+	return tx.Hash, nil
+}
+
+// NativeBalance .
+func (p *EthPayer) NativeBalance(ctx context.Context) (*big.Int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	client, err := p.dial(ctx)
+	if nil != err {
+		return nil, err
+	}
+	balance, err := client.PendingBalanceAt(ctx, p.address)
+	if nil != err {
+		return nil, errors.New(500, "RPC_ERROR", err.Error())
+	}
+
+	return balance, nil
+}
+
+// Receipt 直接调用 eth_getTransactionReceipt，以取得回执中的区块号
+func (p *EthPayer) Receipt(ctx context.Context, hash string) (*biz.PayoutReceipt, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	client, err := p.dial(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	var receipt *struct {
+		TransactionHash common.Hash    `json:"transactionHash"`
+		Status          hexutil.Uint64 `json:"status"`
+		GasUsed         hexutil.Uint64 `json:"gasUsed"`
+		BlockNumber     *hexutil.Big   `json:"blockNumber"`
+	}
+	if err = p.rpc.CallContext(ctx, &receipt, "eth_getTransactionReceipt", common.HexToHash(hash)); nil != err {
+		return nil, errors.New(500, "RPC_ERROR", err.Error())
+	}
+	if nil == receipt || nil == receipt.BlockNumber {
+		return nil, nil
+	}
+
+	head, err := client.HeaderByNumber(ctx, nil)
+	if nil != err {
+		return nil, errors.New(500, "RPC_ERROR", err.Error())
+	}
+	var (
+		blockNumber   = (*big.Int)(receipt.BlockNumber).Uint64()
+		confirmations uint64
+	)
+	if head.Number.Uint64() >= blockNumber {
+		confirmations = head.Number.Uint64() - blockNumber + 1
+	}
+
+	return &biz.PayoutReceipt{
+		Hash:          strings.ToLower(receipt.TransactionHash.Hex()),
+		Status:        uint64(receipt.Status),
+		GasUsed:       uint64(receipt.GasUsed),
+		BlockNumber:   blockNumber,
+		Confirmations: confirmations,
+	}, nil
+}
+
+// Unconfirmed 链上 pending nonce 与已打包 nonce 之差，包含其他实例发送的交易
+func (p *EthPayer) Unconfirmed(ctx context.Context) (uint64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	client, err := p.dial(ctx)
+	if nil != err {
+		return 0, err
+	}
+	minedNonce, err := client.NonceAt(ctx, p.address, nil)
+	if nil != err {
+		return 0, errors.New(500, "RPC_ERROR", err.Error())
+	}
+	pendingNonce, err := client.PendingNonceAt(ctx, p.address)
+	if nil != err {
+		return 0, errors.New(500, "RPC_ERROR", err.Error())
+	}
+	if pendingNonce <= minedNonce {
+		return 0, nil
+	}
+
+	return pendingNonce - minedNonce, nil
+}
+
+// MinedNonce .
+func (p *EthPayer) MinedNonce(ctx context.Context) (uint64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	client, err := p.dial(ctx)
+	if nil != err {
+		return 0, err
+	}
+	nonce, err := client.NonceAt(ctx, p.address, nil)
+	if nil != err {
+		return 0, errors.New(500, "RPC_ERROR", err.Error())
+	}
+
+	return nonce, nil
+}
+
+// send 分配下一个 nonce 签名，save 保存交易后再发送。节点明确拒绝时不占用 nonce，并在下次发送前与链上重新同步；
+// 其他发送错误时交易可能已广播，占用 nonce 并保留在 pending 中，由 ReplaceStuck 用相同 nonce 重发
+func (p *EthPayer) send(ctx context.Context, to common.Address, value *big.Int, data []byte, save func(tx *biz.PayoutTx) error) (*biz.PayoutTx, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	client, err := p.dial(ctx)
+	if nil != err {
+		return nil, err
+	}
+	if err = p.sync(ctx, client); nil != err {
+		return nil, err
+	}
+
+	gasPrice, err := client.SuggestGasPrice(ctx)
+	if nil != err {
+		return nil, errors.New(500, "RPC_ERROR", err.Error())
+	}
+	gasLimit := uint64(payoutNativeGasLimit)
+	if 0 < len(data) {
+		gasLimit, err = client.EstimateGas(ctx, ethereum.CallMsg{From: p.address, To: &to, Value: value, Data: data})
+		if nil != err {
+			return nil, errors.New(500, "RPC_ERROR", err.Error())
+		}
+	}
+
+	signedTx, err := p.signer.SignTx(ctx, types.NewTransaction(p.nonce, to, value, gasLimit, gasPrice, data), p.chainId)
+	if nil != err {
+		return nil, err
+	}
+	tx, err := toBizPayoutTx(signedTx)
+	if nil != err {
+		return nil, err
+	}
+	if nil != save {
+		if err = save(tx); nil != err {
+			return nil, err
+		}
+	}
+
+	if err = client.SendTransaction(ctx, signedTx); nil != err {
+		err = payoutSendError(err)
+		if errors.Is(err, biz.ErrPayoutInsufficientFunds) || errors.Is(err, biz.ErrPayoutRejected) {
+			p.synced = false
+			return nil, err
+		}
+		p.log.Errorf("payer: %s nonce %d tx %s may be sent, err: %v", p.address.Hex(), p.nonce, tx.Hash, err)
+	}
+
+	p.pending[p.nonce] = &payoutTx{tx: signedTx, sentAt: time.Now()}
+	p.nonce++
+
+	return tx, err
+}
+
+// ReplaceStuck 按链上 nonce 清理已打包的交易，超过 stuckAfter 未打包的交易 gas price 提高 1/8 后用相同 nonce 重发
+func (p *EthPayer) ReplaceStuck(ctx context.Context) ([]*biz.ReplacedTx, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	client, err := p.dial(ctx)
+	if nil != err {
+		return nil, err
+	}
+	minedNonce, err := client.NonceAt(ctx, p.address, nil)
+	if nil != err {
+		return nil, errors.New(500, "RPC_ERROR", err.Error())
+	}
+	p.synced = false
+	if err = p.sync(ctx, client); nil != err {
+		return nil, err
+	}
+
+	nonces := make([]uint64, 0, len(p.pending))
+	for nonce := range p.pending {
+		if nonce < minedNonce {
+			delete(p.pending, nonce)
+			continue
+		}
+		nonces = append(nonces, nonce)
+	}
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+
+	res := make([]*biz.ReplacedTx, 0)
+	for _, nonce := range nonces {
+		item := p.pending[nonce]
+		if time.Since(item.sentAt) < p.stuckAfter {
+			continue
+		}
+
+		gasPrice := new(big.Int).Add(item.tx.GasPrice(), new(big.Int).Div(item.tx.GasPrice(), big.NewInt(8)))
+		suggest, err := client.SuggestGasPrice(ctx)
+		if nil == err && 0 < suggest.Cmp(gasPrice) {
+			gasPrice = suggest
+		}
+
+		tx := types.NewTransaction(nonce, *item.tx.To(), item.tx.Value(), item.tx.Gas(), gasPrice, item.tx.Data())
+		signedTx, err := p.broadcast(ctx, client, tx)
+		if nil != err {
+			// 重发期间原交易已打包
+			if strings.Contains(err.Error(), "nonce too low") {
+				delete(p.pending, nonce)
+				continue
+			}
+			return res, err
+		}
+		newTx, err := toBizPayoutTx(signedTx)
+		if nil != err {
+			return res, err
+		}
+
+		res = append(res, &biz.ReplacedTx{
+			Nonce:   nonce,
+			OldHash: strings.ToLower(item.tx.Hash().Hex()),
+			NewHash: newTx.Hash,
+			NewRaw:  newTx.Raw,
+		})
+		p.pending[nonce] = &payoutTx{tx: signedTx, sentAt: time.Now()}
+		p.log.Infof("payer: %s nonce %d replaced %s by %s", p.address.Hex(), nonce, item.tx.Hash().Hex(), signedTx.Hash().Hex())
+	}
+
+	return res, nil
+}
+
+// sync 本地 nonce 未同步时取链上 pending nonce，本地 nonce 较大时保留，避免覆盖节点未返回的已发送交易
+func (p *EthPayer) sync(ctx context.Context, client *ethclient.Client) error {
+	if p.synced {
+		return nil
+	}
+
+	nonce, err := client.PendingNonceAt(ctx, p.address)
+	if nil != err {
+		return errors.New(500, "RPC_ERROR", err.Error())
+	}
+	if nonce > p.nonce || 0 == len(p.pending) {
+		p.nonce = nonce
+	}
+	p.synced = true
+
+	return nil
+}
+
+// Restore 恢复重启前已保存的未打包交易，本地已有的 nonce 跳过。
+// 无法确定交易是否已发送，发送时间按已超时处理，由 ReplaceStuck 提高 gas price 重发，已打包的由 ReplaceStuck 清理
+func (p *EthPayer) Restore(ctx context.Context, txs []*biz.PayoutTx) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, v := range txs {
+		if _, ok := p.pending[v.Nonce]; ok {
+			continue
+		}
+
+		raw, err := hexutil.Decode(v.Raw)
+		if nil != err {
+			return errors.New(500, "PAYOUT_TX_ERROR", err.Error())
+		}
+		tx := new(types.Transaction)
+		if err = rlp.DecodeBytes(raw, tx); nil != err {
+			return errors.New(500, "PAYOUT_TX_ERROR", err.Error())
+		}
+		if tx.Nonce() != v.Nonce {
+			return errors.New(500, "PAYOUT_TX_ERROR", "交易 nonce 不一致")
+		}
+
+		p.pending[v.Nonce] = &payoutTx{tx: tx}
+		if v.Nonce >= p.nonce {
+			p.nonce = v.Nonce + 1
+		}
+	}
+
+	return nil
+}
+
+func (p *EthPayer) broadcast(ctx context.Context, client *ethclient.Client, tx *types.Transaction) (*types.Transaction, error) {
+	signedTx, err := p.signer.SignTx(ctx, tx, p.chainId)
+	if nil != err {
+		return nil, err
+	}
+	if err = client.SendTransaction(ctx, signedTx); nil != err {
+		return nil, payoutSendError(err)
+	}
+
+	return signedTx, nil
+}
+
+// payoutRejected 节点明确拒绝交易时返回的错误，这些交易不会进入交易池
+var payoutRejected = []string{
+	"nonce too low",
+	"underpriced",
+	"intrinsic gas too low",
+	"exceeds block gas limit",
+	"invalid sender",
+	"oversized data",
+	"negative value",
+}
+
+// payoutSendError 区分节点明确拒绝和结果未知的发送错误，超时、连接断开等错误时交易可能已广播
+func payoutSendError(err error) error {
+	msg := err.Error()
+	if strings.Contains(msg, "insufficient funds") {
+		return biz.ErrPayoutInsufficientFunds
+	}
+	for _, v := range payoutRejected {
+		if strings.Contains(msg, v) {
+			return errors.New(500, biz.ErrPayoutRejected.Reason, msg)
+		}
+	}
+
+	return errors.New(500, "RPC_ERROR", msg)
+}
+
+func toBizPayoutTx(tx *types.Transaction) (*biz.PayoutTx, error) {
+	raw, err := rlp.EncodeToBytes(tx)
+	if nil != err {
+		return nil, errors.New(500, "PAYOUT_TX_ERROR", err.Error())
+	}
+
+	return &biz.PayoutTx{
+		Nonce: tx.Nonce(),
+		Hash:  strings.ToLower(tx.Hash().Hex()),
+		Raw:   hexutil.Encode(raw),
+	}, nil
+}
+
+// dial 首次使用时连接节点并读取 chain ID
+func (p *EthPayer) dial(ctx context.Context) (*ethclient.Client, error) {
+	if nil != p.client {
+		return p.client, nil
+	}
+
+	rc, err := rpc.DialContext(ctx, p.rpcUrl)
+	if nil != err {
+		return nil, errors.New(500, "RPC_ERROR", err.Error())
+	}
+	chainId, err := readChainId(ctx, rc)
+	if nil != err {
+		rc.Close()
+		return nil, err
+	}
+
+	p.rpc = rc
+	p.client = ethclient.NewClient(rc)
+	p.chainId = chainId
+	return p.client, nil
+}
+
+// readChainId 签名用的 EIP-155 chain ID，与 net_version 返回的 network ID 不一定相同
+func readChainId(ctx context.Context, rc *rpc.Client) (*big.Int, error) {
+	var chainId hexutil.Big
+	if err := rc.CallContext(ctx, &chainId, "eth_chainId"); nil != err {
+		return nil, errors.New(500, "RPC_ERROR", err.Error())
+	}
+
+	return (*big.Int)(&chainId), nil
+}
@@ -6,19 +6,26 @@ import (
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
+	"strings"
 	"time"
 )
 
 type EthUserRecord struct {
-	ID        int64     `gorm:"primarykey;type:int"`
-	Hash      string    `gorm:"type:varchar(100);not null"`
-	UserId    int64     `gorm:"type:int;not null"`
-	Status    string    `gorm:"type:varchar(45);not null"`
-	Type      string    `gorm:"type:varchar(45);not null"`
-	Amount    string    `gorm:"type:varchar(45);not null"`
-	CoinType  string    `gorm:"type:varchar(45);not null"`
-	CreatedAt time.Time `gorm:"type:datetime;not null"`
-	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+	ID          int64     `gorm:"primarykey;type:int"`
+	ChainId     int64     `gorm:"type:bigint;not null;default:0;uniqueIndex:idx_eth_user_record_log"`
+	Hash        string    `gorm:"type:varchar(100);not null;uniqueIndex:idx_eth_user_record_log"`
+	Address     string    `gorm:"type:varchar(100);not null;default:'';index"`
+	UserId      int64     `gorm:"type:int;not null"`
+	Status      string    `gorm:"type:varchar(45);not null"`
+	Type        string    `gorm:"type:varchar(45);not null"`
+	Amount      string    `gorm:"type:varchar(45);not null"`
+	CoinType    string    `gorm:"type:varchar(45);not null"`
+	BlockNumber uint64    `gorm:"type:bigint;not null;default:0"`
+	TxIndex     int64     `gorm:"type:int;not null;default:0"`
+	LogIndex    int64     `gorm:"type:int;not null;default:0;uniqueIndex:idx_eth_user_record_log"` // 迁移前的历史记录为 -1
+	LocationId  int64     `gorm:"type:int;not null;default:0"`
+	CreatedAt   time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt   time.Time `gorm:"type:datetime;not null"`
 }
 
 type EthUserRecordRepo struct {
@@ -33,26 +40,40 @@ func NewEthUserRecordRepo(data *Data, logger log.Logger) biz.EthUserRecordRepo {
 	}
 }
 
-func (e *EthUserRecordRepo) GetEthUserRecordListByHash(ctx context.Context, hash ...string) (map[string]*biz.EthUserRecord, error) {
+// GetEthUserRecordListByKey 按 (chain_id, hash, log_index) 查询，
+// 迁移前按 hash 入账的历史记录（log_index 为 -1）代表整笔交易，匹配该交易的所有日志序号
+func (e *EthUserRecordRepo) GetEthUserRecordListByKey(ctx context.Context, keys ...biz.EthUserRecordKey) (map[biz.EthUserRecordKey]*biz.EthUserRecord, error) {
+	res := make(map[biz.EthUserRecordKey]*biz.EthUserRecord, 0)
+	if 0 >= len(keys) {
+		return res, nil
+	}
+
+	hash := make([]string, 0, len(keys))
+	for _, v := range keys {
+		hash = append(hash, v.Hash)
+	}
+
 	var ethUserRecord []*EthUserRecord
 	if err := e.data.DB(ctx).Table("eth_user_record").Where("hash IN (?)", hash).Find(&ethUserRecord).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.NotFound("USER_RECOMMEND_NOT_FOUND", "user recommend not found")
-		}
-
-		return nil, errors.New(500, "USER RECOMMEND ERROR", err.Error())
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error())
 	}
 
-	res := make(map[string]*biz.EthUserRecord, 0)
+	legacy := make(map[biz.EthUserRecordKey]*biz.EthUserRecord, 0)
 	for _, item := range ethUserRecord {
-		res[item.Hash] = &biz.EthUserRecord{
-			ID:       item.ID,
-			UserId:   item.UserId,
-			Hash:     item.Hash,
-			Status:   item.Status,
-			Type:     item.Type,
-			Amount:   item.Amount,
-			CoinType: item.CoinType,
+		record := toBizEthUserRecord(item)
+		if biz.EthUserRecordLegacyLogIndex == record.LogIndex {
+			legacy[record.Key()] = record
+			continue
+		}
+		res[record.Key()] = record
+	}
+
+	for _, v := range keys {
+		if _, ok := res[v]; ok {
+			continue
+		}
+		if record, ok := legacy[biz.EthUserRecordKey{ChainId: v.ChainId, Hash: v.Hash, LogIndex: biz.EthUserRecordLegacyLogIndex}]; ok {
+			res[v] = record
 		}
 	}
 
@@ -62,24 +83,183 @@ func (e *EthUserRecordRepo) GetEthUserRecordListByHash(ctx context.Context, hash
 func (e *EthUserRecordRepo) CreateEthUserRecordListByHash(ctx context.Context, r *biz.EthUserRecord) (*biz.EthUserRecord, error) {
 	var ethUserRecord EthUserRecord
 	ethUserRecord.UserId = r.UserId
+	ethUserRecord.ChainId = r.ChainId
 	ethUserRecord.Hash = r.Hash
+	ethUserRecord.Address = r.Address
 	ethUserRecord.Type = r.Type
 	ethUserRecord.Status = r.Status
 	ethUserRecord.Amount = r.Amount
 	ethUserRecord.CoinType = r.CoinType
+	ethUserRecord.BlockNumber = r.BlockNumber
+	ethUserRecord.TxIndex = r.TxIndex
+	ethUserRecord.LogIndex = r.LogIndex
+	ethUserRecord.LocationId = r.LocationId
 
 	res := e.data.DB(ctx).Table("eth_user_record").Create(&ethUserRecord)
 	if res.Error != nil {
 		return nil, errors.New(500, "CREATE_ETH_USER_RECORD_ERROR", "以太坊交易信息创建失败")
 	}
 
+	return toBizEthUserRecord(&ethUserRecord), nil
+}
+
+// GetEthUserRecordListByBlock 区块范围内已入账和等待重试的充值记录，不含没有区块高度的历史记录
+func (e *EthUserRecordRepo) GetEthUserRecordListByBlock(ctx context.Context, chainId int64, coinType string, fromBlock uint64, toBlock uint64) ([]*biz.EthUserRecord, error) {
+	var ethUserRecord []*EthUserRecord
+	if err := e.data.DB(ctx).Table("eth_user_record").
+		Where("chain_id=? and type=? and status IN (?) and coin_type=?", chainId, "deposit", []string{"success", biz.EthUserRecordStatusRetry}, coinType).
+		Where("block_number>0 and block_number>=? and block_number<=?", fromBlock, toBlock).
+		Order("block_number asc, tx_index asc, log_index asc").Find(&ethUserRecord).Error; err != nil {
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error())
+	}
+
+	res := make([]*biz.EthUserRecord, 0, len(ethUserRecord))
+	for _, item := range ethUserRecord {
+		res = append(res, toBizEthUserRecord(item))
+	}
+
+	return res, nil
+}
+
+// GetEthUserRecordListByStatus .
+func (e *EthUserRecordRepo) GetEthUserRecordListByStatus(ctx context.Context, b *biz.Pagination, status ...string) ([]*biz.EthUserRecord, error, int64) {
+	var (
+		ethUserRecord []*EthUserRecord
+		count         int64
+	)
+
+	instance := e.data.db.Table("eth_user_record").Where("status IN (?)", status)
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&ethUserRecord).Error; err != nil {
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error()), 0
+	}
+
+	res := make([]*biz.EthUserRecord, 0, len(ethUserRecord))
+	for _, item := range ethUserRecord {
+		res = append(res, toBizEthUserRecord(item))
+	}
+
+	return res, nil, count
+}
+
+// GetEthUserRecordById .
+func (e *EthUserRecordRepo) GetEthUserRecordById(ctx context.Context, id int64) (*biz.EthUserRecord, error) {
+	var ethUserRecord EthUserRecord
+	if err := e.data.DB(ctx).Table("eth_user_record").Where("id=?", id).First(&ethUserRecord).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("ETH_USER_RECORD_NOT_FOUND", "充值记录不存在")
+		}
+
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error())
+	}
+
+	return toBizEthUserRecord(&ethUserRecord), nil
+}
+
+// GetEthUserRecordListByUserId .
+func (e *EthUserRecordRepo) GetEthUserRecordListByUserId(ctx context.Context, userId int64, status ...string) ([]*biz.EthUserRecord, error) {
+	var ethUserRecord []*EthUserRecord
+	if err := e.data.DB(ctx).Table("eth_user_record").
+		Where("user_id=? and status IN (?)", userId, status).
+		Order("id desc").Find(&ethUserRecord).Error; err != nil {
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error())
+	}
+
+	res := make([]*biz.EthUserRecord, 0, len(ethUserRecord))
+	for _, item := range ethUserRecord {
+		res = append(res, toBizEthUserRecord(item))
+	}
+
+	return res, nil
+}
+
+// GetLastEthUserRecordByUserId 用户最近一条指定状态的记录，没有记录时返回 nil
+func (e *EthUserRecordRepo) GetLastEthUserRecordByUserId(ctx context.Context, userId int64, statuses ...string) (*biz.EthUserRecord, error) {
+	var ethUserRecord EthUserRecord
+	if err := e.data.DB(ctx).Table("eth_user_record").Where("user_id=? and status IN (?)", userId, statuses).Order("id desc").First(&ethUserRecord).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error())
+	}
+
+	return toBizEthUserRecord(&ethUserRecord), nil
+}
+
+// GetEthUserRecordsByStatus 链上指定状态的记录，按链上顺序返回
+func (e *EthUserRecordRepo) GetEthUserRecordsByStatus(ctx context.Context, chainId int64, status string) ([]*biz.EthUserRecord, error) {
+	var ethUserRecord []*EthUserRecord
+	if err := e.data.DB(ctx).Table("eth_user_record").Where("chain_id=? and status=?", chainId, status).
+		Order("block_number asc, tx_index asc, log_index asc").Find(&ethUserRecord).Error; err != nil {
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error())
+	}
+
+	res := make([]*biz.EthUserRecord, 0, len(ethUserRecord))
+	for _, item := range ethUserRecord {
+		res = append(res, toBizEthUserRecord(item))
+	}
+
+	return res, nil
+}
+
+// UpdateEthUserRecordStatus 只修改状态为 fromStatus 的记录，避免重复处理
+func (e *EthUserRecordRepo) UpdateEthUserRecordStatus(ctx context.Context, id int64, fromStatus string, status string) error {
+	res := e.data.DB(ctx).Table("eth_user_record").Where("id=? and status=?", id, fromStatus).
+		Updates(map[string]interface{}{"status": status, "updated_at": time.Now()})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_ETH_USER_RECORD_ERROR", "以太坊交易信息修改失败")
+	}
+	if 0 == res.RowsAffected {
+		return errors.New(500, "ETH_USER_RECORD_STATUS_ERROR", "充值记录状态已变化")
+	}
+
+	return nil
+}
+
+// UpdateEthUserRecordLocation 已有记录入账占位，只处理还未占位的未匹配、未认领、等待配对、等待重试记录
+func (e *EthUserRecordRepo) UpdateEthUserRecordLocation(ctx context.Context, id int64, userId int64, status string, locationId int64) error {
+	res := e.data.DB(ctx).Table("eth_user_record").
+		Where("id=? and location_id=0 and status IN (?)", id, []string{biz.EthUserRecordStatusUnmatched, biz.EthUserRecordStatusOrphan, biz.EthUserRecordStatusUnpaired, biz.EthUserRecordStatusRetry}).
+		Updates(map[string]interface{}{"user_id": userId, "status": status, "location_id": locationId, "updated_at": time.Now()})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_ETH_USER_RECORD_ERROR", "以太坊交易信息修改失败")
+	}
+	if 0 == res.RowsAffected {
+		return errors.New(500, "ETH_USER_RECORD_STATUS_ERROR", "充值记录状态已变化")
+	}
+
+	return nil
+}
+
+// ClaimEthUserRecord 发送地址未注册时的充值归属到新用户，改为已认领等待充值任务入账；
+// 之前已归属该用户但未处理完的一起改为已认领
+func (e *EthUserRecordRepo) ClaimEthUserRecord(ctx context.Context, address string, userId int64) (int64, error) {
+	res := e.data.DB(ctx).Table("eth_user_record").
+		Where("address=? and status=? and user_id in (0, ?)", strings.ToLower(address), biz.EthUserRecordStatusOrphan, userId).
+		Updates(map[string]interface{}{"user_id": userId, "status": biz.EthUserRecordStatusClaimed, "updated_at": time.Now()})
+	if res.Error != nil {
+		return 0, errors.New(500, "UPDATE_ETH_USER_RECORD_ERROR", "以太坊交易信息修改失败")
+	}
+
+	return res.RowsAffected, nil
+}
+
+func toBizEthUserRecord(item *EthUserRecord) *biz.EthUserRecord {
 	return &biz.EthUserRecord{
-		ID:       ethUserRecord.ID,
-		UserId:   ethUserRecord.UserId,
-		Hash:     ethUserRecord.Hash,
-		Status:   ethUserRecord.Status,
-		Type:     ethUserRecord.Type,
-		Amount:   ethUserRecord.Amount,
-		CoinType: ethUserRecord.CoinType,
-	}, nil
+		ID:          item.ID,
+		UserId:      item.UserId,
+		ChainId:     item.ChainId,
+		Hash:        item.Hash,
+		Address:     item.Address,
+		Status:      item.Status,
+		Type:        item.Type,
+		Amount:      item.Amount,
+		CoinType:    item.CoinType,
+		BlockNumber: item.BlockNumber,
+		TxIndex:     item.TxIndex,
+		LogIndex:    item.LogIndex,
+		LocationId:  item.LocationId,
+		CreatedAt:   item.CreatedAt,
+	}
 }
@@ -0,0 +1,312 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+)
+
+const (
+	signerTypeKeystore = "keystore"
+	signerTypeRemote   = "remote"
+
+	remoteSignMethodDefault = "eth_signTransaction"
+)
+
+// NewSigner 按配置创建签名账户，未配置 type 时返回 nil
+func NewSigner(c *conf.Chain_Signer, logger log.Logger) (biz.Signer, error) {
+	switch c.GetType() {
+	case "":
+		return nil, nil
+	case signerTypeKeystore:
+		return newKeystoreSigner(c, logger)
+	case signerTypeRemote:
+		return newRemoteSigner(c, logger)
+	default:
+		return nil, errors.New(500, "SIGNER_CONFIG_ERROR", "不支持的签名方式: "+c.GetType())
+	}
+}
+
+// KeystoreSigner 启动时用密码解锁 geth V3 加密 keystore 文件，私钥只在内存中
+type KeystoreSigner struct {
+	address common.Address
+	key     *ecdsa.PrivateKey
+}
+
+func newKeystoreSigner(c *conf.Chain_Signer, logger log.Logger) (biz.Signer, error) {
+	if "" == c.GetKeystoreFile() {
+		return nil, errors.New(500, "SIGNER_CONFIG_ERROR", "未配置 keystore_file")
+	}
+	passphrase, err := signerPassphrase(c)
+	if nil != err {
+		return nil, err
+	}
+	content, err := ioutil.ReadFile(c.GetKeystoreFile())
+	if nil != err {
+		return nil, errors.New(500, "SIGNER_CONFIG_ERROR", "读取 keystore 文件失败: "+err.Error())
+	}
+	key, err := decryptKeystore(content, passphrase)
+	if nil != err {
+		return nil, err
+	}
+
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	if "" != c.GetAddress() && !strings.EqualFold(c.GetAddress(), address.Hex()) {
+		return nil, errors.New(500, "SIGNER_CONFIG_ERROR", "keystore 账户与 address 不一致")
+	}
+	log.NewHelper(logger).Infof("signer: keystore %s unlocked", address.Hex())
+
+	return &KeystoreSigner{address: address, key: key}, nil
+}
+
+// signerPassphrase 从环境变量或文件读取 keystore 密码，不接受写在配置里的密码
+func signerPassphrase(c *conf.Chain_Signer) (string, error) {
+	if "" != c.GetPassphraseEnv() {
+		passphrase, ok := os.LookupEnv(c.GetPassphraseEnv())
+		if !ok {
+			return "", errors.New(500, "SIGNER_CONFIG_ERROR", "未设置环境变量 "+c.GetPassphraseEnv())
+		}
+		return passphrase, nil
+	}
+	if "" != c.GetPassphraseFile() {
+		content, err := ioutil.ReadFile(c.GetPassphraseFile())
+		if nil != err {
+			return "", errors.New(500, "SIGNER_CONFIG_ERROR", "读取 passphrase_file 失败: "+err.Error())
+		}
+		return strings.TrimRight(string(content), "\r\n"), nil
+	}
+
+	return "", errors.New(500, "SIGNER_CONFIG_ERROR", "未配置 passphrase_env 或 passphrase_file")
+}
+
+func (s *KeystoreSigner) Address() string {
+	return s.address.Hex()
+}
+
+func (s *KeystoreSigner) SignTx(ctx context.Context, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error) {
+	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(chainId), s.key)
+	if nil != err {
+		return nil, errors.New(500, "SIGN_TX_ERROR", err.Error())
+	}
+
+	return signedTx, nil
+}
+
+// keystoreV3 geth V3 keystore 文件格式
+type keystoreV3 struct {
+	Address string `json:"address"`
+	Crypto  struct {
+		Cipher       string `json:"cipher"`
+		CipherText   string `json:"ciphertext"`
+		CipherParams struct {
+			IV string `json:"iv"`
+		} `json:"cipherparams"`
+		KDF       string          `json:"kdf"`
+		KDFParams json.RawMessage `json:"kdfparams"`
+		MAC       string          `json:"mac"`
+	} `json:"crypto"`
+	Version int `json:"version"`
+}
+
+// decryptKeystore 解密 geth V3 keystore，支持 scrypt 和 pbkdf2
+func decryptKeystore(content []byte, passphrase string) (*ecdsa.PrivateKey, error) {
+	var ks keystoreV3
+	if err := json.Unmarshal(content, &ks); nil != err {
+		return nil, errors.New(500, "KEYSTORE_ERROR", "keystore 文件格式错误")
+	}
+	if 3 != ks.Version || "aes-128-ctr" != ks.Crypto.Cipher {
+		return nil, errors.New(500, "KEYSTORE_ERROR", "只支持 V3 aes-128-ctr keystore")
+	}
+
+	cipherText, err := hex.DecodeString(ks.Crypto.CipherText)
+	if nil != err {
+		return nil, errors.New(500, "KEYSTORE_ERROR", "keystore ciphertext 格式错误")
+	}
+	iv, err := hex.DecodeString(ks.Crypto.CipherParams.IV)
+	if nil != err || aes.BlockSize != len(iv) {
+		return nil, errors.New(500, "KEYSTORE_ERROR", "keystore iv 格式错误")
+	}
+	mac, err := hex.DecodeString(ks.Crypto.MAC)
+	if nil != err {
+		return nil, errors.New(500, "KEYSTORE_ERROR", "keystore mac 格式错误")
+	}
+
+	derivedKey, err := keystoreDerivedKey(ks.Crypto.KDF, ks.Crypto.KDFParams, passphrase)
+	if nil != err {
+		return nil, err
+	}
+	if 1 != subtle.ConstantTimeCompare(crypto.Keccak256(derivedKey[16:32], cipherText), mac) {
+		return nil, errors.New(500, "KEYSTORE_ERROR", "keystore 密码错误")
+	}
+
+	block, err := aes.NewCipher(derivedKey[:16])
+	if nil != err {
+		return nil, errors.New(500, "KEYSTORE_ERROR", err.Error())
+	}
+	plain := make([]byte, len(cipherText))
+	cipher.NewCTR(block, iv).XORKeyStream(plain, cipherText)
+
+	key, err := crypto.ToECDSA(plain)
+	if nil != err {
+		return nil, errors.New(500, "KEYSTORE_ERROR", "keystore 私钥格式错误")
+	}
+	if "" != ks.Address && !bytes.Equal(common.HexToAddress(ks.Address).Bytes(), crypto.PubkeyToAddress(key.PublicKey).Bytes()) {
+		return nil, errors.New(500, "KEYSTORE_ERROR", "keystore 私钥与 address 不一致")
+	}
+
+	return key, nil
+}
+
+func keystoreDerivedKey(kdf string, params json.RawMessage, passphrase string) ([]byte, error) {
+	var p struct {
+		DkLen int    `json:"dklen"`
+		Salt  string `json:"salt"`
+		N     int    `json:"n"`
+		R     int    `json:"r"`
+		P     int    `json:"p"`
+		C     int    `json:"c"`
+		Prf   string `json:"prf"`
+	}
+	if err := json.Unmarshal(params, &p); nil != err {
+		return nil, errors.New(500, "KEYSTORE_ERROR", "keystore kdfparams 格式错误")
+	}
+	salt, err := hex.DecodeString(p.Salt)
+	if nil != err || 32 > p.DkLen {
+		return nil, errors.New(500, "KEYSTORE_ERROR", "keystore kdfparams 格式错误")
+	}
+
+	switch kdf {
+	case "scrypt":
+		key, err := scrypt.Key([]byte(passphrase), salt, p.N, p.R, p.P, p.DkLen)
+		if nil != err {
+			return nil, errors.New(500, "KEYSTORE_ERROR", err.Error())
+		}
+		return key, nil
+	case "pbkdf2":
+		if "hmac-sha256" != p.Prf {
+			return nil, errors.New(500, "KEYSTORE_ERROR", "不支持的 prf: "+p.Prf)
+		}
+		return pbkdf2.Key([]byte(passphrase), salt, p.C, p.DkLen, sha256.New), nil
+	default:
+		return nil, errors.New(500, "KEYSTORE_ERROR", "不支持的 kdf: "+kdf)
+	}
+}
+
+// RemoteSigner 通过 web3signer 或 Clef 的 JSON-RPC 签名，私钥不经过本服务
+type RemoteSigner struct {
+	client  *rpc.Client
+	address common.Address
+	method  string
+	log     *log.Helper
+}
+
+func newRemoteSigner(c *conf.Chain_Signer, logger log.Logger) (biz.Signer, error) {
+	if "" == c.GetUrl() || !common.IsHexAddress(c.GetAddress()) {
+		return nil, errors.New(500, "SIGNER_CONFIG_ERROR", "remote 签名需配置 url 和 address")
+	}
+	method := c.GetMethod()
+	if "" == method {
+		method = remoteSignMethodDefault
+	}
+	client, err := rpc.DialHTTP(c.GetUrl())
+	if nil != err {
+		return nil, errors.New(500, "SIGNER_CONFIG_ERROR", "连接签名服务失败: "+err.Error())
+	}
+
+	return &RemoteSigner{
+		client:  client,
+		address: common.HexToAddress(c.GetAddress()),
+		method:  method,
+		log:     log.NewHelper(logger),
+	}, nil
+}
+
+func (s *RemoteSigner) Address() string {
+	return s.address.Hex()
+}
+
+// remoteSignArgs web3signer eth_signTransaction 与 Clef account_signTransaction 共用的交易参数
+type remoteSignArgs struct {
+	From     common.Address  `json:"from"`
+	To       *common.Address `json:"to,omitempty"`
+	Gas      hexutil.Uint64  `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Value    *hexutil.Big    `json:"value"`
+	Nonce    hexutil.Uint64  `json:"nonce"`
+	Data     hexutil.Bytes   `json:"data"`
+	ChainId  *hexutil.Big    `json:"chainId"`
+}
+
+// SignTx 远程签名后校验签名账户和交易内容，签名服务返回的交易与请求不一致时拒绝
+func (s *RemoteSigner) SignTx(ctx context.Context, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error) {
+	args := remoteSignArgs{
+		From:     s.address,
+		To:       tx.To(),
+		Gas:      hexutil.Uint64(tx.Gas()),
+		GasPrice: (*hexutil.Big)(tx.GasPrice()),
+		Value:    (*hexutil.Big)(tx.Value()),
+		Nonce:    hexutil.Uint64(tx.Nonce()),
+		Data:     tx.Data(),
+		ChainId:  (*hexutil.Big)(chainId),
+	}
+
+	var res json.RawMessage
+	if err := s.client.CallContext(ctx, &res, s.method, args); nil != err {
+		return nil, errors.New(500, "SIGN_TX_ERROR", "远程签名失败: "+err.Error())
+	}
+
+	// web3signer 返回签名后的交易，Clef 返回 {raw, tx}
+	var raw hexutil.Bytes
+	if 0 < len(res) && '"' == res[0] {
+		if err := json.Unmarshal(res, &raw); nil != err {
+			return nil, errors.New(500, "SIGN_TX_ERROR", "远程签名返回格式错误")
+		}
+	} else {
+		var clef struct {
+			Raw hexutil.Bytes `json:"raw"`
+		}
+		if err := json.Unmarshal(res, &clef); nil != err {
+			return nil, errors.New(500, "SIGN_TX_ERROR", "远程签名返回格式错误")
+		}
+		raw = clef.Raw
+	}
+
+	signedTx := new(types.Transaction)
+	if err := rlp.DecodeBytes(raw, signedTx); nil != err {
+		return nil, errors.New(500, "SIGN_TX_ERROR", "远程签名返回的交易无法解析")
+	}
+
+	signer := types.NewEIP155Signer(chainId)
+	if signer.Hash(tx) != signer.Hash(signedTx) {
+		s.log.Errorf("signer: remote signed tx %s differs from request", signedTx.Hash().Hex())
+		return nil, errors.New(500, "SIGN_TX_ERROR", "远程签名返回的交易与请求不一致")
+	}
+	from, err := types.Sender(signer, signedTx)
+	if nil != err || from != s.address {
+		return nil, errors.New(500, "SIGN_TX_ERROR", "远程签名账户与 address 不一致")
+	}
+
+	return signedTx, nil
+}
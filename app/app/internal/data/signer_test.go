@@ -0,0 +1,143 @@
+package data
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"dhb/app/app/internal/conf"
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/go-kratos/kratos/v2/log"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// fakeRemoteSigner 代替 web3signer / Clef，用本地私钥签名 JSON-RPC 请求里的交易
+type fakeRemoteSigner struct {
+	key    *ecdsa.PrivateKey
+	clef   bool                                     // 按 Clef 格式返回 {raw, tx}
+	tamper func(args *remoteSignArgs)               // 签名前修改交易
+	called func(method string, args remoteSignArgs) // 记录请求
+}
+
+func (f *fakeRemoteSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Id     json.RawMessage  `json:"id"`
+		Method string           `json:"method"`
+		Params []remoteSignArgs `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); nil != err || 1 != len(req.Params) {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	args := req.Params[0]
+	if nil != f.called {
+		f.called(req.Method, args)
+	}
+	if nil != f.tamper {
+		f.tamper(&args)
+	}
+
+	tx := types.NewTransaction(uint64(args.Nonce), *args.To, args.Value.ToInt(), uint64(args.Gas), args.GasPrice.ToInt(), args.Data)
+	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(args.ChainId.ToInt()), f.key)
+	if nil != err {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	raw, _ := rlp.EncodeToBytes(signedTx)
+
+	var result interface{} = hexutil.Bytes(raw)
+	if f.clef {
+		result = map[string]interface{}{"raw": hexutil.Bytes(raw), "tx": signedTx}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.Id, "result": result})
+}
+
+func newTestRemoteSigner(t *testing.T, f *fakeRemoteSigner, address common.Address, method string) *RemoteSigner {
+	ts := httptest.NewServer(f)
+	t.Cleanup(ts.Close)
+
+	s, err := NewSigner(&conf.Chain_Signer{Type: signerTypeRemote, Url: ts.URL, Address: address.Hex(), Method: method}, log.DefaultLogger)
+	if nil != err {
+		t.Fatalf("new signer: %v", err)
+	}
+	return s.(*RemoteSigner)
+}
+
+func testPayoutTx() *types.Transaction {
+	return types.NewTransaction(7, common.HexToAddress("0x00000000000000000000000000000000000000b1"), big.NewInt(0), 60000, big.NewInt(5000000000), []byte{0xa9, 0x05, 0x9c, 0xbb})
+}
+
+func TestRemoteSignerRoundTrip(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	chainId := big.NewInt(56)
+
+	for _, clef := range []bool{false, true} {
+		var (
+			method  = "eth_signTransaction"
+			gotFrom common.Address
+			gotArgs remoteSignArgs
+		)
+		if clef {
+			method = "account_signTransaction"
+		}
+		s := newTestRemoteSigner(t, &fakeRemoteSigner{key: key, clef: clef, called: func(m string, args remoteSignArgs) {
+			if method != m {
+				t.Errorf("method = %s, want %s", m, method)
+			}
+			gotFrom, gotArgs = args.From, args
+		}}, address, method)
+
+		tx := testPayoutTx()
+		signedTx, err := s.SignTx(context.Background(), tx, chainId)
+		if nil != err {
+			t.Fatalf("clef=%v sign: %v", clef, err)
+		}
+		if address != gotFrom || 7 != uint64(gotArgs.Nonce) || 0 != chainId.Cmp(gotArgs.ChainId.ToInt()) {
+			t.Errorf("clef=%v request from %s nonce %d chain %v", clef, gotFrom.Hex(), gotArgs.Nonce, gotArgs.ChainId)
+		}
+		from, err := types.Sender(types.NewEIP155Signer(chainId), signedTx)
+		if nil != err || address != from {
+			t.Errorf("clef=%v signed by %s, want %s", clef, from.Hex(), address.Hex())
+		}
+		if 0 != chainId.Cmp(signedTx.ChainId()) {
+			t.Errorf("clef=%v chain id = %v, want 56", clef, signedTx.ChainId())
+		}
+	}
+}
+
+func TestRemoteSignerRejectsTamperedTx(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+
+	// 签名服务改了收款地址
+	s := newTestRemoteSigner(t, &fakeRemoteSigner{key: key, tamper: func(args *remoteSignArgs) {
+		to := common.HexToAddress("0x00000000000000000000000000000000000000e1")
+		args.To = &to
+	}}, address, "")
+	if _, err := s.SignTx(context.Background(), testPayoutTx(), big.NewInt(56)); nil == err {
+		t.Errorf("tampered tx accepted")
+	}
+
+	// 签名服务用其他账户签名
+	other, _ := crypto.GenerateKey()
+	s = newTestRemoteSigner(t, &fakeRemoteSigner{key: other}, address, "")
+	if _, err := s.SignTx(context.Background(), testPayoutTx(), big.NewInt(56)); nil == err {
+		t.Errorf("tx signed by another account accepted")
+	}
+
+	// 签名服务用其他链 id 签名
+	s = newTestRemoteSigner(t, &fakeRemoteSigner{key: key, tamper: func(args *remoteSignArgs) {
+		args.ChainId = (*hexutil.Big)(big.NewInt(97))
+	}}, address, "")
+	if _, err := s.SignTx(context.Background(), testPayoutTx(), big.NewInt(56)); nil == err {
+		t.Errorf("tx signed for another chain accepted")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"strconv"
 	"time"
 )
@@ -65,10 +66,20 @@ type Withdraw struct {
 	ID              int64     `gorm:"primarykey;type:int"`
 	UserId          int64     `gorm:"type:int"`
 	Amount          int64     `gorm:"type:bigint"`
+	RequestAmount   int64     `gorm:"type:bigint;not null;default:0"` // 申请提现的数量，提现分红后不修改
 	RelAmount       int64     `gorm:"type:bigint"`
 	Status          string    `gorm:"type:varchar(45);not null"`
 	Type            string    `gorm:"type:varchar(45);not null"`
 	BalanceRecordId int64     `gorm:"type:int"`
+	ChainId         int64     `gorm:"type:bigint;not null;default:0"`
+	Kind            string    `gorm:"type:varchar(45);not null;default:''"`
+	Address         string    `gorm:"type:varchar(100);not null;default:''"`
+	TxHash          string    `gorm:"type:varchar(100);not null;default:''"`
+	Nonce           int64     `gorm:"type:bigint;not null;default:0"`
+	RawTx           string    `gorm:"type:varchar(1000);not null;default:''"`
+	ReplacedTxHash  string    `gorm:"type:varchar(1000);not null;default:''"`
+	GasUsed         int64     `gorm:"type:bigint;not null;default:0"`
+	BlockNumber     int64     `gorm:"type:bigint;not null;default:0"`
 	CreatedAt       time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt       time.Time `gorm:"type:datetime;not null"`
 }
@@ -94,6 +105,7 @@ type Reward struct {
 	Reason           string    `gorm:"type:varchar(45);not null"`
 	ReasonLocationId int64     `gorm:"type:int;not null"`
 	LocationType     string    `gorm:"type:varchar(45);not null"`
+	Review           string    `gorm:"type:varchar(45);not null;default:''"` // 非空表示待管理员审核
 	CreatedAt        time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt        time.Time `gorm:"type:datetime;not null"`
 }
@@ -211,6 +223,17 @@ func (c *ConfigRepo) GetConfigByKeys(ctx context.Context, keys ...string) ([]*bi
 	return res, nil
 }
 
+// LockConfig 在当前事务中锁定配置行，配置不存在时不锁定
+func (c *ConfigRepo) LockConfig(ctx context.Context, key string) error {
+	var configs []*Config
+	if err := c.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("key_name=?", key).Table("config").Find(&configs).Error; err != nil {
+		return errors.New(500, "Config ERROR", err.Error())
+	}
+
+	return nil
+}
+
 // GetConfigs .
 func (c *ConfigRepo) GetConfigs(ctx context.Context) ([]*biz.Config, error) {
 	var configs []*Config
@@ -260,8 +283,9 @@ func (u *UserRepo) GetUserById(ctx context.Context, Id int64) (*biz.User, error)
 	}
 
 	return &biz.User{
-		ID:      user.ID,
-		Address: user.Address,
+		ID:        user.ID,
+		Address:   user.Address,
+		CreatedAt: user.CreatedAt,
 	}, nil
 }
 
@@ -581,6 +605,21 @@ func (ub UserBalanceRepo) GetUserBalance(ctx context.Context, userId int64) (*bi
 	}, nil
 }
 
+// LockUserBalance 在当前事务中锁定用户余额行
+func (ub *UserBalanceRepo) LockUserBalance(ctx context.Context, userId int64) error {
+	var userBalance UserBalance
+	if err := ub.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id=?", userId).Table("user_balance").First(&userBalance).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.NotFound("USER_BALANCE_NOT_FOUND", "user balance not found")
+		}
+
+		return errors.New(500, "USER BALANCE ERROR", err.Error())
+	}
+
+	return nil
+}
+
 // LocationReward .
 func (ub *UserBalanceRepo) LocationReward(ctx context.Context, userId int64, amount int64, locationId int64, myLocationId int64, locationType string) (int64, error) {
 	var err error
@@ -771,26 +810,155 @@ func (ub *UserBalanceRepo) DepositDhb(ctx context.Context, userId int64, amount
 	return userBalanceRecode.ID, nil
 }
 
-// UpdateWithdrawAmount .
-func (ub *UserBalanceRepo) UpdateWithdrawAmount(ctx context.Context, id int64, status string, amount int64) (*biz.Withdraw, error) {
-	var withdraw Withdraw
-	withdraw.Status = status
-	withdraw.Amount = amount
-	res := ub.data.DB(ctx).Table("withdraw").Where("id=?", id).Updates(&withdraw)
+// UpdateWithdrawStatus 只修改状态仍为 fromStatus 的提现，amount 为 0 时不修改金额
+func (ub *UserBalanceRepo) UpdateWithdrawStatus(ctx context.Context, id int64, fromStatus string, status string, amount int64) error {
+	updates := map[string]interface{}{"status": status, "updated_at": time.Now()}
+	if 0 < amount {
+		updates["amount"] = amount
+	}
+	res := ub.data.DB(ctx).Table("withdraw").Where("id=? and status=?", id, fromStatus).Updates(updates)
 	if res.Error != nil {
-		return nil, errors.New(500, "CREATE_WITHDRAW_ERROR", "提现记录修改失败")
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+	if 0 == res.RowsAffected {
+		return biz.ErrWithdrawStatusChanged
 	}
 
-	return &biz.Withdraw{
-		ID:              withdraw.ID,
-		UserId:          withdraw.UserId,
-		Amount:          withdraw.Amount,
-		RelAmount:       withdraw.RelAmount,
-		BalanceRecordId: withdraw.BalanceRecordId,
-		Status:          withdraw.Status,
-		Type:            withdraw.Type,
-		CreatedAt:       withdraw.CreatedAt,
-	}, nil
+	return nil
+}
+
+// GetWithdrawTotal since 之后的提现次数和申请数量，不含已拒绝、已取消的和原路退款，userId 为 0 时统计全部用户，coinType 为空时不区分代币
+func (ub *UserBalanceRepo) GetWithdrawTotal(ctx context.Context, userId int64, coinType string, since time.Time) (int64, int64, error) {
+	var total struct {
+		Count int64
+		Total int64
+	}
+	instance := ub.data.DB(ctx).Table("withdraw").
+		Where("created_at>=?", since).
+		Where("status NOT IN (?)", []string{biz.WithdrawStatusRejected, biz.WithdrawStatusCancelled}).
+		Where("kind<>?", biz.WithdrawKindRefund)
+	if 0 < userId {
+		instance = instance.Where("user_id=?", userId)
+	}
+	if "" != coinType {
+		instance = instance.Where("type=?", coinType)
+	}
+	if err := instance.Select("count(*) as count, coalesce(sum(request_amount), 0) as total").Take(&total).Error; err != nil {
+		return 0, 0, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	return total.Count, total.Total, nil
+}
+
+// GetWithdrawsByStatus .
+func (ub *UserBalanceRepo) GetWithdrawsByStatus(ctx context.Context, status string) ([]*biz.Withdraw, error) {
+	var withdraws []*Withdraw
+	if err := ub.data.DB(ctx).Table("withdraw").Where("status=?", status).Order("id asc").Find(&withdraws).Error; err != nil {
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	res := make([]*biz.Withdraw, 0, len(withdraws))
+	for _, withdraw := range withdraws {
+		res = append(res, &biz.Withdraw{
+			ID:              withdraw.ID,
+			UserId:          withdraw.UserId,
+			Amount:          withdraw.Amount,
+			RelAmount:       withdraw.RelAmount,
+			BalanceRecordId: withdraw.BalanceRecordId,
+			Status:          withdraw.Status,
+			Type:            withdraw.Type,
+			ChainId:         withdraw.ChainId,
+			Kind:            withdraw.Kind,
+			Address:         withdraw.Address,
+			TxHash:          withdraw.TxHash,
+			Nonce:           withdraw.Nonce,
+			RawTx:           withdraw.RawTx,
+			ReplacedTxHash:  withdraw.ReplacedTxHash,
+			GasUsed:         withdraw.GasUsed,
+			BlockNumber:     withdraw.BlockNumber,
+			CreatedAt:       withdraw.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// UpdateWithdrawPayoutTx 每次打款记录新的交易，清空上一次打款的回执
+func (ub *UserBalanceRepo) UpdateWithdrawPayoutTx(ctx context.Context, id int64, tx *biz.PayoutTx) error {
+	if err := ub.data.DB(ctx).Table("withdraw").Where("id=?", id).
+		Updates(map[string]interface{}{
+			"tx_hash":          tx.Hash,
+			"nonce":            tx.Nonce,
+			"raw_tx":           tx.Raw,
+			"replaced_tx_hash": "",
+			"gas_used":         0,
+			"block_number":     0,
+		}).Error; nil != err {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return nil
+}
+
+// ReplaceWithdrawTxHash 只修改已广播且交易为 oldHash 的提现
+func (ub *UserBalanceRepo) ReplaceWithdrawTxHash(ctx context.Context, chainId int64, oldHash string, newHash string, newRaw string) error {
+	if err := ub.data.DB(ctx).Table("withdraw").
+		Where("chain_id=? and tx_hash=? and status=?", chainId, oldHash, biz.WithdrawStatusBroadcast).
+		Updates(map[string]interface{}{
+			"tx_hash":          newHash,
+			"raw_tx":           newRaw,
+			"replaced_tx_hash": gorm.Expr("if(replaced_tx_hash = '', ?, concat(replaced_tx_hash, ',', ?))", oldHash, oldHash),
+		}).Error; nil != err {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return nil
+}
+
+// UpdateWithdrawReceipt .
+func (ub *UserBalanceRepo) UpdateWithdrawReceipt(ctx context.Context, id int64, txHash string, gasUsed int64, blockNumber int64) error {
+	if err := ub.data.DB(ctx).Table("withdraw").Where("id=?", id).
+		Updates(map[string]interface{}{"tx_hash": txHash, "gas_used": gasUsed, "block_number": blockNumber}).Error; nil != err {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return nil
+}
+
+// ReturnWithdraw 拒绝或取消的提现金额退回余额
+func (ub *UserBalanceRepo) ReturnWithdraw(ctx context.Context, userId int64, amount int64, coinType string) error {
+	var column string
+	switch coinType {
+	case "usdt":
+		column = "balance_usdt"
+	case "dhb":
+		column = "balance_dhb"
+	default:
+		return errors.New(500, "WITHDRAW_TOKEN_ERROR", "不支持退回的代币: "+coinType)
+	}
+
+	if res := ub.data.DB(ctx).Table("user_balance").
+		Where("user_id=?", userId).
+		Updates(map[string]interface{}{column: gorm.Expr(column+" + ?", amount)}); 0 == res.RowsAffected || nil != res.Error {
+		return errors.NotFound("user balance err", "user balance not found")
+	}
+
+	var userBalance UserBalance
+	if err := ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error; err != nil {
+		return err
+	}
+
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	if "dhb" == coinType {
+		userBalanceRecode.Balance = userBalance.BalanceDhb
+	}
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = "withdraw_return"
+	userBalanceRecode.CoinType = coinType
+	userBalanceRecode.Amount = amount
+
+	return ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 }
 
 // WithdrawUsdt .
@@ -852,11 +1020,13 @@ func (ub *UserBalanceRepo) WithdrawDhb(ctx context.Context, userId int64, amount
 }
 
 // GreateWithdraw .
-func (ub *UserBalanceRepo) GreateWithdraw(ctx context.Context, userId int64, amount int64, coinType string) (*biz.Withdraw, error) {
+func (ub *UserBalanceRepo) GreateWithdraw(ctx context.Context, userId int64, amount int64, coinType string, chainId int64) (*biz.Withdraw, error) {
 	var withdraw Withdraw
 	withdraw.UserId = userId
 	withdraw.Amount = amount
+	withdraw.RequestAmount = amount
 	withdraw.Type = coinType
+	withdraw.ChainId = chainId
 	res := ub.data.DB(ctx).Table("withdraw").Create(&withdraw)
 	if res.Error != nil {
 		return nil, errors.New(500, "CREATE_WITHDRAW_ERROR", "提现记录创建失败")
@@ -870,27 +1040,15 @@ func (ub *UserBalanceRepo) GreateWithdraw(ctx context.Context, userId int64, amo
 		BalanceRecordId: withdraw.BalanceRecordId,
 		Status:          withdraw.Status,
 		Type:            withdraw.Type,
-		CreatedAt:       withdraw.CreatedAt,
-	}, nil
-}
-
-// UpdateWithdraw .
-func (ub *UserBalanceRepo) UpdateWithdraw(ctx context.Context, id int64, status string) (*biz.Withdraw, error) {
-	var withdraw Withdraw
-	withdraw.Status = status
-	res := ub.data.DB(ctx).Table("withdraw").Where("id=?", id).Updates(&withdraw)
-	if res.Error != nil {
-		return nil, errors.New(500, "CREATE_WITHDRAW_ERROR", "提现记录修改失败")
-	}
-
-	return &biz.Withdraw{
-		ID:              withdraw.ID,
-		UserId:          withdraw.UserId,
-		Amount:          withdraw.Amount,
-		RelAmount:       withdraw.RelAmount,
-		BalanceRecordId: withdraw.BalanceRecordId,
-		Status:          withdraw.Status,
-		Type:            withdraw.Type,
+		ChainId:         withdraw.ChainId,
+		Kind:            withdraw.Kind,
+		Address:         withdraw.Address,
+		TxHash:          withdraw.TxHash,
+		Nonce:           withdraw.Nonce,
+		RawTx:           withdraw.RawTx,
+		ReplacedTxHash:  withdraw.ReplacedTxHash,
+		GasUsed:         withdraw.GasUsed,
+		BlockNumber:     withdraw.BlockNumber,
 		CreatedAt:       withdraw.CreatedAt,
 	}, nil
 }
@@ -916,6 +1074,15 @@ func (ub *UserBalanceRepo) GetWithdrawByUserId(ctx context.Context, userId int64
 			BalanceRecordId: withdraw.BalanceRecordId,
 			Status:          withdraw.Status,
 			Type:            withdraw.Type,
+			ChainId:         withdraw.ChainId,
+			Kind:            withdraw.Kind,
+			Address:         withdraw.Address,
+			TxHash:          withdraw.TxHash,
+			Nonce:           withdraw.Nonce,
+			RawTx:           withdraw.RawTx,
+			ReplacedTxHash:  withdraw.ReplacedTxHash,
+			GasUsed:         withdraw.GasUsed,
+			BlockNumber:     withdraw.BlockNumber,
 			CreatedAt:       withdraw.CreatedAt,
 		})
 	}
@@ -943,6 +1110,15 @@ func (ub *UserBalanceRepo) GetWithdrawNotDeal(ctx context.Context) ([]*biz.Withd
 			BalanceRecordId: withdraw.BalanceRecordId,
 			Status:          withdraw.Status,
 			Type:            withdraw.Type,
+			ChainId:         withdraw.ChainId,
+			Kind:            withdraw.Kind,
+			Address:         withdraw.Address,
+			TxHash:          withdraw.TxHash,
+			Nonce:           withdraw.Nonce,
+			RawTx:           withdraw.RawTx,
+			ReplacedTxHash:  withdraw.ReplacedTxHash,
+			GasUsed:         withdraw.GasUsed,
+			BlockNumber:     withdraw.BlockNumber,
 			CreatedAt:       withdraw.CreatedAt,
 		})
 	}
@@ -966,6 +1142,15 @@ func (ub *UserBalanceRepo) GetWithdrawById(ctx context.Context, id int64) (*biz.
 		BalanceRecordId: withdraw.BalanceRecordId,
 		Status:          withdraw.Status,
 		Type:            withdraw.Type,
+		ChainId:         withdraw.ChainId,
+		Kind:            withdraw.Kind,
+		Address:         withdraw.Address,
+		TxHash:          withdraw.TxHash,
+		Nonce:           withdraw.Nonce,
+		RawTx:           withdraw.RawTx,
+		ReplacedTxHash:  withdraw.ReplacedTxHash,
+		GasUsed:         withdraw.GasUsed,
+		BlockNumber:     withdraw.BlockNumber,
 		CreatedAt:       withdraw.CreatedAt,
 	}, nil
 }
@@ -1002,6 +1187,15 @@ func (ub *UserBalanceRepo) GetWithdraws(ctx context.Context, b *biz.Pagination,
 			BalanceRecordId: withdraw.BalanceRecordId,
 			Status:          withdraw.Status,
 			Type:            withdraw.Type,
+			ChainId:         withdraw.ChainId,
+			Kind:            withdraw.Kind,
+			Address:         withdraw.Address,
+			TxHash:          withdraw.TxHash,
+			Nonce:           withdraw.Nonce,
+			RawTx:           withdraw.RawTx,
+			ReplacedTxHash:  withdraw.ReplacedTxHash,
+			GasUsed:         withdraw.GasUsed,
+			BlockNumber:     withdraw.BlockNumber,
 			CreatedAt:       withdraw.CreatedAt,
 		})
 	}
@@ -1029,6 +1223,15 @@ func (ub *UserBalanceRepo) GetWithdrawPassOrRewarded(ctx context.Context) ([]*bi
 			BalanceRecordId: withdraw.BalanceRecordId,
 			Status:          withdraw.Status,
 			Type:            withdraw.Type,
+			ChainId:         withdraw.ChainId,
+			Kind:            withdraw.Kind,
+			Address:         withdraw.Address,
+			TxHash:          withdraw.TxHash,
+			Nonce:           withdraw.Nonce,
+			RawTx:           withdraw.RawTx,
+			ReplacedTxHash:  withdraw.ReplacedTxHash,
+			GasUsed:         withdraw.GasUsed,
+			BlockNumber:     withdraw.BlockNumber,
 			CreatedAt:       withdraw.CreatedAt,
 		})
 	}
@@ -1882,3 +2085,75 @@ func (uc *UserCurrentMonthRecommendRepo) GetUserLastMonthRecommend(ctx context.C
 	}
 	return res, nil
 }
+
+// FlagLocationRewards 标记占位产生的分红待审核，返回标记条数
+func (ub *UserBalanceRepo) FlagLocationRewards(ctx context.Context, locationId int64, review string) (int64, error) {
+	res := ub.data.DB(ctx).Table("reward").
+		Where("type=? and type_record_id=?", "location", locationId).
+		Updates(map[string]interface{}{"review": review, "updated_at": time.Now()})
+	if res.Error != nil {
+		return 0, errors.New(500, "UPDATE_REWARD_ERROR", "分红信息修改失败")
+	}
+
+	return res.RowsAffected, nil
+}
+
+// DepositUsdt 充值直接入余额，如未匹配档位的充值由管理员入账
+func (ub *UserBalanceRepo) DepositUsdt(ctx context.Context, userId int64, amount int64) (int64, error) {
+	var err error
+	if err = ub.data.DB(ctx).Table("user_balance").
+		Where("user_id=?", userId).
+		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
+		return 0, errors.NotFound("user balance err", "user balance not found")
+	}
+
+	var userBalance UserBalance
+	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = "deposit"
+	userBalanceRecode.CoinType = "usdt"
+	userBalanceRecode.Amount = amount
+	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return userBalanceRecode.ID, nil
+}
+
+// CreateRefundWithdraw 创建原路退款，状态为新建，由 WithdrawUseCase 改为 pass 并记录事件
+func (ub *UserBalanceRepo) CreateRefundWithdraw(ctx context.Context, w *biz.Withdraw) (*biz.Withdraw, error) {
+	var withdraw Withdraw
+	withdraw.UserId = w.UserId
+	withdraw.Amount = w.Amount
+	withdraw.RequestAmount = w.Amount
+	withdraw.RelAmount = w.Amount
+	withdraw.Status = biz.WithdrawStatusNew
+	withdraw.Type = w.Type
+	withdraw.ChainId = w.ChainId
+	withdraw.Kind = biz.WithdrawKindRefund
+	withdraw.Address = w.Address
+	res := ub.data.DB(ctx).Table("withdraw").Create(&withdraw)
+	if res.Error != nil {
+		return nil, errors.New(500, "CREATE_WITHDRAW_ERROR", "提现记录创建失败")
+	}
+
+	return &biz.Withdraw{
+		ID:        withdraw.ID,
+		UserId:    withdraw.UserId,
+		Amount:    withdraw.Amount,
+		RelAmount: withdraw.RelAmount,
+		Status:    withdraw.Status,
+		Type:      withdraw.Type,
+		ChainId:   withdraw.ChainId,
+		Kind:      withdraw.Kind,
+		Address:   withdraw.Address,
+		CreatedAt: withdraw.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+type WithdrawEvent struct {
+	ID         int64     `gorm:"primarykey;type:int"`
+	WithdrawId int64     `gorm:"type:int;not null;index:idx_withdraw_event_withdraw_id"`
+	FromStatus string    `gorm:"type:varchar(45);not null"`
+	ToStatus   string    `gorm:"type:varchar(45);not null"`
+	Actor      string    `gorm:"type:varchar(100);not null"`
+	Reason     string    `gorm:"type:varchar(500);not null;default:''"`
+	CreatedAt  time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time `gorm:"type:datetime;not null"`
+}
+
+type WithdrawEventRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewWithdrawEventRepo(data *Data, logger log.Logger) biz.WithdrawEventRepo {
+	return &WithdrawEventRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateWithdrawEvent .
+func (w *WithdrawEventRepo) CreateWithdrawEvent(ctx context.Context, e *biz.WithdrawEvent) error {
+	reason := e.Reason
+	if 500 < len([]rune(reason)) {
+		reason = string([]rune(reason)[:500])
+	}
+
+	event := WithdrawEvent{
+		WithdrawId: e.WithdrawId,
+		FromStatus: e.FromStatus,
+		ToStatus:   e.ToStatus,
+		Actor:      e.Actor,
+		Reason:     reason,
+	}
+	if err := w.data.DB(ctx).Table("withdraw_event").Create(&event).Error; nil != err {
+		return errors.New(500, "CREATE_WITHDRAW_EVENT_ERROR", "提现状态记录创建失败")
+	}
+
+	e.ID = event.ID
+	e.CreatedAt = event.CreatedAt
+	return nil
+}
+
+// GetWithdrawEvents .
+func (w *WithdrawEventRepo) GetWithdrawEvents(ctx context.Context, withdrawId int64) ([]*biz.WithdrawEvent, error) {
+	var events []*WithdrawEvent
+	if err := w.data.DB(ctx).Table("withdraw_event").Where("withdraw_id=?", withdrawId).Order("id asc").Find(&events).Error; nil != err {
+		return nil, errors.New(500, "WITHDRAW EVENT ERROR", err.Error())
+	}
+
+	res := make([]*biz.WithdrawEvent, 0, len(events))
+	for _, item := range events {
+		res = append(res, &biz.WithdrawEvent{
+			ID:         item.ID,
+			WithdrawId: item.WithdrawId,
+			FromStatus: item.FromStatus,
+			ToStatus:   item.ToStatus,
+			Actor:      item.Actor,
+			Reason:     item.Reason,
+			CreatedAt:  item.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
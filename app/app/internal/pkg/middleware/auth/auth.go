@@ -6,8 +6,9 @@ import (
 )
 
 type CustomClaims struct {
-	UserId   int64
-	UserType string
+	UserId    int64
+	UserType  string
+	SessionId string
 	jwt.StandardClaims
 }
 
@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"dhb/app/app/internal/conf"
+	"errors"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// KeyRing jwt 签名密钥环，新 token 使用 active 的密钥签名并在 header 中写入 kid，
+// 校验时按 kid 选择密钥，轮换密钥时旧 token 仍可校验直到过期
+type KeyRing struct {
+	keys      map[string][]byte
+	activeKid string
+	legacyKey []byte // 不带 kid 的旧 token 使用 conf.Auth.JwtKey 校验
+}
+
+// NewKeyRing 从 conf.Auth 构建密钥环，未配置 jwt_keys 时退回使用 jwt_key
+func NewKeyRing(ca *conf.Auth) (*KeyRing, error) {
+	k := &KeyRing{
+		keys: make(map[string][]byte),
+	}
+	if "" != ca.JwtKey {
+		k.legacyKey = []byte(ca.JwtKey)
+	}
+
+	active := false
+	for _, v := range ca.JwtKeys {
+		if "" == v.Kid || "" == v.Key {
+			return nil, errors.New("jwt key kid and key must not be empty")
+		}
+		if _, ok := k.keys[v.Kid]; ok {
+			return nil, errors.New("duplicate jwt key kid: " + v.Kid)
+		}
+		k.keys[v.Kid] = []byte(v.Key)
+
+		if v.Active {
+			if active {
+				return nil, errors.New("more than one active jwt key")
+			}
+			active = true
+			k.activeKid = v.Kid
+		}
+	}
+
+	if !active && nil == k.legacyKey {
+		return nil, errors.New("no active jwt key")
+	}
+
+	return k, nil
+}
+
+// CreateToken 使用 active 的密钥签名
+func (k *KeyRing) CreateToken(c CustomClaims) (string, error) {
+	if "" == k.activeKid {
+		return CreateToken(c, string(k.legacyKey))
+	}
+
+	claims := jwt.NewWithClaims(jwt.SigningMethodHS256, c)
+	claims.Header["kid"] = k.activeKid
+	signedString, err := claims.SignedString(k.keys[k.activeKid])
+	if err != nil {
+		return "", errors.New("generate token failed" + err.Error())
+	}
+	return signedString, nil
+}
+
+// KeyFunc 按 token header 中的 kid 返回校验密钥，用于 jwt.Server
+func (k *KeyRing) KeyFunc(token *jwt.Token) (interface{}, error) {
+	kid, ok := token.Header["kid"]
+	if !ok {
+		if nil == k.legacyKey {
+			return nil, errors.New("token kid is missing")
+		}
+		return k.legacyKey, nil
+	}
+
+	kidStr, ok := kid.(string)
+	if !ok {
+		return nil, errors.New("token kid is invalid")
+	}
+	key, ok := k.keys[kidStr]
+	if !ok {
+		return nil, errors.New("unknown token kid: " + kidStr)
+	}
+	return key, nil
+}
@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	jwt2 "github.com/golang-jwt/jwt/v4"
+)
+
+var ErrTokenRevoked = errors.Unauthorized("UNAUTHORIZED", "Token has been revoked")
+
+// RevokeChecker 判断 jti 对应的 token 是否已注销
+type RevokeChecker func(ctx context.Context, jti string) (bool, error)
+
+// Revoke 拒绝已注销或没有 jti 的 token，需放在 jwt.Server 之后
+func Revoke(checker RevokeChecker) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			claims, ok := jwt.FromContext(ctx)
+			if !ok {
+				return nil, jwt.ErrMissingJwtToken
+			}
+
+			c, ok := claims.(jwt2.MapClaims)
+			if !ok {
+				return nil, jwt.ErrTokenInvalid
+			}
+
+			jti, _ := c["jti"].(string)
+			if "" == jti {
+				return nil, jwt.ErrTokenInvalid
+			}
+
+			revoked, err := checker(ctx, jti)
+			if nil != err {
+				return nil, err
+			}
+			if revoked {
+				return nil, ErrTokenRevoked
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package server
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport"
+	jwt2 "github.com/golang-jwt/jwt/v4"
+	"strings"
+)
+
+// adminPermissions 后台接口需要的权限，未列出的 Admin 接口一律拒绝
+var adminPermissions = map[string]string{
+	"/api.App/Deposit":                     biz.PermissionDepositSync,
+	"/api.App/AdminRewardList":             biz.PermissionAdminRead,
+	"/api.App/AdminUserList":               biz.PermissionAdminRead,
+	"/api.App/AdminLocationList":           biz.PermissionAdminRead,
+	"/api.App/AdminWithdrawList":           biz.PermissionAdminRead,
+	"/api.App/AdminAll":                    biz.PermissionAdminRead,
+	"/api.App/AdminUserRecommend":          biz.PermissionAdminRead,
+	"/api.App/AdminMonthRecommend":         biz.PermissionAdminRead,
+	"/api.App/AdminConfig":                 biz.PermissionAdminRead,
+	"/api.App/AdminConfigUpdate":           biz.PermissionAdminConfig,
+	"/api.App/AdminWithdraw":               biz.PermissionWithdrawPay,
+	"/api.App/AdminWithdrawEth":            biz.PermissionWithdrawPay,
+	"/api.App/AdminWithdrawReceipt":        biz.PermissionWithdrawPay,
+	"/api.App/AdminWithdrawUpdate":         biz.PermissionWithdrawPay,
+	"/api.App/AdminWithdrawEventList":      biz.PermissionAdminRead,
+	"/api.App/AdminFee":                    biz.PermissionFeePay,
+	"/api.App/AdminJobRunList":             biz.PermissionAdminRead,
+	"/api.App/AdminDepositReorgList":       biz.PermissionAdminRead,
+	"/api.App/AdminDepositTierList":        biz.PermissionAdminRead,
+	"/api.App/AdminDepositTierSave":        biz.PermissionAdminConfig,
+	"/api.App/AdminDepositUnmatchedList":   biz.PermissionAdminRead,
+	"/api.App/AdminDepositUnmatchedHandle": biz.PermissionDepositFix,
+	"/api.App/AdminDepositSweep":           biz.PermissionWithdrawPay,
+}
+
+var (
+	ErrAdminForbidden = errors.Forbidden("ADMIN_FORBIDDEN", "无权限")
+	ErrUserForbidden  = errors.Forbidden("USER_FORBIDDEN", "无权限")
+)
+
+func isAdminOperation(operation string) bool {
+	if _, ok := adminPermissions[operation]; ok {
+		return true
+	}
+	return strings.HasPrefix(operation, "/api.App/Admin")
+}
+
+// NewAdminMatcher 需要后台权限的接口
+func NewAdminMatcher() selector.MatchFunc {
+	whiteListMatcher := NewWhiteListMatcher()
+	return func(ctx context.Context, operation string) bool {
+		return whiteListMatcher(ctx, operation) && isAdminOperation(operation)
+	}
+}
+
+// NewUserMatcher 普通用户接口，只接受钱包登录的 token
+func NewUserMatcher() selector.MatchFunc {
+	whiteListMatcher := NewWhiteListMatcher()
+	return func(ctx context.Context, operation string) bool {
+		return whiteListMatcher(ctx, operation) && !isAdminOperation(operation) && !isMachineOperation(operation)
+	}
+}
+
+// AdminPermission 校验 admin token 及其角色是否拥有接口所需权限，需放在 jwt.Server 之后
+func AdminPermission(adc *biz.AdminUseCase) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, ErrAdminForbidden
+			}
+			permission, ok := adminPermissions[tr.Operation()]
+			if !ok {
+				return nil, ErrAdminForbidden
+			}
+
+			userType, userId := claimsUser(ctx)
+			if biz.AuthUserTypeAdmin != userType || 0 >= userId {
+				return nil, ErrAdminForbidden
+			}
+
+			if err := adc.CheckPermission(ctx, userId, permission); nil != err {
+				return nil, err
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+// UserOnly 拒绝 admin token 访问用户接口
+func UserOnly() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if userType, _ := claimsUser(ctx); biz.AuthUserTypeUser != userType {
+				return nil, ErrUserForbidden
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+func claimsUser(ctx context.Context) (string, int64) {
+	claims, ok := jwt.FromContext(ctx)
+	if !ok {
+		return "", 0
+	}
+	c, ok := claims.(jwt2.MapClaims)
+	if !ok {
+		return "", 0
+	}
+
+	userType, _ := c["UserType"].(string)
+	userId, _ := c["UserId"].(float64)
+	return userType, int64(userId)
+}
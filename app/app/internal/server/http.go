@@ -3,8 +3,11 @@ package server
 import (
 	"context"
 	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/biz"
 	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/pkg/middleware/auth"
 	"dhb/app/app/internal/service"
+	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/selector"
@@ -16,21 +19,29 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, app *service.AppService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, app *service.AppService, auc *biz.AuthUseCase, adc *biz.AdminUseCase, keyRing *auth.KeyRing, logger log.Logger) *http.Server {
+	// jwt 验证，按 kid 选择密钥并拒绝已注销的 token
+	jwtAuth := middleware.Chain(
+		jwt.Server(keyRing.KeyFunc, jwt.WithSigningMethod(jwt2.SigningMethodHS256)),
+		auth.Revoke(auc.IsTokenRevoked),
+	)
+
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
-			selector.Server( // jwt 验证
-				jwt.Server(func(token *jwt2.Token) (interface{}, error) {
-					return []byte("ebb0a1577eaebbad70115c472602da54"), nil
-				}, jwt.WithSigningMethod(jwt2.SigningMethodHS256)),
-			).Match(NewWhiteListMatcher()).Build(),
+			// 后台接口：定时任务使用服务签名，否则校验 admin token 及角色权限
+			selector.Server(MachineOr(auc, middleware.Chain(jwtAuth, AdminPermission(adc)))).Match(NewAdminMatcher()).Build(),
+			// 用户接口只接受钱包登录的 token
+			selector.Server(jwtAuth, UserOnly()).Match(NewUserMatcher()).Build(),
+			// 索引服务推送等只接受服务签名的接口
+			selector.Server(MachineOnly(auc)).Match(NewMachineMatcher()).Build(),
 		),
 		http.Filter(handlers.CORS(
 			handlers.AllowedHeaders([]string{"X-Requested-With", "Content-Type", "Authorization"}),
 			handlers.AllowedMethods([]string{"GET", "POST", "PUT", "HEAD", "OPTIONS"}),
 			handlers.AllowedOrigins([]string{"*"}),
 		)),
+		http.Filter(MachineBodyFilter),
 	}
 	if c.Http.Network != "" {
 		opts = append(opts, http.Network(c.Http.Network))
@@ -50,6 +61,10 @@ func NewHTTPServer(c *conf.Server, app *service.AppService, logger log.Logger) *
 func NewWhiteListMatcher() selector.MatchFunc {
 	whiteList := make(map[string]struct{})
 	whiteList["/api.App/EthAuthorize"] = struct{}{}
+	whiteList["/api.App/GetAuthNonce"] = struct{}{}
+	whiteList["/api.App/RefreshToken"] = struct{}{}
+	whiteList["/api.App/AdminLogin"] = struct{}{}
+	whiteList["/api.App/ChainList"] = struct{}{}
 	//whiteList["/api.App/Deposit"] = struct{}{}
 	//whiteList["/api.App/AdminLocationList"] = struct{}{}
 	//whiteList["/api.App/AdminRewardList"] = struct{}{}
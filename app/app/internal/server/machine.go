@@ -0,0 +1,132 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"dhb/app/app/internal/biz"
+	"encoding/hex"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"io"
+	nethttp "net/http"
+)
+
+const (
+	MachineKeyIdHeader     = "X-Key-Id"
+	MachineTimestampHeader = "X-Timestamp"
+	MachineSignatureHeader = "X-Signature"
+
+	machineBodyMax = 1 << 20
+)
+
+var ErrMachineUnauthorized = errors.Unauthorized("MACHINE_UNAUTHORIZED", "服务签名校验失败")
+
+// machineOperations 只接受服务签名的接口，如索引服务推送充值
+var machineOperations = map[string]bool{
+	"/api.App/DepositWebhook": true,
+}
+
+func isMachineOperation(operation string) bool {
+	return machineOperations[operation]
+}
+
+// NewMachineMatcher 只接受服务签名的接口
+func NewMachineMatcher() selector.MatchFunc {
+	return func(ctx context.Context, operation string) bool {
+		return isMachineOperation(operation)
+	}
+}
+
+// 请求体在绑定参数时会被读取，由 MachineBodyFilter 提前计算 hash 放入上下文
+type machineBodyHashKey struct{}
+
+// MachineBodyFilter 带服务密钥的请求先读取请求体计算 sha256，再放回请求体；超过 machineBodyMax 的返回 413
+func MachineBodyFilter(next nethttp.Handler) nethttp.Handler {
+	return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if "" != r.Header.Get(MachineKeyIdHeader) {
+			var body []byte
+			if nil != r.Body {
+				var err error
+				body, err = io.ReadAll(io.LimitReader(r.Body, machineBodyMax+1))
+				if nil != err {
+					nethttp.Error(w, "read body failed", nethttp.StatusBadRequest)
+					return
+				}
+				if machineBodyMax < len(body) {
+					nethttp.Error(w, "request body too large", nethttp.StatusRequestEntityTooLarge)
+					return
+				}
+				_ = r.Body.Close()
+				r.Body = io.NopCloser(bytes.NewReader(body))
+			}
+
+			h := sha256.Sum256(body)
+			r = r.WithContext(context.WithValue(r.Context(), machineBodyHashKey{}, hex.EncodeToString(h[:])))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// MachineOr 请求带服务密钥时按服务签名校验，否则交给 next(用户 token 校验)
+func MachineOr(auc *biz.AuthUseCase, next middleware.Middleware) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		nextHandler := next(handler)
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok || "" == tr.RequestHeader().Get(MachineKeyIdHeader) {
+				return nextHandler(ctx, req)
+			}
+
+			if err := verifyMachine(ctx, auc, tr); nil != err {
+				return nil, err
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+// MachineOnly 只接受服务签名，不带服务密钥的请求一律拒绝
+func MachineOnly(auc *biz.AuthUseCase) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok || "" == tr.RequestHeader().Get(MachineKeyIdHeader) {
+				return nil, ErrMachineUnauthorized
+			}
+
+			if err := verifyMachine(ctx, auc, tr); nil != err {
+				return nil, err
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+func verifyMachine(ctx context.Context, auc *biz.AuthUseCase, tr transport.Transporter) error {
+	ht, ok := tr.(http.Transporter)
+	if !ok {
+		return ErrMachineUnauthorized
+	}
+	bodyHash, ok := ctx.Value(machineBodyHashKey{}).(string)
+	if !ok {
+		return ErrMachineUnauthorized
+	}
+
+	r := ht.Request()
+	return auc.VerifyMachineRequest(ctx, &biz.MachineRequest{
+		KeyId:     tr.RequestHeader().Get(MachineKeyIdHeader),
+		Timestamp: tr.RequestHeader().Get(MachineTimestampHeader),
+		Signature: tr.RequestHeader().Get(MachineSignatureHeader),
+		Method:    r.Method,
+		Path:      r.URL.RequestURI(),
+		BodyHash:  bodyHash,
+		Operation: tr.Operation(),
+	})
+}
@@ -0,0 +1,175 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"encoding/hex"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testMachineKeyId  = "indexer"
+	testMachineSecret = "test-secret"
+	testWebhookPath   = "/api/deposit_webhook"
+)
+
+// fakeAuthRepo 只记录已使用的服务签名
+type fakeAuthRepo struct {
+	biz.AuthRepo
+	mu   sync.Mutex
+	used map[string]bool
+}
+
+func (f *fakeAuthRepo) UseMachineSignature(ctx context.Context, keyId string, signature string, expire time.Duration) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.used[keyId+":"+signature] {
+		return false, nil
+	}
+	f.used[keyId+":"+signature] = true
+	return true, nil
+}
+
+// fakeWebhookService 签名校验通过后才会被调用
+type fakeWebhookService struct {
+	v1.AppHTTPServer
+	mu    sync.Mutex
+	calls int
+}
+
+func (f *fakeWebhookService) DepositWebhook(ctx context.Context, req *v1.DepositWebhookRequest) (*v1.DepositWebhookReply, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	return &v1.DepositWebhookReply{}, nil
+}
+
+func newWebhookServer(t *testing.T) (*httptest.Server, *fakeWebhookService) {
+	t.Setenv("TEST_MACHINE_SECRET", testMachineSecret)
+	auc, err := biz.NewAuthUseCase(&fakeAuthRepo{used: make(map[string]bool)}, &conf.Auth{
+		MachineKeys: []*conf.Auth_MachineKey{
+			{KeyId: testMachineKeyId, SecretEnv: "TEST_MACHINE_SECRET", Operations: []string{v1.OperationAppDepositWebhook}},
+		},
+	}, nil, log.DefaultLogger)
+	if nil != err {
+		t.Fatalf("new auth usecase: %v", err)
+	}
+
+	svc := &fakeWebhookService{}
+	srv := http.NewServer(
+		http.Middleware(selector.Server(MachineOnly(auc)).Match(NewMachineMatcher()).Build()),
+		http.Filter(MachineBodyFilter),
+	)
+	v1.RegisterAppHTTPServer(srv, svc)
+
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+	return ts, svc
+}
+
+func signWebhook(secret string, timestamp string, body []byte) string {
+	h := sha256.Sum256(body)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(biz.MachineSignPayload(nethttp.MethodPost, testWebhookPath, timestamp, hex.EncodeToString(h[:]))))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func postWebhook(t *testing.T, url string, timestamp string, signature string, body []byte) int {
+	req, err := nethttp.NewRequest(nethttp.MethodPost, url+testWebhookPath, bytes.NewReader(body))
+	if nil != err {
+		t.Fatalf("new request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(MachineKeyIdHeader, testMachineKeyId)
+	req.Header.Set(MachineTimestampHeader, timestamp)
+	req.Header.Set(MachineSignatureHeader, signature)
+
+	res, err := nethttp.DefaultClient.Do(req)
+	if nil != err {
+		t.Fatalf("post webhook: %v", err)
+	}
+	_ = res.Body.Close()
+	return res.StatusCode
+}
+
+func TestWebhookSignature(t *testing.T) {
+	ts, svc := newWebhookServer(t)
+	var (
+		body      = []byte(`{"send_body":{"chain_id":56,"transfers":[]}}`)
+		timestamp = strconv.FormatInt(time.Now().Unix(), 10)
+	)
+
+	if code := postWebhook(t, ts.URL, timestamp, signWebhook("wrong-secret", timestamp, body), body); nethttp.StatusUnauthorized != code {
+		t.Errorf("wrong secret: status %d, want 401", code)
+	}
+
+	// 签名后修改请求体
+	signature := signWebhook(testMachineSecret, timestamp, body)
+	if code := postWebhook(t, ts.URL, timestamp, signature, []byte(`{"send_body":{"chain_id":97,"transfers":[]}}`)); nethttp.StatusUnauthorized != code {
+		t.Errorf("tampered body: status %d, want 401", code)
+	}
+
+	expired := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+	if code := postWebhook(t, ts.URL, expired, signWebhook(testMachineSecret, expired, body), body); nethttp.StatusUnauthorized != code {
+		t.Errorf("expired timestamp: status %d, want 401", code)
+	}
+
+	if code := postWebhook(t, ts.URL, timestamp, signature, body); nethttp.StatusOK != code {
+		t.Fatalf("valid signature: status %d, want 200", code)
+	}
+	if 1 != svc.calls {
+		t.Fatalf("webhook calls = %d, want 1", svc.calls)
+	}
+}
+
+func TestWebhookReplay(t *testing.T) {
+	ts, svc := newWebhookServer(t)
+	var (
+		body      = []byte(`{"send_body":{"chain_id":56,"transfers":[]}}`)
+		timestamp = strconv.FormatInt(time.Now().Unix(), 10)
+		signature = signWebhook(testMachineSecret, timestamp, body)
+	)
+
+	if code := postWebhook(t, ts.URL, timestamp, signature, body); nethttp.StatusOK != code {
+		t.Fatalf("first request: status %d, want 200", code)
+	}
+	if code := postWebhook(t, ts.URL, timestamp, signature, body); nethttp.StatusUnauthorized != code {
+		t.Errorf("replayed request: status %d, want 401", code)
+	}
+	// 大写的同一签名也视为重放
+	if code := postWebhook(t, ts.URL, timestamp, strings.ToUpper(signature), body); nethttp.StatusUnauthorized != code {
+		t.Errorf("replayed upper case signature: status %d, want 401", code)
+	}
+	if 1 != svc.calls {
+		t.Errorf("webhook calls = %d, want 1", svc.calls)
+	}
+}
+
+func TestWebhookBodyTooLarge(t *testing.T) {
+	ts, svc := newWebhookServer(t)
+	var (
+		body      = bytes.Repeat([]byte(" "), machineBodyMax+1)
+		timestamp = strconv.FormatInt(time.Now().Unix(), 10)
+	)
+
+	if code := postWebhook(t, ts.URL, timestamp, signWebhook(testMachineSecret, timestamp, body), body); nethttp.StatusRequestEntityTooLarge != code {
+		t.Errorf("large body: status %d, want 413", code)
+	}
+	if 0 != svc.calls {
+		t.Errorf("webhook calls = %d, want 0", svc.calls)
+	}
+}
@@ -0,0 +1,172 @@
+package server
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/service"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"sync"
+	"time"
+)
+
+type schedulerJob struct {
+	name     string
+	interval time.Duration
+	next     time.Time
+	run      func(ctx context.Context) (int64, error)
+}
+
+// Scheduler 进程内定时任务，作为 kratos.Server 随应用启停；
+// 任务在同一个协程中依次执行，并通过 JobUseCase 按互斥域加锁与其他实例、接口触发互斥
+type Scheduler struct {
+	jobs     []*schedulerJob
+	log      *log.Helper
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewScheduler 按 conf.Scheduler 注册启用的任务
+func NewScheduler(c *conf.Scheduler, app *service.AppService, logger log.Logger) *Scheduler {
+	registry := map[string]func(ctx context.Context) (int64, error){
+		biz.JobDeposit: func(ctx context.Context) (int64, error) {
+			res, err := app.Deposit(ctx, &v1.DepositRequest{})
+			if nil != err {
+				return 0, err
+			}
+			return res.Count, nil
+		},
+		biz.JobWithdraw: func(ctx context.Context) (int64, error) {
+			res, err := app.AdminWithdraw(ctx, &v1.AdminWithdrawRequest{})
+			if nil != err {
+				return 0, err
+			}
+			return res.Count, nil
+		},
+		biz.JobWithdrawEth: func(ctx context.Context) (int64, error) {
+			res, err := app.AdminWithdrawEth(ctx, &v1.AdminWithdrawEthRequest{})
+			if nil != err {
+				return 0, err
+			}
+			return res.Count, nil
+		},
+		biz.JobWithdrawReceipt: func(ctx context.Context) (int64, error) {
+			res, err := app.AdminWithdrawReceipt(ctx, &v1.AdminWithdrawReceiptRequest{})
+			if nil != err {
+				return 0, err
+			}
+			return res.Count, nil
+		},
+		biz.JobFee: func(ctx context.Context) (int64, error) {
+			res, err := app.AdminFee(ctx, &v1.AdminFeeRequest{})
+			if nil != err {
+				return 0, err
+			}
+			return res.Count, nil
+		},
+		biz.JobDepositSweep: func(ctx context.Context) (int64, error) {
+			res, err := app.AdminDepositSweep(ctx, &v1.AdminDepositSweepRequest{})
+			if nil != err {
+				return 0, err
+			}
+			return res.Count, nil
+		},
+	}
+
+	s := &Scheduler{
+		log:  log.NewHelper(logger),
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	for _, v := range c.GetJobs() {
+		if !v.Enabled {
+			continue
+		}
+		run, ok := registry[v.Name]
+		if !ok {
+			s.log.Errorf("scheduler: unknown job %s", v.Name)
+			continue
+		}
+		if 0 >= v.Interval.AsDuration() {
+			s.log.Errorf("scheduler: job %s interval must be positive", v.Name)
+			continue
+		}
+		s.jobs = append(s.jobs, &schedulerJob{
+			name:     v.Name,
+			interval: v.Interval.AsDuration(),
+			run:      run,
+		})
+	}
+
+	return s
+}
+
+// Start 阻塞执行直到 Stop
+func (s *Scheduler) Start(ctx context.Context) error {
+	defer close(s.done)
+
+	now := time.Now()
+	for _, j := range s.jobs {
+		j.next = now.Add(j.interval)
+	}
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			return nil
+		case <-ticker.C:
+			for _, j := range s.jobs {
+				select {
+				case <-s.stop:
+					return nil
+				default:
+				}
+
+				if time.Now().Before(j.next) {
+					continue
+				}
+				s.runJob(j)
+				j.next = time.Now().Add(j.interval)
+			}
+		}
+	}
+}
+
+// Stop 等待正在执行的任务结束
+func (s *Scheduler) Stop(ctx context.Context) error {
+	s.stopOnce.Do(func() {
+		close(s.stop)
+	})
+
+	select {
+	case <-s.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Scheduler) runJob(j *schedulerJob) {
+	defer func() {
+		if r := recover(); nil != r {
+			s.log.Errorf("scheduler: job %s panic: %v", j.name, r)
+		}
+	}()
+
+	start := time.Now()
+	items, err := j.run(context.Background())
+	if nil != err {
+		if errors.Is(err, biz.ErrJobRunning) {
+			s.log.Infof("scheduler: job %s skipped, other job running", j.name)
+			return
+		}
+		s.log.Errorf("scheduler: job %s failed: %v", j.name, err)
+		return
+	}
+	s.log.Infof("scheduler: job %s done, items=%d, cost=%s", j.name, items, time.Since(start))
+}
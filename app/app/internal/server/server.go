@@ -1,8 +1,9 @@
 package server
 
 import (
+	"dhb/app/app/internal/pkg/middleware/auth"
 	"github.com/google/wire"
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer)
+var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewScheduler, auth.NewKeyRing)
@@ -2,29 +2,16 @@ package service
 
 import (
 	"context"
-	"crypto/ecdsa"
-	"dhb/app/app/internal/pkg/middleware/auth"
-	"encoding/json"
 	"fmt"
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/crypto/sha3"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
 	jwt2 "github.com/golang-jwt/jwt/v4"
-	"io"
 	"math/big"
-	"net/url"
-	"strconv"
 
 	v1 "dhb/app/app/api"
 	"dhb/app/app/internal/biz"
 	"dhb/app/app/internal/conf"
-	"io/ioutil"
-	"net/http"
 	"time"
 )
 
@@ -34,13 +21,33 @@ type AppService struct {
 
 	uuc *biz.UserUseCase
 	ruc *biz.RecordUseCase
+	auc *biz.AuthUseCase
+	adc *biz.AdminUseCase
+	juc *biz.JobUseCase
+	duc *biz.DepositUseCase
+	wuc *biz.WithdrawUseCase
 	log *log.Helper
 	ca  *conf.Auth
+	cs  biz.Chains
 }
 
 // NewAppService new a service.
-func NewAppService(uuc *biz.UserUseCase, ruc *biz.RecordUseCase, logger log.Logger, ca *conf.Auth) *AppService {
-	return &AppService{uuc: uuc, ruc: ruc, log: log.NewHelper(logger), ca: ca}
+func NewAppService(uuc *biz.UserUseCase, ruc *biz.RecordUseCase, auc *biz.AuthUseCase, adc *biz.AdminUseCase, juc *biz.JobUseCase, duc *biz.DepositUseCase, wuc *biz.WithdrawUseCase, logger log.Logger, ca *conf.Auth, cs biz.Chains) *AppService {
+	return &AppService{uuc: uuc, ruc: ruc, auc: auc, adc: adc, juc: juc, duc: duc, wuc: wuc, log: log.NewHelper(logger), ca: ca, cs: cs}
+}
+
+// GetAuthNonce 获取钱包登录签名用的一次性随机数
+func (a *AppService) GetAuthNonce(ctx context.Context, req *v1.GetAuthNonceRequest) (*v1.GetAuthNonceReply, error) {
+	authNonce, err := a.auc.GetAuthNonce(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetAuthNonceReply{
+		Nonce:     authNonce.Nonce,
+		Message:   authNonce.Message,
+		ExpiresAt: authNonce.ExpiresAt.Unix(),
+	}, nil
 }
 
 // EthAuthorize ethAuthorize.
@@ -51,7 +58,19 @@ func (a *AppService) EthAuthorize(ctx context.Context, req *v1.EthAuthorizeReque
 		return nil, errors.New(500, "AUTHORIZE_ERROR", "账户地址参数错误")
 	}
 
-	// TODO 验证签名
+	// 验证签名，优先使用 SIWE 消息
+	var signMessage string
+	if "" != req.SendBody.Message {
+		if _, err := a.auc.VerifySiwe(ctx, userAddress, req.SendBody.Message, req.SendBody.Sign); err != nil {
+			return nil, err
+		}
+		signMessage = req.SendBody.Message
+	} else {
+		if err := a.auc.VerifyAuthSign(ctx, userAddress, req.SendBody.Nonce, req.SendBody.Sign); err != nil {
+			return nil, err
+		}
+		signMessage = biz.AuthMessage(userAddress, req.SendBody.Nonce)
+	}
 
 	// 根据地址查询用户，不存在时则创建
 	user, err := a.uuc.GetExistUserByAddressOrCreate(ctx, &biz.User{
@@ -61,245 +80,94 @@ func (a *AppService) EthAuthorize(ctx context.Context, req *v1.EthAuthorizeReque
 		return nil, err
 	}
 
-	claims := auth.CustomClaims{
-		UserId:   user.ID,
-		UserType: "user",
-		StandardClaims: jwt2.StandardClaims{
-			NotBefore: time.Now().Unix(),              // 签名的生效时间
-			ExpiresAt: time.Now().Unix() + 60*60*24*7, // 7天过期
-			Issuer:    "DHB",
-		},
-	}
-	token, err := auth.CreateToken(claims, a.ca.JwtKey)
+	authToken, err := a.auc.IssueToken(ctx, user.ID)
 	if err != nil {
-		return nil, errors.New(500, "AUTHORIZE_ERROR", "生成token失败")
+		return nil, err
+	}
+
+	// 审计记录：接受的签名消息和签发的token
+	if _, err = a.auc.CreateAuthRecord(ctx, &biz.AuthRecord{
+		UserId:    user.ID,
+		Address:   userAddress,
+		Message:   signMessage,
+		TokenHash: biz.TokenHash(authToken.Token),
+		ExpiresAt: authToken.ExpiresAt,
+	}); err != nil {
+		return nil, err
 	}
 
 	userInfoRsp := v1.EthAuthorizeReply{
-		Token: token,
+		Token:        authToken.Token,
+		RefreshToken: authToken.RefreshToken,
 	}
 	return &userInfoRsp, nil
 }
 
-// Deposit deposit.
-func (a *AppService) Deposit(ctx context.Context, req *v1.DepositRequest) (*v1.DepositReply, error) {
-
-	var (
-		depositUsdtResult map[string]*eth
-		//depositDhbResult      map[string]*eth
-		//tmpDepositDhbResult   map[string]*eth
-		//userDepositDhbResult  map[string]map[string]*eth
-		notExistDepositResult []*biz.EthUserRecord
-		existEthUserRecords   map[string]*biz.EthUserRecord
-		depositUsers          map[string]*biz.User
-		fromAccount           []string
-		hashKeys              []string
-		//lock                  bool
-		err error
-		//configs               []*biz.Config
-		//level1Dhb             string
-		//level2Dhb             string
-		//level3Dhb             string
-	)
-
-	// 配置
-	//configs, err = a.uuc.GetDhbConfig(ctx)
-	//if nil != configs {
-	//	for _, vConfig := range configs {
-	//		if "level1Dhb" == vConfig.KeyName {
-	//			level1Dhb = vConfig.Value + "0000000000000000"
-	//		} else if "level2Dhb" == vConfig.KeyName {
-	//			level2Dhb = vConfig.Value + "0000000000000000"
-	//		} else if "level3Dhb" == vConfig.KeyName {
-	//			level3Dhb = vConfig.Value + "0000000000000000"
-	//		}
-	//	}
-	//}
-
-	//if lock, _ = a.ruc.LockEthUserRecordHandle(ctx); !lock { // 上全局锁简单，防止资源更新抢占
-	//	return &v1.DepositReply{}, nil
-	//}
-
-	// 每次一共最多查2000条，所以注意好外层调用的定时查询的时间设置，当然都可以重新定义，
-	// 在功能上调用者查询两种币的交易记录，每次都要把数据覆盖查询，是一个较大范围的查找防止遗漏数据，范围最起码要大于实际这段时间的入单量，不能边界查询容易掉单，这样的实现是因为简单
-	for i := 1; i <= 10; i++ {
-
-		depositUsdtResult, err = requestEthDepositResult(200, int64(i), "0x55d398326f99059fF775485246999027B3197955")
-		// 辅助查询
-		//depositDhbResult, err = requestEthDepositResult(200, int64(i), "0x96BD81715c69eE013405B4005Ba97eA1f420fd87")
-		//tmpDepositDhbResult, err = requestEthDepositResult(100, int64(i+1), "0x96BD81715c69eE013405B4005Ba97eA1f420fd87")
-		//for kTmpDepositDhbResult, v := range tmpDepositDhbResult {
-		//	if _, ok := tmpDepositDhbResult[kTmpDepositDhbResult]; !ok {
-		//		depositDhbResult[kTmpDepositDhbResult] = v
-		//	}
-		//}
-
-		if 0 >= len(depositUsdtResult) {
-			break
-		}
-
-		for hashKey, vDepositResult := range depositUsdtResult { // 主查询
-			hashKeys = append(hashKeys, hashKey)
-			fromAccount = append(fromAccount, vDepositResult.From)
-		}
-		//userDepositDhbResult = make(map[string]map[string]*eth, 0) // 辅助数据
-		//for k, v := range depositDhbResult {
-		//	hashKeys = append(hashKeys, k)
-		//	fromAccount = append(fromAccount, v.From)
-		//	if _, ok := userDepositDhbResult[v.From]; !ok {
-		//		userDepositDhbResult[v.From] = make(map[string]*eth, 0)
-		//	}
-		//	userDepositDhbResult[v.From][k] = v
-		//}
-
-		depositUsers, err = a.uuc.GetUserByAddress(ctx, fromAccount...)
-		if nil != err || nil == depositUsers {
-			continue
-		}
-		existEthUserRecords, err = a.ruc.GetEthUserRecordByTxHash(ctx, hashKeys...)
-		// 统计开始
-		notExistDepositResult = make([]*biz.EthUserRecord, 0)
-		for _, vDepositUsdtResult := range depositUsdtResult { // 主查usdt
-			if _, ok := existEthUserRecords[vDepositUsdtResult.Hash]; ok { // 记录已存在
-				continue
-			}
-			if _, ok := depositUsers[vDepositUsdtResult.From]; !ok { // 用户不存在
-				continue
-			}
-			//if _, ok := userDepositDhbResult[vDepositUsdtResult.From]; !ok { // 没有dhb的充值记录
-			//	continue
-			//}
-			//var (
-			//	tmpDhbHash, tmpDhbHashValue string
-			//)
-
-			//tmpPass := false
-			//for _, vUserDepositDhbResult := range userDepositDhbResult[vDepositUsdtResult.From] { // 充值数额类型匹配
-			//	if _, ok := existEthUserRecords[vUserDepositDhbResult.Hash]; ok { // 记录已存在
-			//		continue
-			//	}
-			//
-			//	if "10000000000000000" == vDepositUsdtResult.Value {
-			//		tmpPass = true
-			//	} else if "20000000000000000" == vDepositUsdtResult.Value {
-			//		tmpPass = true
-			//	} else if "50000000000000000" == vDepositUsdtResult.Value {
-			//		tmpPass = true
-			//	} else {
-			//		continue
-			//	}
-			//
-			//	tmpDhbHash = vUserDepositDhbResult.Hash
-			//	tmpDhbHashValue = vUserDepositDhbResult.Value
-			//}
-			//if !tmpPass {
-			//	continue
-			//}
-
-			if "100000000000000000000" == vDepositUsdtResult.Value {
-
-			} else if "200000000000000000000" == vDepositUsdtResult.Value {
-
-			} else if "500000000000000000000" == vDepositUsdtResult.Value {
-
-			} else {
-				continue
-			}
-
-			notExistDepositResult = append(notExistDepositResult, &biz.EthUserRecord{ // 两种币的记录
-				UserId:   depositUsers[vDepositUsdtResult.From].ID,
-				Hash:     vDepositUsdtResult.Hash,
-				Status:   "success",
-				Type:     "deposit",
-				Amount:   vDepositUsdtResult.Value,
-				CoinType: "USDT",
-			})
-			//&biz.EthUserRecord{
-			//	UserId:   depositUsers[vDepositUsdtResult.From].ID,
-			//	Hash:     tmpDhbHash,
-			//	Status:   "success",
-			//	Type:     "deposit",
-			//	Amount:   tmpDhbHashValue,
-			//	CoinType: "DHB",
-			//}
-		}
-
-		_, err = a.ruc.EthUserRecordHandle(ctx, notExistDepositResult...)
-		if nil != err {
-			fmt.Println(err)
-		}
-
-		//time.Sleep(2 * time.Second)
+// RefreshToken 刷新令牌换取新的访问令牌
+func (a *AppService) RefreshToken(ctx context.Context, req *v1.RefreshTokenRequest) (*v1.RefreshTokenReply, error) {
+	if nil == req.SendBody {
+		return nil, errors.New(401, "REFRESH_TOKEN_INVALID", "刷新令牌无效")
 	}
 
-	//_, _ = a.ruc.UnLockEthUserRecordHandle(ctx)
-	return &v1.DepositReply{}, nil
-}
+	authToken, err := a.auc.RefreshToken(ctx, req.SendBody.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
 
-type eth struct {
-	Value       string
-	Hash        string
-	TokenSymbol string
-	From        string
-	To          string
+	return &v1.RefreshTokenReply{
+		Token:        authToken.Token,
+		RefreshToken: authToken.RefreshToken,
+	}, nil
 }
 
-func requestEthDepositResult(offset int64, page int64, contractAddress string) (map[string]*eth, error) {
-	//apiUrl := "https://api-testnet.bscscan.com/api"
-	apiUrl := "https://api.bscscan.com/api"
-	// URL param
-	data := url.Values{}
-	data.Set("module", "account")
-	data.Set("action", "tokentx")
-	data.Set("contractaddress", contractAddress)
-	data.Set("address", "0x636F2deAAb4C9A8F3c808D23F16f456009C4e9Fd")
-	data.Set("sort", "desc")
-	data.Set("offset", strconv.FormatInt(offset, 10))
-	data.Set("page", strconv.FormatInt(page, 10))
+// Logout 退出登录
+func (a *AppService) Logout(ctx context.Context, req *v1.LogoutRequest) (*v1.LogoutReply, error) {
+	var (
+		jti       string
+		sessionId string
+		expiresAt time.Time
+	)
 
-	u, err := url.ParseRequestURI(apiUrl)
-	if err != nil {
-		return nil, err
-	}
-	u.RawQuery = data.Encode() // URL encode
-	client := http.Client{
-		Timeout: 10 * time.Second,
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		jti, _ = c["jti"].(string)
+		sessionId, _ = c["SessionId"].(string)
+		if exp, ok := c["exp"].(float64); ok {
+			expiresAt = time.Unix(int64(exp), 0)
+		}
 	}
-	fmt.Println(u.String())
 
-	resp, err := client.Get(u.String())
-	if err != nil {
+	if err := a.auc.Logout(ctx, jti, sessionId, expiresAt); err != nil {
 		return nil, err
 	}
 
-	defer func(Body io.ReadCloser) {
-		err := Body.Close()
-		if err != nil {
+	return &v1.LogoutReply{}, nil
+}
 
-		}
-	}(resp.Body)
-	b, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
+// AdminLogin 后台登录
+func (a *AppService) AdminLogin(ctx context.Context, req *v1.AdminLoginRequest) (*v1.AdminLoginReply, error) {
+	if nil == req.SendBody {
+		return nil, errors.New(401, "ADMIN_LOGIN_ERROR", "账号或密码错误")
 	}
 
-	var i struct {
-		Message string `json:"message"`
-		Result  []*eth `json:"Result"`
-	}
-	err = json.Unmarshal(b, &i)
+	authToken, err := a.adc.AdminLogin(ctx, req.SendBody.Account, req.SendBody.Password)
 	if err != nil {
 		return nil, err
 	}
 
-	res := make(map[string]*eth, 0)
-	for _, v := range i.Result {
-		if "0x636F2deAAb4C9A8F3c808D23F16f456009C4e9Fd" == v.To { // 接收者
-			res[v.Hash] = v
-		}
+	return &v1.AdminLoginReply{
+		Token: authToken.Token,
+	}, nil
+}
+
+// Deposit deposit.
+func (a *AppService) Deposit(ctx context.Context, req *v1.DepositRequest) (*v1.DepositReply, error) {
+	count, err := a.juc.Run(ctx, biz.JobDeposit, a.duc.Scan)
+	if nil != err {
+		return nil, err
 	}
 
-	return res, err
+	return &v1.DepositReply{Count: count}, nil
 }
 
 // UserInfo userInfo.
@@ -384,6 +252,196 @@ func (a *AppService) WithdrawList(ctx context.Context, req *v1.WithdrawListReque
 	})
 }
 
+// DepositPendingList 未达到确认数的充值
+func (a *AppService) DepositPendingList(ctx context.Context, req *v1.DepositPendingListRequest) (*v1.DepositPendingListReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	pending, err := a.duc.GetDepositPendingList(ctx, userId)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.DepositPendingListReply{
+		Deposits: make([]*v1.DepositPendingListReply_List, 0),
+	}
+	for _, v := range pending {
+		res.Deposits = append(res.Deposits, &v1.DepositPendingListReply_List{
+			Hash:          v.Hash,
+			Amount:        depositAmount(v.Amount),
+			BlockNumber:   v.BlockNumber,
+			Confirmations: v.Confirmations,
+			Required:      a.duc.Confirmations(v.ChainId),
+			Status:        v.Status,
+			CreatedAt:     v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			CoinType:      v.CoinType,
+			ChainId:       v.ChainId,
+		})
+	}
+
+	return res, nil
+}
+
+// DepositUnmatchedList 未匹配档位的充值及处理结果
+func (a *AppService) DepositUnmatchedList(ctx context.Context, req *v1.DepositUnmatchedListRequest) (*v1.DepositUnmatchedListReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	records, err := a.duc.GetUnmatchedList(ctx, userId)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.DepositUnmatchedListReply{
+		Deposits: make([]*v1.DepositUnmatchedListReply_List, 0),
+	}
+	for _, v := range records {
+		res.Deposits = append(res.Deposits, &v1.DepositUnmatchedListReply_List{
+			Hash:      v.Hash,
+			Amount:    depositAmount(v.Amount),
+			Status:    v.Status,
+			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			CoinType:  v.CoinType,
+			ChainId:   v.ChainId,
+		})
+	}
+
+	return res, nil
+}
+
+// ChainList 支持充值和提现的链及代币
+func (a *AppService) ChainList(ctx context.Context, req *v1.ChainListRequest) (*v1.ChainListReply, error) {
+	res := &v1.ChainListReply{
+		Chains: make([]*v1.ChainListReply_List, 0, len(a.cs)),
+	}
+	for _, c := range a.cs {
+		chain := &v1.ChainListReply_List{
+			ChainId:       c.ChainId(),
+			Name:          c.Name(),
+			Tokens:        make([]*v1.ChainListReply_Token, 0),
+			Confirmations: c.Confirmations(),
+		}
+		for _, v := range c.Tokens() {
+			chain.Tokens = append(chain.Tokens, &v1.ChainListReply_Token{
+				Symbol:   v.Symbol,
+				Contract: v.Contract,
+				Decimals: v.Decimals,
+			})
+		}
+		res.Chains = append(res.Chains, chain)
+	}
+
+	return res, nil
+}
+
+// DepositAddress 用户的充值地址
+func (a *AppService) DepositAddress(ctx context.Context, req *v1.DepositAddressRequest) (*v1.DepositAddressReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	depositAddress, err := a.duc.GetDepositAddress(ctx, userId, req.ChainId)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.DepositAddressReply{
+		Address: depositAddress.Address,
+		Shared:  0 >= depositAddress.UserId,
+		ChainId: depositAddress.ChainId,
+	}, nil
+}
+
+// SubmitDepositTx 用户提交充值交易 hash 立即入账
+func (a *AppService) SubmitDepositTx(ctx context.Context, req *v1.SubmitDepositTxRequest) (*v1.SubmitDepositTxReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	var res *biz.DepositSubmitResult
+	_, err := a.juc.Run(ctx, biz.JobDepositSubmit, func(ctx context.Context) (int64, error) {
+		var err error
+		res, err = a.duc.SubmitDepositTx(ctx, userId, req.SendBody.ChainId, req.SendBody.Hash)
+		if nil != err {
+			return 0, err
+		}
+		return 1, nil
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.SubmitDepositTxReply{
+		Status:        res.Status,
+		CoinType:      res.CoinType,
+		Amount:        depositAmount(res.Amount),
+		Confirmations: res.Confirmations,
+		Required:      res.Required,
+	}, nil
+}
+
+// DepositWebhook 索引服务推送的充值转账，签名已在 MachineOnly 中校验
+func (a *AppService) DepositWebhook(ctx context.Context, req *v1.DepositWebhookRequest) (*v1.DepositWebhookReply, error) {
+	transfers := make([]*biz.DepositTransfer, 0, len(req.SendBody.Transfers))
+	for _, v := range req.SendBody.Transfers {
+		transfers = append(transfers, &biz.DepositTransfer{
+			Hash:        v.Hash,
+			From:        v.From,
+			To:          v.To,
+			Value:       v.Value,
+			Contract:    v.Contract,
+			BlockNumber: v.BlockNumber,
+			TxIndex:     v.TxIndex,
+			LogIndex:    v.LogIndex,
+		})
+	}
+
+	var res *biz.DepositWebhookResult
+	_, err := a.juc.Run(ctx, biz.JobDepositWebhook, func(ctx context.Context) (int64, error) {
+		var err error
+		res, err = a.duc.HandleWebhookTransfers(ctx, req.SendBody.ChainId, transfers)
+		if nil != err {
+			return 0, err
+		}
+		return res.Count, nil
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.DepositWebhookReply{
+		Count:   res.Count,
+		Pending: res.Pending,
+		Ignored: res.Ignored,
+	}, nil
+}
+
 // Withdraw withdraw.
 func (a *AppService) Withdraw(ctx context.Context, req *v1.WithdrawRequest) (*v1.WithdrawReply, error) {
 	// 在上下文 context 中取出 claims 对象
@@ -401,6 +459,46 @@ func (a *AppService) Withdraw(ctx context.Context, req *v1.WithdrawRequest) (*v1
 	})
 }
 
+// WithdrawCancel 取消还未处理的提现，金额退回余额
+func (a *AppService) WithdrawCancel(ctx context.Context, req *v1.WithdrawCancelRequest) (*v1.WithdrawCancelReply, error) {
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+	if 0 >= userId {
+		return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+	}
+
+	if err := a.wuc.Cancel(ctx, req.SendBody.GetId(), userId, withdrawActor(ctx)); nil != err {
+		return nil, err
+	}
+
+	return &v1.WithdrawCancelReply{}, nil
+}
+
+// withdrawActor 提现状态变化的操作人，user:ID 或 admin:ID，无 token 时为定时任务
+func withdrawActor(ctx context.Context) string {
+	claims, ok := jwt.FromContext(ctx)
+	if !ok {
+		return biz.WithdrawActorSystem
+	}
+	c, ok := claims.(jwt2.MapClaims)
+	if !ok {
+		return biz.WithdrawActorSystem
+	}
+
+	userType, _ := c["UserType"].(string)
+	userId, _ := c["UserId"].(float64)
+	if biz.AuthUserTypeAdmin == userType {
+		return fmt.Sprintf("admin:%d", int64(userId))
+	}
+	return fmt.Sprintf("user:%d", int64(userId))
+}
+
 func (a *AppService) AdminRewardList(ctx context.Context, req *v1.AdminRewardListRequest) (*v1.AdminRewardListReply, error) {
 	return a.uuc.AdminRewardList(ctx, req)
 }
@@ -418,11 +516,242 @@ func (a *AppService) AdminWithdrawList(ctx context.Context, req *v1.AdminWithdra
 }
 
 func (a *AppService) AdminWithdraw(ctx context.Context, req *v1.AdminWithdrawRequest) (*v1.AdminWithdrawReply, error) {
-	return a.uuc.AdminWithdraw(ctx, req)
+	count, err := a.juc.Run(ctx, biz.JobWithdraw, func(ctx context.Context) (int64, error) {
+		res, err := a.uuc.AdminWithdraw(ctx, req)
+		if nil != err {
+			return 0, err
+		}
+		return res.Count, nil
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminWithdrawReply{Count: count}, nil
+}
+
+// AdminWithdrawUpdate 拒绝提现、打款失败后重新打款或将打款中的提现标记为失败
+func (a *AppService) AdminWithdrawUpdate(ctx context.Context, req *v1.AdminWithdrawUpdateRequest) (*v1.AdminWithdrawUpdateReply, error) {
+	var (
+		id     = req.SendBody.GetId()
+		reason = req.SendBody.GetReason()
+		actor  = withdrawActor(ctx)
+		status string
+		err    error
+	)
+	switch req.SendBody.GetAction() {
+	case "reject":
+		status, err = biz.WithdrawStatusRejected, a.wuc.Reject(ctx, id, actor, reason)
+	case "retry":
+		status, err = biz.WithdrawStatusPass, a.wuc.Retry(ctx, id, actor, reason)
+	case "fail":
+		status, err = biz.WithdrawStatusFailed, a.wuc.Fail(ctx, id, actor, reason)
+	default:
+		return nil, errors.New(500, "WITHDRAW_ACTION_ERROR", "不支持的操作")
+	}
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminWithdrawUpdateReply{Status: status}, nil
+}
+
+// AdminWithdrawEventList 提现的状态变化记录
+func (a *AppService) AdminWithdrawEventList(ctx context.Context, req *v1.AdminWithdrawEventListRequest) (*v1.AdminWithdrawEventListReply, error) {
+	events, err := a.wuc.GetWithdrawEvents(ctx, req.Id)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminWithdrawEventListReply{Events: make([]*v1.AdminWithdrawEventListReply_List, 0, len(events))}
+	for _, v := range events {
+		res.Events = append(res.Events, &v1.AdminWithdrawEventListReply_List{
+			Id:         v.ID,
+			FromStatus: v.FromStatus,
+			ToStatus:   v.ToStatus,
+			Actor:      v.Actor,
+			Reason:     v.Reason,
+			CreatedAt:  v.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
 }
 
 func (a *AppService) AdminFee(ctx context.Context, req *v1.AdminFeeRequest) (*v1.AdminFeeReply, error) {
-	return a.uuc.AdminFee(ctx, req)
+	count, err := a.juc.Run(ctx, biz.JobFee, func(ctx context.Context) (int64, error) {
+		res, err := a.uuc.AdminFee(ctx, req)
+		if nil != err {
+			return 0, err
+		}
+		return res.Count, nil
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminFeeReply{Count: count}, nil
+}
+
+// AdminJobRunList 任务执行记录
+func (a *AppService) AdminJobRunList(ctx context.Context, req *v1.AdminJobRunListRequest) (*v1.AdminJobRunListReply, error) {
+	jobRuns, err, count := a.juc.GetJobRuns(ctx, req.Page, req.Name)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminJobRunListReply{
+		JobRun: make([]*v1.AdminJobRunListReply_List, 0),
+		Count:  count,
+	}
+	for _, v := range jobRuns {
+		var endedAt string
+		if !v.EndedAt.IsZero() {
+			endedAt = v.EndedAt.Format("2006-01-02 15:04:05")
+		}
+		res.JobRun = append(res.JobRun, &v1.AdminJobRunListReply_List{
+			Id:        v.ID,
+			Name:      v.Name,
+			Status:    v.Status,
+			StartedAt: v.StartedAt.Format("2006-01-02 15:04:05"),
+			EndedAt:   endedAt,
+			Items:     v.Items,
+			Error:     v.Error,
+		})
+	}
+
+	return res, nil
+}
+
+// AdminDepositReorgList 入账后被回滚、待审核的充值
+func (a *AppService) AdminDepositReorgList(ctx context.Context, req *v1.AdminDepositReorgListRequest) (*v1.AdminDepositReorgListReply, error) {
+	records, err, count := a.duc.GetReorgRecords(ctx, req.Page)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminDepositReorgListReply{
+		Records: make([]*v1.AdminDepositReorgListReply_List, 0),
+		Count:   count,
+	}
+	for _, v := range records {
+		res.Records = append(res.Records, &v1.AdminDepositReorgListReply_List{
+			Id:          v.ID,
+			UserId:      v.UserId,
+			Hash:        v.Hash,
+			Amount:      depositAmount(v.Amount),
+			BlockNumber: v.BlockNumber,
+			LocationId:  v.LocationId,
+			CreatedAt:   v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
+
+// AdminDepositUnmatchedList 未匹配档位的充值
+func (a *AppService) AdminDepositUnmatchedList(ctx context.Context, req *v1.AdminDepositUnmatchedListRequest) (*v1.AdminDepositUnmatchedListReply, error) {
+	records, err, count := a.duc.GetUnmatchedRecords(ctx, req.Page, req.Status)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminDepositUnmatchedListReply{
+		Records: make([]*v1.AdminDepositUnmatchedListReply_List, 0),
+		Count:   count,
+	}
+	for _, v := range records {
+		res.Records = append(res.Records, &v1.AdminDepositUnmatchedListReply_List{
+			Id:          v.ID,
+			UserId:      v.UserId,
+			Hash:        v.Hash,
+			Amount:      depositAmount(v.Amount),
+			BlockNumber: v.BlockNumber,
+			Status:      v.Status,
+			CreatedAt:   v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			Address:     v.Address,
+			CoinType:    v.CoinType,
+		})
+	}
+
+	return res, nil
+}
+
+// AdminDepositUnmatchedHandle 处理未匹配档位的充值，占位和入余额与充值任务互斥
+func (a *AppService) AdminDepositUnmatchedHandle(ctx context.Context, req *v1.AdminDepositUnmatchedHandleRequest) (*v1.AdminDepositUnmatchedHandleReply, error) {
+	actor := withdrawActor(ctx)
+	if _, err := a.juc.Run(ctx, biz.JobDepositUnmatched, func(ctx context.Context) (int64, error) {
+		return 1, a.duc.HandleUnmatched(ctx, req.SendBody.Id, req.SendBody.Action, actor)
+	}); nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminDepositUnmatchedHandleReply{}, nil
+}
+
+// AdminDepositTierList 充值档位
+func (a *AppService) AdminDepositTierList(ctx context.Context, req *v1.AdminDepositTierListRequest) (*v1.AdminDepositTierListReply, error) {
+	tiers, err := a.duc.GetDepositTiers(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminDepositTierListReply{
+		Tiers: make([]*v1.AdminDepositTierListReply_List, 0),
+	}
+	for _, v := range tiers {
+		res.Tiers = append(res.Tiers, &v1.AdminDepositTierListReply_List{
+			Id:         v.ID,
+			Token:      v.Token,
+			Amount:     v.Amount,
+			Level:      v.Level,
+			Multiplier: v.Multiplier,
+			Enabled:    v.Enabled,
+			PairToken:  v.PairToken,
+			PairAmount: v.PairAmount,
+		})
+	}
+
+	return res, nil
+}
+
+// AdminDepositTierSave 新增或修改充值档位，id 为 0 时新增
+func (a *AppService) AdminDepositTierSave(ctx context.Context, req *v1.AdminDepositTierSaveRequest) (*v1.AdminDepositTierSaveReply, error) {
+	tier, err := a.duc.SaveDepositTier(ctx, &biz.DepositTier{
+		ID:         req.SendBody.Id,
+		Token:      req.SendBody.Token,
+		Amount:     req.SendBody.Amount,
+		Level:      req.SendBody.Level,
+		Multiplier: req.SendBody.Multiplier,
+		Enabled:    req.SendBody.Enabled,
+		PairToken:  req.SendBody.PairToken,
+		PairAmount: req.SendBody.PairAmount,
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminDepositTierSaveReply{Id: tier.ID}, nil
+}
+
+// AdminDepositSweep 归集专属充值地址的代币
+func (a *AppService) AdminDepositSweep(ctx context.Context, req *v1.AdminDepositSweepRequest) (*v1.AdminDepositSweepReply, error) {
+	count, err := a.juc.Run(ctx, biz.JobDepositSweep, a.duc.Sweep)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminDepositSweepReply{Count: count}, nil
+}
+
+// depositAmount 链上 18 位精度的金额转为代币数量
+func depositAmount(value string) string {
+	amount, ok := new(big.Float).SetString(value)
+	if !ok {
+		return value
+	}
+
+	return amount.Quo(amount, big.NewFloat(1e18)).Text('f', 2)
 }
 
 func (a *AppService) AdminAll(ctx context.Context, req *v1.AdminAllRequest) (*v1.AdminAllReply, error) {
@@ -446,17 +775,38 @@ func (a *AppService) AdminConfigUpdate(ctx context.Context, req *v1.AdminConfigU
 }
 
 func (a *AppService) AdminWithdrawEth(ctx context.Context, req *v1.AdminWithdrawEthRequest) (*v1.AdminWithdrawEthReply, error) {
+	count, err := a.juc.Run(ctx, biz.JobWithdrawEth, a.withdrawEth)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminWithdrawEthReply{Count: count}, nil
+}
+
+// AdminWithdrawReceipt 查询已广播打款交易的回执
+func (a *AppService) AdminWithdrawReceipt(ctx context.Context, req *v1.AdminWithdrawReceiptRequest) (*v1.AdminWithdrawReceiptReply, error) {
+	count, err := a.juc.Run(ctx, biz.JobWithdrawReceipt, a.wuc.WatchReceipts)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminWithdrawReceiptReply{Count: count}, nil
+}
+
+// withdrawEth 在提现所在链上打款已审核的提现，返回广播成功条数。
+// 同一条链的提现由打款账户在本地分配 nonce 连续发送，不等待上一笔上链
+func (a *AppService) withdrawEth(ctx context.Context) (int64, error) {
 	var (
-		withdraws    []*biz.Withdraw
-		userIds      []int64
-		userIdsMap   map[int64]int64
-		users        map[int64]*biz.User
-		tokenAddress string
-		err          error
+		withdraws  []*biz.Withdraw
+		userIds    []int64
+		userIdsMap map[int64]int64
+		users      map[int64]*biz.User
+		count      int64
+		err        error
 	)
 	withdraws, err = a.uuc.GetWithdrawPassOrRewardedList(ctx)
 	if nil != err {
-		return nil, err
+		return 0, err
 	}
 
 	userIdsMap = make(map[int64]int64, 0)
@@ -469,9 +819,13 @@ func (a *AppService) AdminWithdrawEth(ctx context.Context, req *v1.AdminWithdraw
 
 	users, err = a.uuc.GetUserByUserIds(ctx, userIds...)
 	if nil != err {
-		return nil, err
+		return 0, err
 	}
 
+	var (
+		chainIds       []int64
+		chainWithdraws = make(map[int64][]*biz.Withdraw, 0)
+	)
 	for _, v := range withdraws {
 		if _, ok := users[v.UserId]; !ok {
 			continue
@@ -479,193 +833,163 @@ func (a *AppService) AdminWithdrawEth(ctx context.Context, req *v1.AdminWithdraw
 
 		if "dhb" == v.Type {
 			continue
-			//tokenAddress = "0x96BD81715c69eE013405B4005Ba97eA1f420fd87"
-		} else if "usdt" == v.Type {
-			//tokenAddress = "0x337610d27c682E347C9cD60BD4b3b107C9d34dDd"
-			tokenAddress = "0x55d398326f99059fF775485246999027B3197955"
-		} else {
-			continue
 		}
 
-		_, err = a.uuc.UpdateWithdrawDoing(ctx, v.ID)
+		chain, err := a.cs.Get(v.ChainId)
 		if nil != err {
+			a.log.Errorf("withdraw eth: withdraw %d chain %d not configured", v.ID, v.ChainId)
 			continue
 		}
-
-		withDrawAmount := strconv.FormatInt(v.Amount, 10) + "00000000" // 补八个0.系统基础1是10个0
-
-		for i := 0; i < 3; i++ {
-			//fmt.Println(11111, user.ToAddress, v.Amount, balanceInt)
-			_, _, err = toToken("", users[v.UserId].Address, withDrawAmount, tokenAddress)
-			fmt.Println(3333, err)
-			if err == nil {
-				_, err = a.uuc.UpdateWithdrawSuccess(ctx, v.ID)
-				time.Sleep(6 * time.Second)
-				break
-			} else if "insufficient funds for gas * price + value" == err.Error() {
-				_, _, err = toBnB("0xe865f2e5ff04B8b7952d1C0d9163A91F313b158f", "", 300000000000000000)
-				if nil != err {
-					fmt.Println(5555, err)
-					continue
-				}
-				time.Sleep(6 * time.Second)
-			} else {
-				time.Sleep(10 * time.Second)
-			}
+		if _, ok := chainWithdraws[chain.ChainId()]; !ok {
+			chainIds = append(chainIds, chain.ChainId())
 		}
+		chainWithdraws[chain.ChainId()] = append(chainWithdraws[chain.ChainId()], v)
+	}
 
-		// 清空bnb
-		for j := 0; j < 3; j++ {
-			banBalance := BnbBalance("0xe865f2e5ff04B8b7952d1C0d9163A91F313b158f")
-
-			tmpAmount, _ := strconv.ParseInt(banBalance, 10, 64)
-			fmt.Println(22222, tmpAmount)
-			tmpAmount -= 3000000000000000
-			fmt.Println(22222, banBalance, tmpAmount)
+	for _, chainId := range chainIds {
+		chain, err := a.cs.Get(chainId)
+		if nil != err {
+			continue
+		}
+		count += a.withdrawChain(ctx, chain, chainWithdraws[chainId], users)
+	}
 
-			if 0 < tmpAmount {
-				//_, _, err = toBnB("0xe865f2e5ff04B8b7952d1C0d9163A91F313b158f", user.ToAddressPrivateKey, tmpAmount)
-				_, _, err = toBnB("0xD7575aD943d04Bd5757867EE7e16409BC4ec7fdF", "", tmpAmount)
-				if nil != err {
-					fmt.Println(4444, err)
-					continue
-				}
-				time.Sleep(6 * time.Second)
-			}
+	// 本次没有提现的链，打款地址剩余的原生币转回 treasury
+	for _, chain := range a.cs {
+		if _, ok := chainWithdraws[chain.ChainId()]; !ok {
+			a.returnLeftover(ctx, chain)
 		}
 	}
 
-	return &v1.AdminWithdrawEthReply{}, nil
+	return count, nil
 }
 
-func toBnB(toAccount string, fromPrivateKey string, toAmount int64) (bool, string, error) {
-	//client, err := ethclient.Dial("https://data-seed-prebsc-1-s3.binance.org:8545/")
-	client, err := ethclient.Dial("https://bsc-dataseed.binance.org/")
-	if err != nil {
-		return false, "", err
-	}
-
-	privateKey, err := crypto.HexToECDSA(fromPrivateKey)
-	if err != nil {
-		return false, "", err
-	}
-	publicKey := privateKey.Public()
-	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
-	if !ok {
-		return false, "", err
-	}
-	fromAddress := crypto.PubkeyToAddress(*publicKeyECDSA)
-	nonce, err := client.PendingNonceAt(context.Background(), fromAddress)
-	if err != nil {
-		return false, "", err
+// withdrawChain 打款一条链上的提现，返回打款成功条数
+func (a *AppService) withdrawChain(ctx context.Context, chain *biz.Chain, withdraws []*biz.Withdraw, users map[int64]*biz.User) int64 {
+	var (
+		payer = chain.Payer
+		count int64
+		err   error
+	)
+	if nil == payer {
+		a.log.Errorf("withdraw eth: chain %s payout_signer not configured", chain.Name())
+		return 0
 	}
 
-	value := big.NewInt(toAmount) // in wei (1 eth) 最低0.03bnb才能转账
-	fmt.Println(value)
-	gasLimit := uint64(210000) // in units
-	gasPrice, err := client.SuggestGasPrice(context.Background())
-	if err != nil {
-		return false, "", err
+	// 恢复上次运行中断的交易，再重发卡住的交易，卡住的 nonce 之后的新交易都不会被打包
+	if err = a.wuc.RestorePayout(ctx, chain); nil != err {
+		a.log.Errorf("withdraw eth: chain %s restore payout tx err: %v", chain.Name(), err)
+		return 0
 	}
-	toAddress := common.HexToAddress(toAccount)
-	var data []byte
-	tx := types.NewTransaction(nonce, toAddress, value, gasLimit, gasPrice, data)
-	chainID, err := client.NetworkID(context.Background())
-	if err != nil {
-		return false, "", err
-	}
-	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(chainID), privateKey)
-	if err != nil {
-		return false, "", err
+	replaced, err := payer.ReplaceStuck(ctx)
+	if nil != err {
+		a.log.Errorf("withdraw eth: chain %s replace stuck tx err: %v", chain.Name(), err)
 	}
-	err = client.SendTransaction(context.Background(), signedTx)
-	if err != nil {
-		return false, "", err
+	for _, r := range replaced {
+		a.log.Infof("withdraw eth: chain %s nonce %d tx %s replaced by %s", chain.Name(), r.Nonce, r.OldHash, r.NewHash)
+		if err = a.wuc.ReplaceTxHash(ctx, chain.ChainId(), r); nil != err {
+			a.log.Errorf("withdraw eth: chain %s replace tx %s err: %v", chain.Name(), r.OldHash, err)
+		}
 	}
-	return true, signedTx.Hash().Hex(), nil
-}
 
-func toToken(userPrivateKey string, toAccount string, withdrawAmount string, withdrawTokenAddress string) (bool, string, error) {
-	//client, err := ethclient.Dial("https://data-seed-prebsc-1-s3.binance.org:8545/")
-	client, err := ethclient.Dial("https://bsc-dataseed.binance.org/")
-	if err != nil {
-		return false, "", err
-	}
-	// 转token
-	privateKey, err := crypto.HexToECDSA(userPrivateKey)
-	if err != nil {
-		return false, "", err
-	}
-	publicKey := privateKey.Public()
-	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
-	if !ok {
-		return false, "", err
-	}
-	fromAddress := crypto.PubkeyToAddress(*publicKeyECDSA)
-	nonce, err := client.PendingNonceAt(context.Background(), fromAddress)
-	if err != nil {
-		return false, "", err
-	}
-	value := big.NewInt(0) // in wei (0 eth)
-	gasPrice, err := client.SuggestGasPrice(context.Background())
-	if err != nil {
-		return false, "", err
-	}
-	toAddress := common.HexToAddress(toAccount)
-	// 0x337610d27c682E347C9cD60BD4b3b107C9d34dDd
-	// 0x55d398326f99059fF775485246999027B3197955
-	// tokenAddress := common.HexToAddress("0x55d398326f99059fF775485246999027B3197955")
-	// tokenAddress := common.HexToAddress("0x337610d27c682E347C9cD60BD4b3b107C9d34dDd")
-	tokenAddress := common.HexToAddress(withdrawTokenAddress)
-	transferFnSignature := []byte("transfer(address,uint256)")
-	hash := sha3.NewKeccak256()
-	hash.Write(transferFnSignature)
-	methodID := hash.Sum(nil)[:4]
+	for _, v := range withdraws {
+		token := chain.Token(v.Type)
+		if nil == token {
+			a.log.Errorf("withdraw eth: withdraw %d %s not supported on chain %s", v.ID, v.Type, chain.Name())
+			continue
+		}
 
-	paddedAddress := common.LeftPadBytes(toAddress.Bytes(), 32)
+		// 原路退款打给充值的发送地址
+		to := v.Address
+		if "" == to {
+			to = users[v.UserId].Address
+		}
 
-	amount := new(big.Int)
+		// 补八个0.系统基础1是10个0，再按代币精度换算
+		withDrawAmount := token.ChainValue(new(big.Int).Mul(big.NewInt(v.Amount), big.NewInt(100000000)))
 
-	amount.SetString(withdrawAmount, 10) // 提现的金额恢复
-	paddedAmount := common.LeftPadBytes(amount.Bytes(), 32)
+		// 签名后先保存交易并改为打款中，再发送
+		save := func(tx *biz.PayoutTx) error {
+			return a.wuc.SavePayoutTx(ctx, v, tx)
+		}
 
-	var data []byte
-	data = append(data, methodID...)
-	data = append(data, paddedAddress...)
-	data = append(data, paddedAmount...)
+		var (
+			tx      *biz.PayoutTx
+			sendErr error
+		)
+		for i := 0; i < 3; i++ {
+			tx, sendErr = payer.TransferToken(ctx, token.Contract, to, withDrawAmount, save)
+			// 交易可能已广播时不能换 nonce 重新打款，保留已保存的交易等待回执
+			if nil == sendErr || nil != tx {
+				break
+			}
 
-	tx := types.NewTransaction(nonce, tokenAddress, value, 30000000, gasPrice, data)
+			a.log.Errorf("withdraw eth: withdraw %d send err: %v", v.ID, sendErr)
+			if errors.Is(sendErr, biz.ErrWithdrawStatusChanged) || errors.Is(sendErr, biz.ErrWithdrawTransition) {
+				break
+			}
+			if errors.Is(sendErr, biz.ErrPayoutInsufficientFunds) && nil != chain.GasPayer {
+				if _, err = chain.GasPayer.TransferNative(ctx, payer.Address(), big.NewInt(300000000000000000)); nil != err {
+					a.log.Errorf("withdraw eth: chain %s gas top up err: %v", chain.Name(), err)
+				}
+				time.Sleep(6 * time.Second)
+			} else {
+				time.Sleep(10 * time.Second)
+			}
+		}
 
-	chainID, err := client.NetworkID(context.Background())
-	if err != nil {
-		return false, "", err
-	}
+		// 三次都确定未广播，标记为打款失败，由管理员确认后重新打款或拒绝；还未改为打款中的下次再打款
+		if nil == tx {
+			if biz.WithdrawStatusDoing != v.Status {
+				continue
+			}
+			if err = a.wuc.FailUnsent(ctx, v, sendErr.Error()); nil != err {
+				a.log.Errorf("withdraw eth: withdraw %d update status failed err: %v", v.ID, err)
+			}
+			continue
+		}
+		if nil != sendErr {
+			a.log.Errorf("withdraw eth: withdraw %d tx %s may be sent, wait for receipt, err: %v", v.ID, tx.Hash, sendErr)
+		}
 
-	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(chainID), privateKey)
-	if err != nil {
-		return false, "", err
+		// 是否到账由回执任务确认；修改失败时交易已保存，下次打款前由 RestorePayout 改为已广播
+		for i := 0; i < 3; i++ {
+			if err = a.wuc.Broadcast(ctx, v); nil == err {
+				break
+			}
+			time.Sleep(time.Second)
+		}
+		if nil != err {
+			a.log.Errorf("withdraw eth: withdraw %d paid in %s, update status err: %v", v.ID, tx.Hash, err)
+			continue
+		}
+		count++
 	}
 
-	err = client.SendTransaction(context.Background(), signedTx)
-	if err != nil {
-		return false, "", err
-	}
-	fmt.Println(signedTx.Hash().Hex())
-	return true, signedTx.Hash().Hex(), nil
+	return count
 }
 
-func BnbBalance(bnbAccount string) string {
-	//client, err := ethclient.Dial("https://data-seed-prebsc-1-s3.binance.org:8545/")
-	client, err := ethclient.Dial("https://bsc-dataseed.binance.org/")
-	if err != nil {
-		log.Fatal(err)
+// returnLeftover 清空bnb，打款地址剩余的原生币转回 treasury。
+// 有未打包的交易时不转，避免之前的交易手续费不足
+func (a *AppService) returnLeftover(ctx context.Context, chain *biz.Chain) {
+	treasury := chain.Conf.GetTreasury()
+	if nil == chain.Payer || "" == treasury {
+		return
 	}
 
-	account := common.HexToAddress(bnbAccount)
-	balance, err := client.BalanceAt(context.Background(), account, nil)
-	if err != nil {
-		log.Fatal(err)
+	unconfirmed, err := chain.Payer.Unconfirmed(ctx)
+	if nil != err || 0 < unconfirmed {
+		return
+	}
+	balance, err := chain.Payer.NativeBalance(ctx)
+	if nil != err {
+		a.log.Errorf("withdraw eth: chain %s balance err: %v", chain.Name(), err)
+		return
+	}
+	leftover := new(big.Int).Sub(balance, big.NewInt(3000000000000000))
+	if 0 < leftover.Sign() {
+		if _, err = chain.Payer.TransferNative(ctx, treasury, leftover); nil != err {
+			a.log.Errorf("withdraw eth: chain %s return leftover err: %v", chain.Name(), err)
+		}
 	}
-
-	return balance.String()
 }
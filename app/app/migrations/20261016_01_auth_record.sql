@@ -0,0 +1,15 @@
+-- 登录审计：每次钱包签名登录记录接受的签名消息（SIWE 或 nonce 消息）和签发令牌的 hash。
+-- 所有迁移按文件名中的日期和序号依次执行，20261016_* 在 20261017_* 之前。
+
+CREATE TABLE auth_record (
+    id         INT           NOT NULL AUTO_INCREMENT,
+    user_id    INT           NOT NULL,
+    address    VARCHAR(100)  NOT NULL,
+    message    VARCHAR(2000) NOT NULL,
+    token_hash VARCHAR(100)  NOT NULL,
+    expires_at DATETIME      NOT NULL,
+    created_at DATETIME      NOT NULL,
+    updated_at DATETIME      NOT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_auth_record_user_id (user_id)
+);
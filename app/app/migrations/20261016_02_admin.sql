@@ -0,0 +1,14 @@
+-- 后台账号和角色：operator 运营、finance 财务、viewer 只读，status 为 disable 的账号不能登录。
+-- 密码为 pbkdf2-sha256 哈希，执行后用 cmd/admin 创建第一个账号。
+
+CREATE TABLE admin (
+    id         INT          NOT NULL AUTO_INCREMENT,
+    account    VARCHAR(100) NOT NULL,
+    password   VARCHAR(200) NOT NULL,
+    role       VARCHAR(45)  NOT NULL,
+    status     VARCHAR(45)  NOT NULL,
+    created_at DATETIME     NOT NULL,
+    updated_at DATETIME     NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE INDEX idx_admins_account (account)
+);
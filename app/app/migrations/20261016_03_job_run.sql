@@ -0,0 +1,16 @@
+-- 定时任务运行记录：每次运行一条，status 为 running、success 或 fail，
+-- 查询任务上次成功运行时间按 name 和 status 过滤。
+
+CREATE TABLE job_run (
+    id         INT           NOT NULL AUTO_INCREMENT,
+    name       VARCHAR(45)   NOT NULL,
+    status     VARCHAR(45)   NOT NULL,
+    started_at DATETIME      NOT NULL,
+    ended_at   DATETIME      NULL,
+    items      BIGINT        NOT NULL,
+    error      VARCHAR(1000) NOT NULL,
+    created_at DATETIME      NOT NULL,
+    updated_at DATETIME      NOT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_job_run_name (name, status)
+);
@@ -0,0 +1,14 @@
+-- 充值扫描游标：每个代币合约记录已扫描到的区块和日志序号。
+-- 没有游标时从 chain.start_block 开始扫描，执行前先停止 deposit 任务。
+-- 唯一索引使用 gorm 默认命名，20261017_02_multi_chain 按该名称删除。
+
+CREATE TABLE deposit_cursor (
+    id           INT          NOT NULL AUTO_INCREMENT,
+    contract     VARCHAR(100) NOT NULL,
+    block_number BIGINT       NOT NULL,
+    log_index    BIGINT       NOT NULL,
+    created_at   DATETIME     NOT NULL,
+    updated_at   DATETIME     NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE INDEX idx_deposit_cursors_contract (contract)
+);
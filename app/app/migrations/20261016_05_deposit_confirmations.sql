@@ -0,0 +1,27 @@
+-- 充值确认数和回滚处理：未达到确认数的转账记入 deposit_pending，达到后再入账；
+-- 入账记录保存所在区块和产生的占位，在 reorg_window 内复查是否被回滚。
+-- 唯一索引 idx_deposit_pending 由 20261017_01_eth_user_record_log_key 加上 log_index。
+
+CREATE TABLE deposit_pending (
+    id            INT          NOT NULL AUTO_INCREMENT,
+    user_id       INT          NOT NULL,
+    hash          VARCHAR(100) NOT NULL,
+    contract      VARCHAR(100) NOT NULL,
+    amount        VARCHAR(45)  NOT NULL,
+    block_number  BIGINT       NOT NULL,
+    confirmations BIGINT       NOT NULL,
+    status        VARCHAR(45)  NOT NULL,
+    created_at    DATETIME     NOT NULL,
+    updated_at    DATETIME     NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE INDEX idx_deposit_pending (hash, contract)
+);
+
+-- 历史记录没有区块高度，保持 0，不参与回滚复查
+ALTER TABLE eth_user_record
+    ADD COLUMN block_number BIGINT NOT NULL DEFAULT 0 AFTER coin_type,
+    ADD COLUMN location_id  INT    NOT NULL DEFAULT 0 AFTER block_number,
+    ADD INDEX idx_eth_user_record_block (block_number);
+
+-- 回滚充值已产生的分红标记为待审核，由管理员处理
+ALTER TABLE reward ADD COLUMN review VARCHAR(45) NOT NULL DEFAULT '' AFTER location_type;
@@ -0,0 +1,6 @@
+-- 充值按链上顺序入账：同一区块内按交易序号和日志序号排序。
+-- 历史记录保持 0，20261017_01_eth_user_record_log_key 将其 log_index 标记为 -1。
+
+ALTER TABLE eth_user_record
+    ADD COLUMN tx_index  INT NOT NULL DEFAULT 0 AFTER block_number,
+    ADD COLUMN log_index INT NOT NULL DEFAULT 0 AFTER tx_index;
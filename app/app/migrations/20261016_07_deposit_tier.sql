@@ -0,0 +1,15 @@
+-- 充值档位：(token, amount) 唯一，enabled 为 1 时启用。
+-- 表为空时首次读取档位会写入原来的 100/200/500 USDT 档位，之后由 admin_dhb 接口维护。
+
+CREATE TABLE deposit_tier (
+    id         INT          NOT NULL AUTO_INCREMENT,
+    token      VARCHAR(45)  NOT NULL,
+    amount     VARCHAR(100) NOT NULL,
+    level      INT          NOT NULL,
+    multiplier INT          NOT NULL,
+    enabled    INT          NOT NULL,
+    created_at DATETIME     NOT NULL,
+    updated_at DATETIME     NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE INDEX idx_deposit_tier (token, amount)
+);
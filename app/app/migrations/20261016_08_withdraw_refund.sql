@@ -0,0 +1,6 @@
+-- 未匹配档位充值的原路退款：kind 为 refund，打给充值转账的发送地址，不计入提现限额。
+-- 历史提现 kind 为空，address 为空时打给用户地址。
+
+ALTER TABLE withdraw
+    ADD COLUMN kind    VARCHAR(45)  NOT NULL DEFAULT '',
+    ADD COLUMN address VARCHAR(100) NOT NULL DEFAULT '';
@@ -0,0 +1,6 @@
+-- 未注册钱包的充值记为 orphan 并保存发送地址，用户首次登录时按地址认领。
+-- 历史记录没有发送地址，保持为空。
+
+ALTER TABLE eth_user_record
+    ADD COLUMN address VARCHAR(100) NOT NULL DEFAULT '' AFTER hash,
+    ADD INDEX idx_eth_user_records_address (address);
@@ -0,0 +1,9 @@
+-- 多代币充值和 USDT+DHB 组合档位：待确认充值记录代币，档位增加第二种代币及其金额。
+-- 历史数据都是 USDT 单币档位，pair_token 为空。
+
+ALTER TABLE deposit_pending ADD COLUMN coin_type VARCHAR(45) NOT NULL DEFAULT '' AFTER contract;
+UPDATE deposit_pending SET coin_type = 'USDT' WHERE coin_type = '';
+
+ALTER TABLE deposit_tier
+    ADD COLUMN pair_token  VARCHAR(45)  NOT NULL DEFAULT '' AFTER enabled,
+    ADD COLUMN pair_amount VARCHAR(100) NOT NULL DEFAULT '' AFTER pair_token;
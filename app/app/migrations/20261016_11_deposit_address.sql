@@ -0,0 +1,15 @@
+-- 每个用户按 xpub 派生专属充值地址，address_index 为派生序号。
+-- 唯一索引使用 gorm 默认命名，20261017_02_multi_chain 按这些名称删除后改为按链唯一。
+
+CREATE TABLE deposit_address (
+    id            INT          NOT NULL AUTO_INCREMENT,
+    user_id       INT          NOT NULL,
+    address_index INT UNSIGNED NOT NULL,
+    address       VARCHAR(100) NOT NULL,
+    created_at    DATETIME     NOT NULL,
+    updated_at    DATETIME     NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE INDEX idx_deposit_addresses_user_id (user_id),
+    UNIQUE INDEX idx_deposit_addresses_address_index (address_index),
+    UNIQUE INDEX idx_deposit_addresses_address (address)
+);
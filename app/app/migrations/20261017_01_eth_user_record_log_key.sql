@@ -0,0 +1,20 @@
+-- eth_user_record 由按 hash 去重改为按 (chain_id, hash, log_index) 唯一，
+-- 一笔交易（multicall、批量转账）中的多个 Transfer 事件各入账一条。
+-- 执行前先停止 deposit 任务，执行后再发布新版本。
+
+ALTER TABLE eth_user_record ADD COLUMN chain_id BIGINT NOT NULL DEFAULT 0 AFTER id;
+
+-- 历史记录都来自 BSC 主网，与 chain.chain_id 一致
+UPDATE eth_user_record SET chain_id = 56 WHERE chain_id = 0;
+
+-- 没有区块高度的记录是按区块入账之前写入的，当时只按 hash 去重，log_index 无意义；
+-- 标记为 -1 代表整笔交易，查询时匹配该交易的所有日志序号，避免重复入账
+UPDATE eth_user_record SET log_index = -1 WHERE block_number = 0;
+
+-- 加唯一索引前确认没有重复，有结果时需先人工处理：
+-- SELECT chain_id, hash, log_index, COUNT(*) FROM eth_user_record GROUP BY chain_id, hash, log_index HAVING COUNT(*) > 1;
+ALTER TABLE eth_user_record ADD UNIQUE INDEX idx_eth_user_record_log (chain_id, hash, log_index);
+
+-- 待确认充值同样区分同一交易中的多个转账
+ALTER TABLE deposit_pending ADD COLUMN log_index INT NOT NULL DEFAULT 0 AFTER contract;
+ALTER TABLE deposit_pending DROP INDEX idx_deposit_pending, ADD UNIQUE INDEX idx_deposit_pending (hash, contract, log_index);
@@ -0,0 +1,27 @@
+-- 多链充值和提现：扫描游标、待确认充值、专属充值地址、提现记录按 chain_id 区分。
+-- 执行前先停止 deposit、deposit_sweep、withdraw_eth 任务，执行后再发布新版本。
+-- 旧的唯一索引名为 gorm 默认命名，执行前用 SHOW INDEX 确认。
+
+-- 历史数据都来自 BSC 主网
+ALTER TABLE deposit_cursor ADD COLUMN chain_id BIGINT NOT NULL DEFAULT 0 AFTER id;
+UPDATE deposit_cursor SET chain_id = 56 WHERE chain_id = 0;
+ALTER TABLE deposit_cursor DROP INDEX idx_deposit_cursors_contract, ADD UNIQUE INDEX idx_deposit_cursor (chain_id, contract);
+
+ALTER TABLE deposit_pending ADD COLUMN chain_id BIGINT NOT NULL DEFAULT 0 AFTER id;
+UPDATE deposit_pending SET chain_id = 56 WHERE chain_id = 0;
+ALTER TABLE deposit_pending DROP INDEX idx_deposit_pending, ADD UNIQUE INDEX idx_deposit_pending (chain_id, hash, contract, log_index);
+
+-- 每条链按各自的 xpub 派生，同一用户在不同链上的地址可以不同
+ALTER TABLE deposit_address ADD COLUMN chain_id BIGINT NOT NULL DEFAULT 0 AFTER id;
+UPDATE deposit_address SET chain_id = 56 WHERE chain_id = 0;
+ALTER TABLE deposit_address
+    DROP INDEX idx_deposit_addresses_user_id,
+    DROP INDEX idx_deposit_addresses_address_index,
+    DROP INDEX idx_deposit_addresses_address,
+    ADD UNIQUE INDEX idx_deposit_address_user (chain_id, user_id),
+    ADD UNIQUE INDEX idx_deposit_address_index (chain_id, address_index),
+    ADD UNIQUE INDEX idx_deposit_address (chain_id, address);
+
+-- 未打款的历史提现按 BSC 主网打款
+ALTER TABLE withdraw ADD COLUMN chain_id BIGINT NOT NULL DEFAULT 0 AFTER type;
+UPDATE withdraw SET chain_id = 56 WHERE chain_id = 0;
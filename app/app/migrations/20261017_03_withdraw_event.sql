@@ -0,0 +1,18 @@
+-- 提现状态机：每次状态变化记录一条 withdraw_event，包含操作人和原因。
+-- 历史提现没有状态变化记录，doing 状态的提现需人工确认链上是否到账后通过 withdraw_update 处理。
+
+CREATE TABLE withdraw_event (
+    id          INT          NOT NULL AUTO_INCREMENT,
+    withdraw_id INT          NOT NULL,
+    from_status VARCHAR(45)  NOT NULL,
+    to_status   VARCHAR(45)  NOT NULL,
+    actor       VARCHAR(100) NOT NULL,
+    reason      VARCHAR(500) NOT NULL DEFAULT '',
+    created_at  DATETIME     NOT NULL,
+    updated_at  DATETIME     NOT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_withdraw_event_withdraw_id (withdraw_id)
+);
+
+-- 状态修改带 WHERE status = 原状态 条件
+ALTER TABLE withdraw ADD INDEX idx_withdraw_status (status);
@@ -0,0 +1,7 @@
+-- 打款交易签名后先和打款中状态一起保存 nonce 和签名后的交易再发送，
+-- 重启后由已保存的交易恢复未打包的交易并用相同 nonce 重发，发送结果未知时不会换 nonce 重新打款。
+-- 历史提现 raw_tx 为空，不会被恢复。
+
+ALTER TABLE withdraw
+    ADD COLUMN nonce  BIGINT        NOT NULL DEFAULT 0,
+    ADD COLUMN raw_tx VARCHAR(1000) NOT NULL DEFAULT '';
@@ -0,0 +1,10 @@
+-- 提现打款回执：记录打款交易 hash、消耗的 gas 和所在区块。
+-- 新打款的状态为 broadcast，回执任务 withdraw_receipt 达到确认数后改为 confirmed，执行失败改为 failed。
+-- 历史的 success 提现没有交易 hash，保持不变。
+
+ALTER TABLE withdraw
+    ADD COLUMN tx_hash VARCHAR(100) NOT NULL DEFAULT '' AFTER chain_id,
+    ADD COLUMN replaced_tx_hash VARCHAR(1000) NOT NULL DEFAULT '' AFTER tx_hash,
+    ADD COLUMN gas_used BIGINT NOT NULL DEFAULT 0 AFTER replaced_tx_hash,
+    ADD COLUMN block_number BIGINT NOT NULL DEFAULT 0 AFTER gas_used,
+    ADD INDEX idx_withdraw_tx_hash (chain_id, tx_hash);
@@ -0,0 +1,16 @@
+-- 提现风控规则，后台 config 中修改。金额为代币数量，次数、小时为整数；为 0 时不限制。
+-- 每日按北京时间零点划分，已拒绝、已取消的提现和充值原路退款不计入每日次数和数量。
+
+INSERT INTO config (name, key_name, value, created_at, updated_at) VALUES
+    ('USDT 单笔最小提现数量', 'withdraw_min_usdt', '0', NOW(), NOW()),
+    ('DHB 单笔最小提现数量', 'withdraw_min_dhb', '0', NOW(), NOW()),
+    ('每个用户每日 USDT 提现数量上限', 'withdraw_user_daily_amount_usdt', '0', NOW(), NOW()),
+    ('每个用户每日 DHB 提现数量上限', 'withdraw_user_daily_amount_dhb', '0', NOW(), NOW()),
+    ('全网每日 USDT 提现数量上限', 'withdraw_daily_amount_usdt', '0', NOW(), NOW()),
+    ('全网每日 DHB 提现数量上限', 'withdraw_daily_amount_dhb', '0', NOW(), NOW()),
+    ('每个用户每日提现次数上限', 'withdraw_user_daily_count', '0', NOW(), NOW()),
+    ('充值后多少小时内不能提现', 'withdraw_deposit_cooldown', '0', NOW(), NOW()),
+    ('注册不满多少小时不能提现', 'withdraw_account_hold', '0', NOW(), NOW());
+
+-- 每日统计按 user_id、created_at 查询
+ALTER TABLE withdraw ADD INDEX idx_withdraw_user_created (user_id, created_at);
@@ -0,0 +1,14 @@
+-- 扫描游标改为每条链一个，不再按第一个代币合约记录，调整代币配置的顺序或增删代币不会换游标。
+-- 每条链保留区块最小的游标，从该区块开头重新扫描，已入账的转账按 (chain_id, hash, log_index) 跳过。
+-- 执行前先停止 deposit 任务。
+
+DELETE c FROM deposit_cursor c
+    JOIN deposit_cursor k ON c.chain_id = k.chain_id
+        AND (c.block_number > k.block_number OR (c.block_number = k.block_number AND c.id > k.id));
+
+UPDATE deposit_cursor SET log_index = -1;
+
+ALTER TABLE deposit_cursor
+    DROP INDEX idx_deposit_cursor,
+    DROP COLUMN contract,
+    ADD UNIQUE INDEX idx_deposit_cursor (chain_id);
@@ -0,0 +1,7 @@
+-- 提现分红会把 amount 改为分红后的金额，每日提现数量改为按申请时的数量统计。
+-- 已有记录无法还原分红前的数量，按当前 amount 填充。
+
+ALTER TABLE withdraw
+    ADD COLUMN request_amount BIGINT NOT NULL DEFAULT 0 AFTER amount;
+
+UPDATE withdraw SET request_amount = amount;
@@ -10,6 +10,7 @@ require (
 	github.com/google/wire v0.5.0
 	github.com/gorilla/handlers v1.5.1
 	go.uber.org/automaxprocs v1.5.1
+	golang.org/x/crypto v0.3.0
 	google.golang.org/genproto v0.0.0-20221130183247-a2ec334bae6f
 	google.golang.org/grpc v1.51.0
 	google.golang.org/protobuf v1.28.1